@@ -5,21 +5,31 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/go-redis/redis"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/G-Research/armada/internal/armada"
 	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/repository/schema"
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/database"
 	"github.com/G-Research/armada/internal/common/grpc"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 const CustomConfigLocation string = "config"
+const MigrateDatabase string = "migrateDatabase"
+const BackupRedis string = "backupRedis"
+const RestoreRedis string = "restoreRedis"
 
 func init() {
 	pflag.StringSlice(CustomConfigLocation, []string{}, "Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)")
+	pflag.Bool(MigrateDatabase, false, "Migrate Postgres databases of events, jobs and audit log instead of running server")
+	pflag.String(BackupRedis, "", "Write backup of queues, jobs, job sets and cluster priorities in Redis to this file instead of running server")
+	pflag.String(RestoreRedis, "", "Restore backup from this file into empty Redis (or Redis with unfinished restore of the same backup) instead of running server")
 	pflag.Parse()
 }
 
@@ -31,6 +41,27 @@ func main() {
 	userSpecifiedConfigs := viper.GetStringSlice(CustomConfigLocation)
 	common.LoadConfig(&config, "./config/armada", userSpecifiedConfigs)
 
+	if viper.GetBool(MigrateDatabase) {
+		migrateDatabase(config.EventsPostgres.Postgres)
+		if config.JobsBackend == "postgres" {
+			migrateDatabase(config.JobsPostgres.Postgres)
+		}
+		if config.Audit.Enabled && config.Audit.Sink == "postgres" {
+			migrateDatabase(config.Audit.Postgres)
+		}
+		os.Exit(0)
+	}
+
+	if path := viper.GetString(BackupRedis); path != "" {
+		backupRedis(&config.Redis, path)
+		os.Exit(0)
+	}
+
+	if path := viper.GetString(RestoreRedis); path != "" {
+		restoreRedis(&config.Redis, path)
+		os.Exit(0)
+	}
+
 	log.Info("Starting...")
 
 	stopSignal := make(chan os.Signal, 1)
@@ -42,10 +73,15 @@ func main() {
 	shutdownGateway := grpc.ServeGateway(
 		config.HttpPort,
 		config.GrpcPort,
+		config.Auth.ClientCertAuth.Enabled(),
 		config.CorsAllowedOrigins,
 		api.SwaggerJsonTemplate(),
 		api.RegisterSubmitHandler,
 		api.RegisterEventHandler,
+		api.RegisterNotificationHandler,
+		api.RegisterAuditHandler,
+		api.RegisterApiTokensHandler,
+		api.RegisterSchedulingHandler,
 	)
 	defer shutdownGateway()
 
@@ -56,3 +92,54 @@ func main() {
 	}()
 	wg.Wait()
 }
+
+func migrateDatabase(config database.PostgresConfig) {
+	db, err := database.OpenPostgres(config)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	err = schema.UpdateDatabase(db)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func backupRedis(config *redis.UniversalOptions, path string) {
+	db := redis.NewUniversalClient(config)
+	defer db.Close()
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		panic(err)
+	}
+	summary, err := repository.NewRedisBackup(db).Backup(file)
+	if err != nil {
+		panic(err)
+	}
+	err = file.Close()
+	if err != nil {
+		panic(err)
+	}
+	log.Infof("Backup of %d queues, %d queued jobs, %d leased jobs and %d clusters written to %s",
+		summary.Queues, summary.QueuedJobs, summary.LeasedJobs, summary.Clusters, path)
+}
+
+func restoreRedis(config *redis.UniversalOptions, path string) {
+	db := redis.NewUniversalClient(config)
+	defer db.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	summary, err := repository.NewRedisBackup(db).Restore(file)
+	if err != nil {
+		panic(err)
+	}
+	log.Infof("Restored %d queues, %d queued jobs, %d leased jobs and %d clusters from %s",
+		summary.Queues, summary.QueuedJobs, summary.LeasedJobs, summary.Clusters, path)
+}
@@ -20,14 +20,12 @@ func init() {
 
 // analyzeCmd represents the analyze command
 var analyzeCmd = &cobra.Command{
-	Use:   "analyze queue jobSet",
+	Use:   "analyze [queue jobSet]",
 	Short: "Analyze job events in job set.",
 	Long:  ``,
-	Args:  cobra.ExactArgs(2),
+	Args:  queueAndJobSetArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-
-		queue := args[0]
-		jobSetId := args[1]
+		queue, jobSetId := getQueueAndJobSet(args)
 
 		log.Infof("job set %s", jobSetId)
 
@@ -45,6 +43,21 @@ var analyzeCmd = &cobra.Command{
 				return false
 			})
 
+			if isStructuredOutput() {
+				unsuccessfulJobs := map[string][]*eventOutput{}
+				if jobState != nil {
+					for id, jobInfo := range jobState.GetCurrentState() {
+						if jobInfo.Status != domain.Succeeded {
+							for _, e := range events[id] {
+								unsuccessfulJobs[id] = append(unsuccessfulJobs[id], newEventOutput(*e))
+							}
+						}
+					}
+				}
+				printStructured(unsuccessfulJobs)
+				return
+			}
+
 			if jobState == nil {
 				log.Infof("No events found in jobset %s (queue: %s)", jobSetId, queue)
 				return
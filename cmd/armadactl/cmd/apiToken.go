@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(createApiTokenCmd)
+	createApiTokenCmd.Flags().String("principal", "", "Principal the token authenticates as, required.")
+	createApiTokenCmd.Flags().StringSlice("groups", []string{}, "Comma separated list of groups of the principal.")
+	createApiTokenCmd.Flags().StringSlice("scopes", []string{}, "Comma separated list of scopes granted to the token.")
+	createApiTokenCmd.Flags().Duration("expiresIn", 0, "Validity of the token, e.g. 720h, the token never expires by default.")
+	createApiTokenCmd.MarkFlagRequired("principal")
+
+	rootCmd.AddCommand(revokeApiTokenCmd)
+}
+
+var createApiTokenCmd = &cobra.Command{
+	Use:   "create-api-token name",
+	Short: "Create api token",
+	Long: `Creates long-lived api token authenticating as the principal with given groups and scopes.
+The token is printed only once, it can't be recovered later.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		principal, _ := cmd.Flags().GetString("principal")
+		groups, _ := cmd.Flags().GetStringSlice("groups")
+		scopes, _ := cmd.Flags().GetStringSlice("scopes")
+		expiresIn, _ := cmd.Flags().GetDuration("expiresIn")
+
+		request := &api.ApiTokenCreateRequest{
+			Name:      args[0],
+			Principal: principal,
+			Groups:    groups,
+			Scopes:    scopes,
+		}
+		if expiresIn > 0 {
+			expires := time.Now().Add(expiresIn)
+			request.Expires = &expires
+		}
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			tokensClient := api.NewApiTokensClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			response, e := tokensClient.CreateApiToken(ctx, request)
+			if e != nil {
+				exitWithError(e)
+			}
+			if printStructured(response) {
+				return
+			}
+			log.Infof("Api token %s created.", response.Token.Id)
+			fmt.Println(response.SecretToken)
+		})
+	},
+}
+
+var revokeApiTokenCmd = &cobra.Command{
+	Use:   "revoke-api-token id",
+	Short: "Revoke api token",
+	Long:  `Revokes api token, requests authenticated with the token are rejected immediately.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			tokensClient := api.NewApiTokensClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			_, e := tokensClient.RevokeApiToken(ctx, &api.ApiTokenRevokeRequest{Id: args[0]})
+			if e != nil {
+				exitWithError(e)
+			}
+			if !printStructured(&api.ApiTokenRevokeRequest{Id: args[0]}) {
+				log.Infof("Api token %s revoked.", args[0])
+			}
+		})
+	},
+}
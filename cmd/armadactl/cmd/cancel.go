@@ -17,7 +17,7 @@ func init() {
 	cancelCmd.Flags().String(
 		"jobId", "", "job to cancel")
 	cancelCmd.Flags().String(
-		"queue", "", "queue to cancel jobs from (requires job set to be specified)")
+		"queue", "", "queue to cancel jobs from (requires job set to be specified, default queue of the context is used when omitted)")
 	cancelCmd.Flags().String(
 		"jobSet", "", "jobSet to cancel (requires queue to be specified)")
 }
@@ -29,6 +29,7 @@ var cancelCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+		defaultQueue := client.ExtractCommandlineDefaultQueue()
 
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			client := api.NewSubmitClient(conn)
@@ -36,6 +37,9 @@ var cancelCmd = &cobra.Command{
 			jobId, _ := cmd.Flags().GetString("jobId")
 			queue, _ := cmd.Flags().GetString("queue")
 			jobSet, _ := cmd.Flags().GetString("jobSet")
+			if jobSet != "" && queue == "" {
+				queue = defaultQueue
+			}
 
 			ctx, cancel := common.ContextWithDefaultTimeout()
 			defer cancel()
@@ -47,7 +51,9 @@ var cancelCmd = &cobra.Command{
 			if e != nil {
 				exitWithError(e)
 			}
-			log.Infof("Cancellation request submitted for jobs: %s", strings.Join(result.CancelledIds, ", "))
+			if !printStructured(result) {
+				log.Infof("Cancellation request submitted for jobs: %s", strings.Join(result.CancelledIds, ", "))
+			}
 		})
 	},
 }
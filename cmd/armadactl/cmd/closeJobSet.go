@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(closeJobSetCmd)
+}
+
+var closeJobSetCmd = &cobra.Command{
+	Use:   "close-job-set [queue jobSet]",
+	Short: "Close job set for new submissions",
+	Long: `This command closes the job set, further submissions to it are rejected.
+Once all jobs of the closed job set finish, the job set is completed and JobSetCompletedEvent is reported.`,
+
+	Args: queueAndJobSetArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		queue, jobSetId := getQueueAndJobSet(args)
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submissionClient := api.NewSubmitClient(conn)
+			e := client.CloseJobSet(submissionClient, queue, jobSetId)
+			if e != nil {
+				exitWithError(e)
+			}
+			if !printStructured(&api.JobSetCloseRequest{Queue: queue, JobSetId: jobSetId}) {
+				log.Infof("Job set %s closed.", jobSetId)
+			}
+		})
+	},
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(useContextCmd)
+	configCmd.AddCommand(getContextsCmd)
+	configCmd.AddCommand(setContextCmd)
+	setContextCmd.Flags().String("queue", "", "Default queue of the context")
+	setContextCmd.Flags().String("jobSet", "", "Default job set of the context")
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Modify armadactl config file",
+	Long: `Modify contexts of armadactl config file, the file is passed in using --config argument or $HOME/.armadactl.yaml is used.
+
+Example structure:
+currentContext: dev
+contexts:
+  dev:
+    armadaUrl: armada.dev.example.com:443
+    queue: test
+    jobSetId: set1
+    openIdAuth:
+      providerUrl: https://login.example.com
+      clientId: armadactl
+      localPort: 26354
+      scopes: []
+  prod:
+    armadaUrl: armada.example.com:443
+    kerberosAuth:
+      enabled: true`,
+}
+
+var useContextCmd = &cobra.Command{
+	Use:   "use-context name",
+	Short: "Set the current context",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		config := readCommandlineConfig()
+		e := config.UseContext(name)
+		if e != nil {
+			exitWithError(e)
+		}
+		writeCommandlineConfig(config)
+		if !printStructured(config.Context(name)) {
+			log.Infof("Switched to context %s.", name)
+		}
+	},
+}
+
+var getContextsCmd = &cobra.Command{
+	Use:   "get-contexts",
+	Short: "List contexts",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := readCommandlineConfig()
+		if printStructured(config.Contexts()) {
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "CURRENT\tNAME\tARMADA URL\tQUEUE\tJOB SET")
+		for _, context := range config.Contexts() {
+			current := ""
+			if context.Current {
+				current = "*"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, context.Name, context.ArmadaUrl, context.Queue, context.JobSetId)
+		}
+		w.Flush()
+	},
+}
+
+var setContextCmd = &cobra.Command{
+	Use:   "set-context name",
+	Short: "Create or modify context",
+	Long: `Creates context or modifies its armada url, default queue and job set. Only settings passed by flags are changed,
+for example: armadactl config set-context dev --armadaUrl armada.dev.example.com:443 --queue test`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		values := map[string]string{}
+		for flag, key := range map[string]string{"armadaUrl": "armadaUrl", "queue": "queue", "jobSet": "jobSetId"} {
+			if cmd.Flags().Changed(flag) {
+				values[key], _ = cmd.Flags().GetString(flag)
+			}
+		}
+
+		config := readCommandlineConfig()
+		config.SetContext(name, values)
+		writeCommandlineConfig(config)
+		if !printStructured(config.Context(name)) {
+			log.Infof("Context %s set.", name)
+		}
+	},
+}
+
+func readCommandlineConfig() *client.CommandlineConfig {
+	path, e := client.CommandlineConfigPath(cfgFile)
+	if e != nil {
+		exitWithError(e)
+	}
+	config, e := client.ReadCommandlineConfig(path)
+	if e != nil {
+		exitWithError(e)
+	}
+	return config
+}
+
+func writeCommandlineConfig(config *client.CommandlineConfig) {
+	e := config.Write()
+	if e != nil {
+		exitWithError(e)
+	}
+}
@@ -25,6 +25,7 @@ func init() {
 	createQueueCmd.Flags().StringToString(
 		"resourceLimits", map[string]string{},
 		"Command separated list of resource limits pairs, defaults to empty list. Example: --resourceLimits cpu=0.3,memory=0.2")
+	addQueueRoleFlags(createQueueCmd)
 }
 
 // createQueueCmd represents the createQueue command
@@ -50,17 +51,21 @@ Job priority is evaluated inside queue, queue has its own priority.`,
 
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			submissionClient := api.NewSubmitClient(conn)
-			e := client.CreateQueue(submissionClient, &api.Queue{
+			created := &api.Queue{
 				Name:           queue,
 				PriorityFactor: priority,
 				UserOwners:     owners,
 				GroupOwners:    groups,
-				ResourceLimits: resourceLimitsFloat})
+				ResourceLimits: resourceLimitsFloat,
+				RoleBindings:   getQueueRoleBindings(cmd)}
+			e := client.CreateQueue(submissionClient, created)
 
 			if e != nil {
 				exitWithError(e)
 			}
-			log.Infof("Queue %s created.", queue)
+			if !printStructured(created) {
+				log.Infof("Queue %s created.", queue)
+			}
 		})
 	},
 }
@@ -30,7 +30,9 @@ var deleteQueueCmd = &cobra.Command{
 			if e != nil {
 				exitWithError(e)
 			}
-			log.Infof("Queue %s deleted or did not exist.", queue)
+			if !printStructured(&api.QueueDeleteRequest{Name: queue}) {
+				log.Infof("Queue %s deleted or did not exist.", queue)
+			}
 		})
 	},
 }
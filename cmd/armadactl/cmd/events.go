@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/G-Research/armada/internal/armada/archive"
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.AddCommand(eventsExportCmd)
+	eventsExportCmd.Flags().String("job-set", "", "Export only events of this job set")
+	eventsExportCmd.Flags().String("from", "", "Export events created at or after this time (RFC3339)")
+	eventsExportCmd.Flags().String("to", "", "Export events created at or before this time (RFC3339)")
+	eventsExportCmd.Flags().String("archive-dir", "", "Directory of local event archive")
+	eventsExportCmd.Flags().String("s3-bucket", "", "S3 bucket of event archive")
+	eventsExportCmd.Flags().String("s3-prefix", "", "Prefix of event archive in S3 bucket")
+	eventsExportCmd.Flags().String("s3-endpoint", "", "Endpoint of S3 compatible storage, defaults to AWS")
+	eventsExportCmd.Flags().String("s3-region", "us-east-1", "S3 region")
+	eventsExportCmd.Flags().Bool("s3-force-path-style", false, "Use path style S3 urls, required by some S3 compatible storages")
+}
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Work with archived events",
+}
+
+var eventsExportCmd = &cobra.Command{
+	Use:   "export queue",
+	Short: "Export archived events of the queue",
+	Long: `This command reads events of the queue from the event archive and prints them as JSON, one event per line.
+S3 credentials are taken from the environment (AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY) or shared AWS configuration.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+		jobSetId, _ := cmd.Flags().GetString("job-set")
+		from, _ := cmd.Flags().GetString("from")
+		to, _ := cmd.Flags().GetString("to")
+		archiveDir, _ := cmd.Flags().GetString("archive-dir")
+		s3Bucket, _ := cmd.Flags().GetString("s3-bucket")
+		s3Prefix, _ := cmd.Flags().GetString("s3-prefix")
+		s3Endpoint, _ := cmd.Flags().GetString("s3-endpoint")
+		s3Region, _ := cmd.Flags().GetString("s3-region")
+		s3ForcePathStyle, _ := cmd.Flags().GetBool("s3-force-path-style")
+
+		filter := &archive.ExportFilter{Queue: queue, JobSetId: jobSetId}
+		var e error
+		if filter.From, e = parseOptionalTime(from); e != nil {
+			exitWithError(e)
+		}
+		if filter.To, e = parseOptionalTime(to); e != nil {
+			exitWithError(e)
+		}
+
+		sink, e := archive.NewSink(&configuration.EventArchiveConfig{
+			Local: configuration.LocalArchiveConfig{Directory: archiveDir},
+			S3: configuration.S3ArchiveConfig{
+				Endpoint:       s3Endpoint,
+				Region:         s3Region,
+				Bucket:         s3Bucket,
+				Prefix:         s3Prefix,
+				ForcePathStyle: s3ForcePathStyle,
+			},
+		})
+		if e != nil {
+			exitWithError(e)
+		}
+
+		e = archive.Export(sink, filter, func(message *api.EventMessage) error {
+			data, e := json.Marshal(message)
+			if e != nil {
+				return e
+			}
+			fmt.Println(string(data))
+			return nil
+		})
+		if e != nil {
+			exitWithError(e)
+		}
+	},
+}
+
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+}
+
+var explainCmd = &cobra.Command{
+	Use:   "explain jobId",
+	Short: "Explain why queued job is not running",
+	Long: `Evaluates queued job against node types reported by all clusters and current limits of its queue.
+Prints reasons why each cluster and node type can't run the job, e.g. node selector mismatch, untolerated taint
+or insufficient resources, and reasons why the queue is not scheduled in pools where the job fits,
+e.g. queue resource limit reached or queue using more than its fair share.
+Wide output adds labels, taints and allocatable resources of node types.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		jobId := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			schedulingClient := api.NewSchedulingClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			explanation, e := schedulingClient.ExplainJob(ctx, &api.JobExplainRequest{JobId: jobId})
+			if e != nil {
+				exitWithError(e)
+			}
+			if printStructured(explanation) {
+				return
+			}
+			printExplanation(explanation)
+		})
+	},
+}
+
+func printExplanation(explanation *api.JobExplanation) {
+	fmt.Printf("Job %s of queue %s, job set %s\n", explanation.JobId, explanation.Queue, explanation.JobSetId)
+	for _, reason := range explanation.Reasons {
+		fmt.Printf("- %s\n", reason)
+	}
+
+	if len(explanation.Pools) > 0 {
+		fmt.Println()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "POOL\tACTIVE QUEUES\tPRIORITY\tFAIR SHARE\tUSAGE\tREASON")
+		for _, pool := range explanation.Pools {
+			reasons := pool.Reasons
+			if len(reasons) == 0 {
+				reasons = []string{"-"}
+			}
+			for _, reason := range reasons {
+				fmt.Fprintf(w, "%s\t%d\t%.2f\t%.0f%%\t%.0f%%\t%s\n",
+					pool.Pool, pool.ActiveQueues, pool.QueuePriority, pool.QueueFairShare*100, pool.QueueUsage*100, reason)
+			}
+		}
+		w.Flush()
+	}
+
+	if len(explanation.Clusters) > 0 {
+		fmt.Println()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		if outputFormat == outputWide {
+			fmt.Fprintln(w, "CLUSTER\tPOOL\tSCHEDULABLE\tNODE TYPE\tLABELS\tTAINTS\tALLOCATABLE\tREASON")
+		} else {
+			fmt.Fprintln(w, "CLUSTER\tPOOL\tSCHEDULABLE\tNODE TYPE\tREASON")
+		}
+		for _, cluster := range explanation.Clusters {
+			for _, reason := range cluster.Reasons {
+				printExplanationRow(w, cluster, "-", nil, reason)
+			}
+			for i, nodeType := range cluster.NodeTypes {
+				reasons := nodeType.Reasons
+				if nodeType.Matches {
+					reasons = []string{"matches"}
+				}
+				for _, reason := range reasons {
+					printExplanationRow(w, cluster, fmt.Sprint(i), nodeType.NodeType, reason)
+				}
+			}
+		}
+		w.Flush()
+	}
+}
+
+func printExplanationRow(w *tabwriter.Writer, cluster *api.ClusterExplanation, nodeTypeIndex string, nodeType *api.NodeType, reason string) {
+	if outputFormat != outputWide {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\n", cluster.ClusterId, cluster.Pool, cluster.Schedulable, nodeTypeIndex, reason)
+		return
+	}
+	labels, taints, allocatable := "-", "-", "-"
+	if nodeType != nil {
+		labels = formatLabels(nodeType.Labels)
+		taints = formatTaints(nodeType)
+		allocatable = formatAllocatable(nodeType)
+	}
+	fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%s\t%s\t%s\n",
+		cluster.ClusterId, cluster.Pool, cluster.Schedulable, nodeTypeIndex, labels, taints, allocatable, reason)
+}
+
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func formatTaints(nodeType *api.NodeType) string {
+	taints := make([]string, 0, len(nodeType.Taints))
+	for _, taint := range nodeType.Taints {
+		taints = append(taints, taint.ToString())
+	}
+	return strings.Join(taints, ",")
+}
+
+func formatAllocatable(nodeType *api.NodeType) string {
+	resources := make([]string, 0, len(nodeType.AllocatableResources))
+	for name, quantity := range nodeType.AllocatableResources {
+		resources = append(resources, name+"="+quantity.String())
+	}
+	sort.Strings(resources)
+	return strings.Join(resources, ",")
+}
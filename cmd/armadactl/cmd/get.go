@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(getCmd)
+	getCmd.AddCommand(getQueuesCmd)
+	getCmd.AddCommand(getApiTokensCmd)
+}
+
+var getCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Display resources",
+}
+
+var getQueuesCmd = &cobra.Command{
+	Use:   "queues",
+	Short: "List queues",
+	Long:  `Lists all queues you are allowed to view.`,
+
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			queues, e := submitClient.GetQueues(ctx, &types.Empty{})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			if printStructured(queues) {
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			if outputFormat == outputWide {
+				fmt.Fprintln(w, "NAME\tPRIORITY FACTOR\tOWNERS\tGROUP OWNERS\tVERSION\tRESOURCE LIMITS\tROLE BINDINGS")
+			} else {
+				fmt.Fprintln(w, "NAME\tPRIORITY FACTOR\tOWNERS\tGROUP OWNERS\tVERSION")
+			}
+			for _, queue := range queues.Queues {
+				fmt.Fprintf(w, "%s\t%g\t%s\t%s\t%d", queue.Name, queue.PriorityFactor,
+					strings.Join(queue.UserOwners, ","), strings.Join(queue.GroupOwners, ","), queue.Version)
+				if outputFormat == outputWide {
+					fmt.Fprintf(w, "\t%s\t%s", formatResourceLimits(queue.ResourceLimits), formatRoleBindings(queue.RoleBindings))
+				}
+				fmt.Fprintln(w)
+			}
+			w.Flush()
+		})
+	},
+}
+
+var getApiTokensCmd = &cobra.Command{
+	Use:   "api-tokens",
+	Short: "List api tokens",
+	Long:  `Lists all api tokens issued by armada, secrets of the tokens are never returned.`,
+
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			tokensClient := api.NewApiTokensClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			tokens, e := tokensClient.GetApiTokens(ctx, &types.Empty{})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			if printStructured(tokens) {
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "ID\tNAME\tPRINCIPAL\tGROUPS\tSCOPES\tCREATED\tEXPIRES\tCREATED BY")
+			for _, token := range tokens.Tokens {
+				expires := "never"
+				if token.Expires != nil {
+					expires = token.Expires.Format(time.RFC3339)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", token.Id, token.Name, token.Principal,
+					strings.Join(token.Groups, ","), strings.Join(token.Scopes, ","),
+					token.Created.Format(time.RFC3339), expires, token.CreatedBy)
+			}
+			w.Flush()
+		})
+	},
+}
+
+func formatResourceLimits(resourceLimits map[string]float64) string {
+	limits := make([]string, 0, len(resourceLimits))
+	for resource, limit := range resourceLimits {
+		limits = append(limits, fmt.Sprintf("%s=%g", resource, limit))
+	}
+	sort.Strings(limits)
+	return strings.Join(limits, ",")
+}
+
+func formatRoleBindings(roleBindings []*api.QueueRoleBinding) string {
+	bindings := []string{}
+	for _, binding := range roleBindings {
+		subjects := append([]string{}, binding.Users...)
+		for _, group := range binding.Groups {
+			subjects = append(subjects, groupSubjectPrefix+group)
+		}
+		bindings = append(bindings, fmt.Sprintf("%s=%s", binding.Role, strings.Join(subjects, "+")))
+	}
+	return strings.Join(bindings, ",")
+}
@@ -2,10 +2,12 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"sort"
+	"text/tabwriter"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
@@ -18,13 +20,13 @@ func init() {
 }
 
 var infoCmd = &cobra.Command{
-	Use:   "info queue",
+	Use:   "info [queue]",
 	Short: "Prints out queue info including all jobs sets where jobs are running or queued.",
 	Long:  `Prints out queue info including all jobs sets where jobs are running or queued.`,
 
-	Args: cobra.ExactArgs(1),
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		queue := args[0]
+		queue := getQueueArg(args)
 
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 
@@ -42,14 +44,24 @@ var infoCmd = &cobra.Command{
 				return jobSets[i].Name < jobSets[j].Name
 			})
 
-			log.Infof("Queue %s:", queueInfo.Name)
-			if len(jobSets) == 0 {
-				log.Info("No job queued or running.")
+			if printStructured(queueInfo) {
+				return
 			}
 
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			if outputFormat == outputWide {
+				fmt.Fprintln(w, "QUEUE\tJOB SET\tQUEUED\tIN CLUSTER")
+			} else {
+				fmt.Fprintln(w, "JOB SET\tQUEUED\tIN CLUSTER")
+			}
 			for _, jobSet := range jobSets {
-				log.Infof("in cluster: %d, queued: %d - %s", jobSet.LeasedJobs, jobSet.QueuedJobs, jobSet.Name)
+				if outputFormat == outputWide {
+					fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", queueInfo.Name, jobSet.Name, jobSet.QueuedJobs, jobSet.LeasedJobs)
+				} else {
+					fmt.Fprintf(w, "%s\t%d\t%d\n", jobSet.Name, jobSet.QueuedJobs, jobSet.LeasedJobs)
+				}
 			}
+			w.Flush()
 		})
 	},
 }
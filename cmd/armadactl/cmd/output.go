@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"gopkg.in/yaml.v2"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	outputTable = "table"
+	outputWide  = "wide"
+	outputJson  = "json"
+	outputYaml  = "yaml"
+)
+
+// Exit codes of armadactl, documented in docs/production-install.md
+const (
+	// Command failed, e.g. invalid arguments, unreachable server or rejected request
+	exitCodeError = 1
+	// Request succeeded only partially, e.g. some jobs of submission were rejected
+	exitCodePartialFailure = 2
+	// Watched jobs failed
+	exitCodeJobsFailed = 3
+)
+
+var outputFormat string
+
+func validateOutputFormat() error {
+	switch outputFormat {
+	case outputTable, outputWide, outputJson, outputYaml:
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q, supported formats are %s, %s, %s and %s",
+			outputFormat, outputTable, outputWide, outputJson, outputYaml)
+	}
+}
+
+func isStructuredOutput() bool {
+	return outputFormat == outputJson || outputFormat == outputYaml
+}
+
+// printStructured prints value as json or yaml document when such output is requested, commands print
+// table output themselves when it returns false
+func printStructured(value interface{}) bool {
+	switch outputFormat {
+	case outputJson:
+		data, e := marshalJson(value)
+		if e != nil {
+			exitWithError(e)
+		}
+		var indented bytes.Buffer
+		if e = json.Indent(&indented, data, "", "  "); e != nil {
+			exitWithError(e)
+		}
+		fmt.Println(indented.String())
+	case outputYaml:
+		printYaml(value)
+	default:
+		return false
+	}
+	return true
+}
+
+// printStructuredItem prints one item of a stream, as json line or separate yaml document
+func printStructuredItem(value interface{}) {
+	switch outputFormat {
+	case outputJson:
+		data, e := marshalJson(value)
+		if e != nil {
+			exitWithError(e)
+		}
+		fmt.Println(string(data))
+	case outputYaml:
+		fmt.Println("---")
+		printYaml(value)
+	}
+}
+
+// printYaml converts value through json, so the yaml has the same field names and order as json output
+func printYaml(value interface{}) {
+	data, e := marshalJson(value)
+	if e != nil {
+		exitWithError(e)
+	}
+	// json is valid yaml, decoding it wrapped in MapSlice keeps order of keys in all nested objects
+	var document yaml.MapSlice
+	if e = yaml.Unmarshal(append(append([]byte(`{"value":`), data...), '}'), &document); e != nil {
+		exitWithError(e)
+	}
+	data, e = yaml.Marshal(document[0].Value)
+	if e != nil {
+		exitWithError(e)
+	}
+	fmt.Print(string(data))
+}
+
+// marshalJson uses protobuf json mapping for api messages, so enums are printed as names and all fields are present
+func marshalJson(value interface{}) ([]byte, error) {
+	if message, ok := value.(proto.Message); ok {
+		var buffer bytes.Buffer
+		e := (&jsonpb.Marshaler{EmitDefaults: true}).Marshal(&buffer, message)
+		return buffer.Bytes(), e
+	}
+	return json.Marshal(value)
+}
+
+type eventOutput struct {
+	Type  string          `json:"type"`
+	Event json.RawMessage `json:"event"`
+}
+
+func newEventOutput(event api.Event) *eventOutput {
+	data, e := marshalJson(event)
+	if e != nil {
+		exitWithError(e)
+	}
+	return &eventOutput{Type: reflect.TypeOf(event).Elem().Name(), Event: data}
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+const groupSubjectPrefix = "group:"
+
+var queueRoleFlags = map[string]api.QueueRole{
+	"viewers":    api.QueueRole_Viewer,
+	"submitters": api.QueueRole_Submitter,
+	"operators":  api.QueueRole_Operator,
+	"admins":     api.QueueRole_Admin,
+}
+
+func init() {
+	rootCmd.AddCommand(setQueueRolesCmd)
+	addQueueRoleFlags(setQueueRolesCmd)
+}
+
+var setQueueRolesCmd = &cobra.Command{
+	Use:   "set-queue-roles name",
+	Short: "Replace role bindings of queue",
+	Long: `Grants roles on the queue to users and groups, replacing all existing role bindings of the queue.
+Roles are viewer, submitter, operator and admin, each role includes rights of the previous ones.
+Groups are prefixed with "group:", for example: --operators alice,group:team-a`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+		roleBindings := getQueueRoleBindings(cmd)
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submissionClient := api.NewSubmitClient(conn)
+			e := client.SetQueueRoleBindings(submissionClient, queue, roleBindings)
+			if e != nil {
+				exitWithError(e)
+			}
+			if !printStructured(&api.QueueRoleBindingsRequest{Name: queue, RoleBindings: roleBindings}) {
+				log.Infof("Role bindings of queue %s updated.", queue)
+			}
+		})
+	},
+}
+
+func addQueueRoleFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("viewers", []string{}, "Comma separated list of users and groups allowed to watch events of the queue.")
+	cmd.Flags().StringSlice("submitters", []string{}, "Comma separated list of users and groups allowed to submit jobs to the queue.")
+	cmd.Flags().StringSlice("operators", []string{}, "Comma separated list of users and groups allowed to cancel and reprioritize any jobs of the queue.")
+	cmd.Flags().StringSlice("admins", []string{}, "Comma separated list of users and groups allowed to change role bindings of the queue.")
+}
+
+func getQueueRoleBindings(cmd *cobra.Command) []*api.QueueRoleBinding {
+	roleBindings := []*api.QueueRoleBinding{}
+	for _, flag := range []string{"viewers", "submitters", "operators", "admins"} {
+		subjects, _ := cmd.Flags().GetStringSlice(flag)
+		if len(subjects) == 0 {
+			continue
+		}
+		binding := &api.QueueRoleBinding{Role: queueRoleFlags[flag]}
+		for _, subject := range subjects {
+			if strings.HasPrefix(subject, groupSubjectPrefix) {
+				binding.Groups = append(binding.Groups, strings.TrimPrefix(subject, groupSubjectPrefix))
+			} else {
+				binding.Users = append(binding.Users, subject)
+			}
+		}
+		roleBindings = append(roleBindings, binding)
+	}
+	return roleBindings
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 
 	log "github.com/sirupsen/logrus"
@@ -18,7 +19,7 @@ func init() {
 	reprioritizeCmd.Flags().String(
 		"jobId", "", "Job to reprioritize")
 	reprioritizeCmd.Flags().String(
-		"queue", "", "Queue including jobs to be reprioritized (requires job set to be specified)")
+		"queue", "", "Queue including jobs to be reprioritized (requires job set to be specified, default queue of the context is used when omitted)")
 	reprioritizeCmd.Flags().String(
 		"jobSet", "", "Job set including jobs to be reprioritized (requires queue to be specified)")
 }
@@ -36,6 +37,7 @@ var reprioritizeCmd = &cobra.Command{
 		}
 
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+		defaultQueue := client.ExtractCommandlineDefaultQueue()
 
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			client := api.NewSubmitClient(conn)
@@ -43,6 +45,9 @@ var reprioritizeCmd = &cobra.Command{
 			jobId, _ := cmd.Flags().GetString("jobId")
 			queue, _ := cmd.Flags().GetString("queue")
 			jobSet, _ := cmd.Flags().GetString("jobSet")
+			if jobSet != "" && queue == "" {
+				queue = defaultQueue
+			}
 			var jobIds []string
 			if jobId != "" {
 				jobIds = append(jobIds, jobId)
@@ -60,19 +65,23 @@ var reprioritizeCmd = &cobra.Command{
 				exitWithError(err)
 			}
 
-			err = reportResults(result.ReprioritizationResults)
-			if err != nil {
-				exitWithError(err)
+			if len(result.ReprioritizationResults) == 0 {
+				exitWithError(fmt.Errorf("no jobs were reprioritized"))
+			}
+			if !printStructured(result) {
+				reportResults(result.ReprioritizationResults)
+			}
+			for _, errorString := range result.ReprioritizationResults {
+				if errorString != "" {
+					log.Error("Some jobs failed to be reprioritized")
+					os.Exit(exitCodePartialFailure)
+				}
 			}
 		})
 	},
 }
 
-func reportResults(results map[string]string) error {
-	if len(results) == 0 {
-		return fmt.Errorf("no jobs were reprioritized")
-	}
-
+func reportResults(results map[string]string) {
 	var reprioritizedIds []string
 	erroredIds := make(map[string]string)
 	for jobId, errorString := range results {
@@ -96,9 +105,4 @@ func reportResults(results map[string]string) error {
 			log.Infof("%s: %s", jobId, errorString)
 		}
 	}
-
-	if len(erroredIds) > 0 {
-		return fmt.Errorf("Some jobs failed to be reprioritized")
-	}
-	return nil
 }
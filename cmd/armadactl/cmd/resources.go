@@ -2,11 +2,13 @@ package cmd
 
 import (
 	"context"
+	"sort"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/client"
 )
@@ -16,6 +18,11 @@ func init() {
 	kubeCmd.FParseErrWhitelist.UnknownFlags = true
 }
 
+type jobResourcesOutput struct {
+	JobId            string                  `json:"jobId"`
+	MaxUsedResources common.ComputeResources `json:"maxUsedResources"`
+}
+
 var resourcesCmd = &cobra.Command{
 	Use:   "resources <queue> <jobSet>",
 	Short: "Prints out maximum resource usage for individual jobs.",
@@ -32,6 +39,18 @@ var resourcesCmd = &cobra.Command{
 			eventsClient := api.NewEventClient(conn)
 			state := client.GetJobSetState(eventsClient, queue, jobSetId, context.Background())
 
+			if isStructuredOutput() {
+				usage := []*jobResourcesOutput{}
+				for _, j := range state.GetCurrentState() {
+					usage = append(usage, &jobResourcesOutput{JobId: j.Job.Id, MaxUsedResources: j.MaxUsedResources})
+				}
+				sort.Slice(usage, func(i, j int) bool {
+					return usage[i].JobId < usage[j].JobId
+				})
+				printStructured(usage)
+				return
+			}
+
 			for _, j := range state.GetCurrentState() {
 				log.Infof("job id: %v, maximum used resources: %v", j.Job.Id, j.MaxUsedResources)
 			}
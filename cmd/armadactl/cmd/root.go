@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 
 	log "github.com/sirupsen/logrus"
@@ -12,6 +13,7 @@ import (
 func init() {
 	cobra.OnInitialize(initConfig)
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.armadactl.yaml)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", outputTable, "output format: table, wide, json or yaml")
 	client.AddArmadaApiConnectionCommandlineArgs(rootCmd)
 }
 
@@ -30,6 +32,12 @@ basicAuth:
   password: password123
 
 The location of this file can be passed in using --config argument or picked from $HOME/.armadactl.yaml.
+
+The file can also define named contexts for multiple armada instances, see armadactl config --help.
+
+Results are printed as tables by default, --output json or yaml prints them in format suitable for scripts.
+Exit code is 0 on success, 1 when the command fails, 2 when the request succeeds only partially (e.g. some jobs
+of submission are rejected) and 3 when watched jobs fail.
 `,
 }
 
@@ -46,9 +54,43 @@ var cfgFile string
 
 func initConfig() {
 	client.LoadCommandlineArgsFromConfigFile(cfgFile)
+	if e := validateOutputFormat(); e != nil {
+		exitWithError(e)
+	}
 }
 
 func exitWithError(e error) {
 	log.Error(e)
-	os.Exit(1)
+	os.Exit(exitCodeError)
+}
+
+// queueAndJobSetArgs accepts "queue jobSet" arguments, both can be omitted when the context has default queue and job set
+func queueAndJobSetArgs(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 && len(args) != 2 {
+		return fmt.Errorf("accepts queue and job set arguments or none to use defaults of the context, received %d", len(args))
+	}
+	return nil
+}
+
+func getQueueAndJobSet(args []string) (string, string) {
+	if len(args) == 2 {
+		return args[0], args[1]
+	}
+	queue := client.ExtractCommandlineDefaultQueue()
+	jobSetId := client.ExtractCommandlineDefaultJobSetId()
+	if queue == "" || jobSetId == "" {
+		exitWithError(fmt.Errorf("queue and job set are not specified and the context has no default queue and job set"))
+	}
+	return queue, jobSetId
+}
+
+func getQueueArg(args []string) string {
+	if len(args) == 1 {
+		return args[0]
+	}
+	queue := client.ExtractCommandlineDefaultQueue()
+	if queue == "" {
+		exitWithError(fmt.Errorf("queue is not specified and the context has no default queue"))
+	}
+	return queue
 }
@@ -1,9 +1,10 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"text/tabwriter"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
@@ -14,9 +15,19 @@ import (
 	"github.com/G-Research/armada/pkg/client/validation"
 )
 
+const (
+	dryRunNone   = "none"
+	dryRunClient = "client"
+	dryRunServer = "server"
+)
+
 func init() {
 	rootCmd.AddCommand(submitCmd)
-	submitCmd.Flags().Bool("dry-run", false, "Performs basic validation on the submitted file. Does no actual submission of jobs to the server.")
+	submitCmd.Flags().String("dry-run", dryRunNone,
+		`Validate jobs without submitting them, "client" validates the file locally and "server" asks armada server whether the jobs are valid and can be scheduled on any cluster.`)
+	submitCmd.Flags().Lookup("dry-run").NoOptDefVal = dryRunClient
+	submitCmd.Flags().StringArray("set", []string{}, "Set template value, e.g. --set image.tag=1.0, can be repeated.")
+	submitCmd.Flags().StringArrayP("values", "f", []string{}, "Yaml file with template values, can be repeated, later files override earlier ones.")
 }
 
 var submitCmd = &cobra.Command{
@@ -26,61 +37,208 @@ var submitCmd = &cobra.Command{
 
 	Example jobs.yaml:
 	
+	queue: test
+	jobSetId: set1
 	jobs:
-	  - queue: test
-		priority: 0
-		jobSetId: set1
+	  - priority: 0
 		podSpec:
 		  ... kubernetes pod spec ...
+
+	When --values files or --set flags are given, the file is a Go template, the values are available as .Values
+	and environment variables through env function, e.g. {{ .Values.image }} or {{ env "USER" }}.
+	Files using only environment variables opt in with "# armadactl: template" line, other files are submitted as they are.
+	The file can contain multiple yaml documents separated by "---", each with its own queue and job set.
+	Queue and job set default to the ones of the current context.
 `,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		dryRun, _ := cmd.Flags().GetBool("dry-run")
-		filePath := args[0]
-
-		ok, err := validation.ValidateSubmitFile(filePath)
-
-		if !ok {
-			log.Error(err)
-			os.Exit(1)
+		dryRun, _ := cmd.Flags().GetString("dry-run")
+		if dryRun != dryRunNone && dryRun != dryRunClient && dryRun != dryRunServer {
+			exitWithError(fmt.Errorf("unknown dry run mode %q, supported modes are %s, %s and %s", dryRun, dryRunNone, dryRunClient, dryRunServer))
 		}
+		valuesFiles, _ := cmd.Flags().GetStringArray("values")
+		setValues, _ := cmd.Flags().GetStringArray("set")
+		filePath := args[0]
 
-		submitFile := &domain.JobSubmitFile{}
-		err = util.BindJsonOrYaml(filePath, submitFile)
-
+		submitFiles, err := loadSubmitFiles(filePath, valuesFiles, setValues)
 		if err != nil {
 			exitWithError(err)
 		}
 
-		if dryRun {
+		if dryRun == dryRunClient {
+			output := &submitOutput{DryRun: dryRun, Jobs: []*submittedJob{}}
+			for _, submitFile := range submitFiles {
+				output.addValidated(submitFile)
+			}
+			printSubmitOutput(output)
+			if output.hasRejectedJobs() {
+				os.Exit(exitCodePartialFailure)
+			}
 			return
 		}
 
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 
-		requests := client.CreateChunkedSubmitRequests(submitFile.Queue, submitFile.JobSetId, submitFile.Jobs)
-
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			submissionClient := api.NewSubmitClient(conn)
-			for _, request := range requests {
-				response, e := client.SubmitJobs(submissionClient, request)
-
-				if e != nil {
-					exitWithError(e)
+			output := &submitOutput{Jobs: []*submittedJob{}}
+			if dryRun == dryRunServer {
+				output.DryRun = dryRun
+			}
+			for _, submitFile := range submitFiles {
+				requests := client.CreateChunkedSubmitRequests(submitFile.Queue, submitFile.JobSetId, submitFile.Jobs)
+				index := 0
+				for _, request := range requests {
+					request.ReturnDuplicateStatus = true
+					request.DryRun = dryRun == dryRunServer
+					response, e := client.SubmitJobs(submissionClient, request)
+
+					if e != nil {
+						// Jobs of previous requests are submitted already, print them before failing
+						printSubmitOutput(output)
+						exitWithError(e)
+					}
+
+					output.add(request, response, index)
+					index += len(request.JobRequestItems)
 				}
-
-				summariseResponse(response, request.JobSetId)
+			}
+			printSubmitOutput(output)
+			if output.hasRejectedJobs() {
+				os.Exit(exitCodePartialFailure)
 			}
 		})
 	},
 }
 
-func summariseResponse(response *api.JobSubmitResponse, jobSetId string) {
-	for _, jobResponseItem := range response.JobResponseItems {
-		if jobResponseItem.Error != "" {
-			log.Errorf("Failed to submit job because: %s", jobResponseItem.Error)
+// loadSubmitFiles renders the submit file template and parses all its documents
+func loadSubmitFiles(filePath string, valuesFiles []string, setValues []string) ([]*domain.JobSubmitFile, error) {
+	values, e := client.LoadTemplateValues(valuesFiles, setValues)
+	if e != nil {
+		return nil, e
+	}
+	rendered, e := client.RenderSubmitFile(filePath, values)
+	if e != nil {
+		return nil, e
+	}
+	documents, e := util.SplitYamlDocuments(rendered)
+	if e != nil {
+		return nil, fmt.Errorf("Failed to parse file %s because: %v", filePath, e)
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("Warning: You have provided no jobs to submit.")
+	}
+
+	submitFiles := make([]*domain.JobSubmitFile, 0, len(documents))
+	for i, document := range documents {
+		ok, e := validation.ValidateSubmitFileData(document)
+		if !ok {
+			return nil, fmt.Errorf("document %d of file %s: %v", i, filePath, e)
+		}
+
+		submitFile := &domain.JobSubmitFile{}
+		e = util.BindJsonOrYamlData(document, submitFile)
+		if e != nil {
+			return nil, fmt.Errorf("Failed to parse document %d of file %s because: %v", i, filePath, e)
+		}
+		if submitFile.Queue == "" {
+			submitFile.Queue = client.ExtractCommandlineDefaultQueue()
+		}
+		if submitFile.JobSetId == "" {
+			submitFile.JobSetId = client.ExtractCommandlineDefaultJobSetId()
+		}
+		submitFiles = append(submitFiles, submitFile)
+	}
+	return submitFiles, nil
+}
+
+type submitOutput struct {
+	// Dry run mode, jobs were only validated when set
+	DryRun string          `json:"dryRun,omitempty"`
+	Jobs   []*submittedJob `json:"jobs"`
+}
+
+type submittedJob struct {
+	Queue    string `json:"queue"`
+	JobSetId string `json:"jobSetId"`
+	// Position of the job in its document of the submitted file
+	Index             int    `json:"index"`
+	ClientId          string `json:"clientId,omitempty"`
+	JobId             string `json:"jobId,omitempty"`
+	Duplicate         bool   `json:"duplicate"`
+	OriginalJobStatus string `json:"originalJobStatus,omitempty"`
+	Error             string `json:"error,omitempty"`
+}
+
+// add pairs response items with the requested jobs, the server returns them in the same order
+func (o *submitOutput) add(request *api.JobSubmitRequest, response *api.JobSubmitResponse, firstIndex int) {
+	for i, item := range response.JobResponseItems {
+		job := &submittedJob{
+			Queue:     request.Queue,
+			JobSetId:  request.JobSetId,
+			Index:     firstIndex + i,
+			JobId:     item.JobId,
+			Duplicate: item.Duplicate,
+			Error:     item.Error,
+		}
+		if i < len(request.JobRequestItems) {
+			job.ClientId = request.JobRequestItems[i].ClientId
+		}
+		if item.Duplicate {
+			job.OriginalJobStatus = item.OriginalJobStatus.String()
+		}
+		o.Jobs = append(o.Jobs, job)
+	}
+}
+
+// addValidated validates jobs of the document locally
+func (o *submitOutput) addValidated(submitFile *domain.JobSubmitFile) {
+	for i, item := range submitFile.Jobs {
+		job := &submittedJob{Queue: submitFile.Queue, JobSetId: submitFile.JobSetId, Index: i, ClientId: item.ClientId}
+		if submitFile.Queue == "" {
+			job.Error = "queue is not specified"
+		} else if submitFile.JobSetId == "" {
+			job.Error = "job set is not specified"
+		} else if e := validation.ValidateSubmitRequestItem(item); e != nil {
+			job.Error = e.Error()
+		}
+		o.Jobs = append(o.Jobs, job)
+	}
+}
+
+func (o *submitOutput) hasRejectedJobs() bool {
+	for _, job := range o.Jobs {
+		if job.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func printSubmitOutput(output *submitOutput) {
+	if printStructured(output) {
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	if outputFormat == outputWide {
+		fmt.Fprintln(w, "JOB ID\tCLIENT ID\tQUEUE\tJOB SET\tINDEX\tRESULT")
+	} else {
+		fmt.Fprintln(w, "JOB ID\tJOB SET\tINDEX\tRESULT")
+	}
+	for _, job := range output.Jobs {
+		result := "submitted"
+		if job.Error != "" {
+			result = "rejected: " + job.Error
+		} else if job.Duplicate {
+			result = "duplicate of job in status " + job.OriginalJobStatus
+		} else if output.DryRun != "" {
+			result = "valid"
+		}
+		if outputFormat == outputWide {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", job.JobId, job.ClientId, job.Queue, job.JobSetId, job.Index, result)
 		} else {
-			log.Infof("Submitted job id: %s (set: %s)", jobResponseItem.JobId, jobSetId)
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", job.JobId, job.JobSetId, job.Index, result)
 		}
 	}
+	w.Flush()
 }
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(updateCmd)
+	updateCmd.AddCommand(updateQueueCmd)
+	updateQueueCmd.Flags().Float64(
+		"priorityFactor", 1,
+		"Set queue priority factor - lower number makes queue more important, must be > 0.")
+	updateQueueCmd.Flags().StringSlice(
+		"owners", []string{},
+		"Comma separated list of queue owners.")
+	updateQueueCmd.Flags().StringSlice(
+		"groupOwners", []string{},
+		"Comma separated list of queue group owners.")
+	updateQueueCmd.Flags().StringToString(
+		"resourceLimits", map[string]string{},
+		"Command separated list of resource limits pairs. Example: --resourceLimits cpu=0.3,memory=0.2")
+	addQueueRoleFlags(updateQueueCmd)
+}
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update existing resource",
+}
+
+var updateQueueCmd = &cobra.Command{
+	Use:   "queue name",
+	Short: "Update existing queue",
+	Long: `Changes only properties of the queue specified by flags, other properties are kept.
+Role flags (--viewers, --submitters, --operators, --admins) replace all role bindings of the queue when any of them is specified.
+The update fails if the queue is changed by someone else at the same time.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			queue, e := getQueue(ctx, submitClient, name)
+			if e != nil {
+				exitWithError(e)
+			}
+
+			flags := cmd.Flags()
+			if flags.Changed("priorityFactor") {
+				queue.PriorityFactor, _ = flags.GetFloat64("priorityFactor")
+			}
+			if flags.Changed("owners") {
+				queue.UserOwners, _ = flags.GetStringSlice("owners")
+			}
+			if flags.Changed("groupOwners") {
+				queue.GroupOwners, _ = flags.GetStringSlice("groupOwners")
+			}
+			if flags.Changed("resourceLimits") {
+				resourceLimits, _ := flags.GetStringToString("resourceLimits")
+				queue.ResourceLimits, e = convertResourceLimitsToFloat64(resourceLimits)
+				if e != nil {
+					exitWithError(e)
+				}
+			}
+			for flag := range queueRoleFlags {
+				if flags.Changed(flag) {
+					queue.RoleBindings = getQueueRoleBindings(cmd)
+					break
+				}
+			}
+
+			updated, e := submitClient.UpdateQueue(ctx, queue)
+			if e != nil {
+				exitWithError(e)
+			}
+			if !printStructured(updated) {
+				log.Infof("Queue %s updated to version %d.", updated.Name, updated.Version)
+			}
+		})
+	},
+}
+
+func getQueue(ctx context.Context, submitClient api.SubmitClient, name string) (*api.Queue, error) {
+	queues, e := submitClient.GetQueues(ctx, &types.Empty{})
+	if e != nil {
+		return nil, e
+	}
+	for _, queue := range queues.Queues {
+		if queue.Name == name {
+			return queue, nil
+		}
+	}
+	return nil, fmt.Errorf("queue %s does not exist or you are not allowed to view it", name)
+}
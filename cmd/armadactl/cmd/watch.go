@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"reflect"
 	"time"
 
@@ -20,29 +21,37 @@ func init() {
 	rootCmd.AddCommand(watchCmd)
 	watchCmd.Flags().Bool("raw", false, "Output raw events")
 	watchCmd.Flags().Bool("exit-if-inactive", false, "Exit if there are no more active jobs")
+	watchCmd.Flags().Bool("from-snapshot", false, "Start from the current job set state instead of replaying all events")
 }
 
 // watchCmd represents the watch command
 var watchCmd = &cobra.Command{
-	Use:   "watch queue jobSet",
+	Use:   "watch [queue jobSet]",
 	Short: "Watch job events in job set.",
-	Long:  `This command will list all job set events and `,
-	Args:  cobra.ExactArgs(2),
+	Long: `This command will list all job set events and exits once the job set is completed (closed and all its jobs finished).
+With --output json or yaml events are printed as json lines or yaml documents with type and event fields.
+Exits with code 3 when any of the watched jobs failed.`,
+	Args: queueAndJobSetArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-
-		queue := args[0]
-		jobSetId := args[1]
+		queue, jobSetId := getQueueAndJobSet(args)
 
 		raw, _ := cmd.Flags().GetBool("raw")
 		exit_on_inactive, _ := cmd.Flags().GetBool("exit-if-inactive")
+		fromSnapshot, _ := cmd.Flags().GetBool("from-snapshot")
 		log.Infof("Watching job set %s", jobSetId)
 
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			eventsClient := api.NewEventClient(conn)
-			client.WatchJobSet(eventsClient, queue, jobSetId, true, context.Background(), func(state *domain.WatchContext, e api.Event) bool {
-				if raw {
+			watch := client.WatchJobSet
+			if fromSnapshot {
+				watch = client.WatchJobSetFromSnapshot
+			}
+			state := watch(eventsClient, queue, jobSetId, true, context.Background(), func(state *domain.WatchContext, e api.Event) bool {
+				if isStructuredOutput() {
+					printStructuredItem(newEventOutput(e))
+				} else if raw {
 					data, err := json.Marshal(e)
 					if err != nil {
 						log.Error(e)
@@ -54,6 +63,9 @@ var watchCmd = &cobra.Command{
 					switch event := e.(type) {
 					case *api.JobUtilisationEvent:
 						// no print
+					case *api.JobSetCompletedEvent:
+						log.Infof("Job set completed in %s | Submitted: %d, Succeeded: %d, Failed: %d, Cancelled: %d",
+							event.Duration, event.Submitted, event.Succeeded, event.Failed, event.Cancelled)
 					case *api.JobFailedEvent:
 						printSummary(state, e)
 						log.Errorf("Failure reason:\n%s\n", event.Reason)
@@ -67,11 +79,17 @@ var watchCmd = &cobra.Command{
 						printSummary(state, e)
 					}
 				}
+				if state.IsJobSetCompleted() {
+					return true
+				}
 				if exit_on_inactive && state.GetNumberOfJobs() == state.GetNumberOfFinishedJobs() {
 					return true
 				}
 				return false
 			})
+			if state.GetNumberOfJobsInStates([]domain.JobStatus{domain.Failed}) > 0 {
+				os.Exit(exitCodeJobsFailed)
+			}
 		})
 	},
 }
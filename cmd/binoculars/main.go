@@ -42,6 +42,7 @@ func main() {
 	shutdownGateway := grpc.ServeGateway(
 		config.HttpPort,
 		config.GrpcPort,
+		config.Auth.ClientCertAuth.Enabled(),
 		config.CorsAllowedOrigins,
 		api.SwaggerJsonTemplate(),
 		api.RegisterBinocularsHandler,
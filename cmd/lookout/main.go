@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
@@ -9,14 +10,16 @@ import (
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	grpcApi "google.golang.org/grpc"
 
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/database"
 	"github.com/G-Research/armada/internal/common/grpc"
 	"github.com/G-Research/armada/internal/common/serve"
 	"github.com/G-Research/armada/internal/lookout"
 	"github.com/G-Research/armada/internal/lookout/configuration"
-	"github.com/G-Research/armada/internal/lookout/postgres"
 	"github.com/G-Research/armada/internal/lookout/repository/schema"
+	"github.com/G-Research/armada/internal/lookout/server"
 	lookoutApi "github.com/G-Research/armada/pkg/api/lookout"
 )
 
@@ -38,7 +41,7 @@ func main() {
 	common.LoadConfig(&config, "./config/lookout", userSpecifiedConfigs)
 
 	if viper.GetBool(MigrateDatabase) {
-		db, err := postgres.Open(config.Postgres)
+		db, err := database.OpenPostgres(config.Postgres)
 		if err != nil {
 			panic(err)
 		}
@@ -56,13 +59,23 @@ func main() {
 	shutdownMetricServer := common.ServeMetrics(config.MetricsPort)
 	defer shutdownMetricServer()
 
+	grpcTls := config.Auth.ClientCertAuth.Enabled()
 	mux, shutdownGateway := grpc.CreateGatewayHandler(
 		config.GrpcPort,
+		grpcTls,
 		"/api/",
 		[]string{},
 		lookoutApi.SwaggerJsonTemplate(),
 		lookoutApi.RegisterLookoutHandler)
 
+	// resource consumption report export
+	conn, err := grpcApi.Dial(fmt.Sprintf(":%d", config.GrpcPort), grpc.LocalTransportCredentials(grpcTls))
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	mux.Handle("/api/v1/lookout/consumption.csv", server.NewResourceConsumptionCsvHandler(lookoutApi.NewLookoutClient(conn)))
+
 	// UI config
 	mux.HandleFunc("/config", func(w http.ResponseWriter, r *http.Request) {
 		configHandler(config.UIConfig, w)
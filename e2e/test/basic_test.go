@@ -11,6 +11,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
@@ -141,8 +143,12 @@ func submitJobsAndWatch(t *testing.T, submitClient api.SubmitClient, eventsClien
 	return receivedEvents
 }
 
+// createQueue creates the queue shared by tests, it is created by the first test and reused by the rest of them
 func createQueue(submitClient api.SubmitClient, jobRequest *api.JobSubmitRequest, t *testing.T) {
 	err := client.CreateQueue(submitClient, &api.Queue{Name: jobRequest.Queue, PriorityFactor: 1})
+	if status.Code(err) == codes.AlreadyExists {
+		return
+	}
 	assert.Nil(t, err)
 }
 
@@ -0,0 +1,124 @@
+package archive
+
+import (
+	"net/url"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type batchKey struct {
+	queue string
+	hour  time.Time
+}
+
+// Archiver buffers events by queue and hour of their creation and writes every batch as a separate file to the sink.
+// Events are acknowledged to their source only after all buffered events are written, so no event is lost
+// when the server stops, but events can be archived more than once.
+// While the sink fails, events stay buffered until the buffer is full, then new events are refused,
+// so they are neither acknowledged nor consumed until the sink recovers.
+type Archiver struct {
+	sink              Sink
+	format            string
+	maxBatchSize      int
+	maxBufferedEvents int
+
+	mutex   sync.Mutex
+	batches map[batchKey][]*api.EventMessage
+	size    int
+	acks    []func() error
+}
+
+func NewArchiver(sink Sink, format string, maxBatchSize int, maxBufferedEvents int) *Archiver {
+	if maxBufferedEvents < maxBatchSize {
+		maxBufferedEvents = maxBatchSize
+	}
+	return &Archiver{
+		sink:              sink,
+		format:            format,
+		maxBatchSize:      maxBatchSize,
+		maxBufferedEvents: maxBufferedEvents,
+		batches:           map[batchKey][]*api.EventMessage{},
+	}
+}
+
+// Full returns true when no more events can be buffered, sources should stop consuming events until it returns false
+func (a *Archiver) Full() bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.full()
+}
+
+func (a *Archiver) full() bool {
+	return a.size >= a.maxBufferedEvents || len(a.acks) >= a.maxBufferedEvents
+}
+
+// Add buffers events, ack is called once the events are written.
+// Returns false when the buffer is full, the events are not buffered then and have to be consumed again later.
+func (a *Archiver) Add(events []*api.EventMessage, ack func() error) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.full() {
+		return false
+	}
+
+	for _, message := range events {
+		event, e := api.UnwrapEvent(message)
+		if e != nil {
+			log.Errorf("Skipping archiving of unknown event: %v", e)
+			continue
+		}
+		key := batchKey{queue: event.GetQueue(), hour: event.GetCreated().UTC().Truncate(time.Hour)}
+		a.batches[key] = append(a.batches[key], message)
+		a.size++
+	}
+	a.acks = append(a.acks, ack)
+
+	if a.size >= a.maxBatchSize {
+		a.flush()
+	}
+	return true
+}
+
+func (a *Archiver) Flush() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.flush()
+}
+
+func (a *Archiver) flush() {
+	for key, batch := range a.batches {
+		filePath := archivePath(key, a.format)
+		data, e := Encode(a.format, batch)
+		if e == nil {
+			e = a.sink.Write(filePath, data)
+		}
+		if e != nil {
+			// the batch is kept and written with the next flush
+			log.Errorf("Error while archiving %d events to %s: %v", len(batch), filePath, e)
+			continue
+		}
+		delete(a.batches, key)
+		a.size -= len(batch)
+	}
+
+	if len(a.batches) > 0 {
+		return
+	}
+	for _, ack := range a.acks {
+		if e := ack(); e != nil {
+			log.Errorf("Error while acknowledging archived events: %v", e)
+		}
+	}
+	a.acks = nil
+}
+
+// Files of a batch are stored as {queue}/{date}/{hour}/{ulid}.{format}, so they are listed in the order of writing
+func archivePath(key batchKey, format string) string {
+	return url.PathEscape(key.queue) + "/" + key.hour.Format("2006-01-02/15") + "/" + util.NewULID() + "." + format
+}
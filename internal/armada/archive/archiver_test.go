@@ -0,0 +1,167 @@
+package archive
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestArchiver_WritesBatchesByQueueAndHour(t *testing.T) {
+	for _, format := range []string{FormatJsonl, FormatParquet} {
+		withLocalSink(t, func(sink *LocalSink) {
+			archiver := NewArchiver(sink, format, 100, 100)
+			acked := 0
+			created := time.Date(2021, 3, 1, 10, 15, 0, 0, time.UTC)
+
+			archiver.Add([]*api.EventMessage{
+				wrap(t, &api.JobSubmittedEvent{JobId: "1", JobSetId: "set", Queue: "queue", Created: created}),
+				wrap(t, &api.JobQueuedEvent{JobId: "1", JobSetId: "set", Queue: "queue", Created: created.Add(time.Minute)}),
+				wrap(t, &api.JobSubmittedEvent{JobId: "2", JobSetId: "set", Queue: "other", Created: created}),
+				wrap(t, &api.JobSucceededEvent{JobId: "1", JobSetId: "set", Queue: "queue", Created: created.Add(time.Hour)}),
+			}, func() error { acked++; return nil })
+			assert.Equal(t, 0, acked)
+
+			archiver.Flush()
+			assert.Equal(t, 1, acked)
+
+			paths, e := sink.List("queue/")
+			assert.Nil(t, e)
+			assert.Equal(t, 2, len(paths))
+
+			paths, e = sink.List("queue/2021-03-01/10/")
+			assert.Nil(t, e)
+			assert.Equal(t, 1, len(paths))
+			assert.Equal(t, format, FormatFromPath(paths[0]))
+
+			data, e := sink.Read(paths[0])
+			assert.Nil(t, e)
+			messages, e := Decode(format, data)
+			assert.Nil(t, e)
+			assert.Equal(t, 2, len(messages))
+			assert.Equal(t, "1", messages[0].GetSubmitted().JobId)
+			assert.Equal(t, created, messages[0].GetSubmitted().Created)
+			assert.NotNil(t, messages[1].GetQueued())
+		})
+	}
+}
+
+func TestArchiver_FlushesWhenBatchIsFull(t *testing.T) {
+	withLocalSink(t, func(sink *LocalSink) {
+		archiver := NewArchiver(sink, FormatJsonl, 2, 2)
+		acked := 0
+		ack := func() error { acked++; return nil }
+
+		archiver.Add([]*api.EventMessage{wrap(t, &api.JobSubmittedEvent{JobId: "1", Queue: "queue"})}, ack)
+		assert.Equal(t, 0, acked)
+
+		archiver.Add([]*api.EventMessage{wrap(t, &api.JobSubmittedEvent{JobId: "2", Queue: "queue"})}, ack)
+		assert.Equal(t, 2, acked)
+	})
+}
+
+func TestArchiver_KeepsEventsWhenWriteFails(t *testing.T) {
+	sink := &failingSink{fail: true}
+	archiver := NewArchiver(sink, FormatJsonl, 100, 100)
+	acked := 0
+
+	archiver.Add([]*api.EventMessage{wrap(t, &api.JobSubmittedEvent{JobId: "1", Queue: "queue"})}, func() error { acked++; return nil })
+	archiver.Flush()
+	assert.Equal(t, 0, acked)
+
+	sink.fail = false
+	archiver.Flush()
+	assert.Equal(t, 1, acked)
+	assert.Equal(t, 1, sink.writes)
+}
+
+func TestArchiver_RefusesEventsWhenBufferIsFull(t *testing.T) {
+	sink := &failingSink{fail: true}
+	archiver := NewArchiver(sink, FormatJsonl, 1, 2)
+	acked := 0
+	ack := func() error { acked++; return nil }
+
+	assert.True(t, archiver.Add([]*api.EventMessage{wrap(t, &api.JobSubmittedEvent{JobId: "1", Queue: "queue"})}, ack))
+	assert.False(t, archiver.Full())
+	assert.True(t, archiver.Add([]*api.EventMessage{wrap(t, &api.JobSubmittedEvent{JobId: "2", Queue: "queue"})}, ack))
+	assert.True(t, archiver.Full())
+	assert.False(t, archiver.Add([]*api.EventMessage{wrap(t, &api.JobSubmittedEvent{JobId: "3", Queue: "queue"})}, ack))
+	assert.Equal(t, 0, acked)
+
+	sink.fail = false
+	archiver.Flush()
+	assert.Equal(t, 2, acked)
+	assert.False(t, archiver.Full())
+}
+
+func TestExport_FiltersEvents(t *testing.T) {
+	withLocalSink(t, func(sink *LocalSink) {
+		archiver := NewArchiver(sink, FormatJsonl, 100, 100)
+		created := time.Date(2021, 3, 1, 10, 15, 0, 0, time.UTC)
+
+		archiver.Add([]*api.EventMessage{
+			wrap(t, &api.JobSubmittedEvent{JobId: "1", JobSetId: "set", Queue: "queue", Created: created}),
+			wrap(t, &api.JobSubmittedEvent{JobId: "2", JobSetId: "other", Queue: "queue", Created: created}),
+			wrap(t, &api.JobSucceededEvent{JobId: "1", JobSetId: "set", Queue: "queue", Created: created.Add(2 * time.Hour)}),
+			wrap(t, &api.JobSubmittedEvent{JobId: "3", JobSetId: "set", Queue: "other", Created: created}),
+		}, func() error { return nil })
+		archiver.Flush()
+
+		assert.Equal(t, []string{"1", "2", "1"}, exportedJobIds(t, sink, &ExportFilter{Queue: "queue"}))
+		assert.Equal(t, []string{"1", "1"}, exportedJobIds(t, sink, &ExportFilter{Queue: "queue", JobSetId: "set"}))
+		assert.Equal(t, []string{"1"}, exportedJobIds(t, sink, &ExportFilter{Queue: "queue", JobSetId: "set", From: created.Add(time.Hour)}))
+		assert.Equal(t, []string{"1", "2"}, exportedJobIds(t, sink, &ExportFilter{Queue: "queue", To: created}))
+	})
+}
+
+func exportedJobIds(t *testing.T, sink Sink, filter *ExportFilter) []string {
+	jobIds := []string{}
+	e := Export(sink, filter, func(message *api.EventMessage) error {
+		event, e := api.UnwrapEvent(message)
+		assert.Nil(t, e)
+		jobIds = append(jobIds, event.GetJobId())
+		return nil
+	})
+	assert.Nil(t, e)
+	return jobIds
+}
+
+func wrap(t *testing.T, event api.Event) *api.EventMessage {
+	message, e := api.Wrap(event)
+	assert.Nil(t, e)
+	return message
+}
+
+func withLocalSink(t *testing.T, action func(sink *LocalSink)) {
+	directory, e := ioutil.TempDir("", "armada-archive")
+	assert.Nil(t, e)
+	defer os.RemoveAll(directory)
+
+	action(NewLocalSink(directory))
+}
+
+type failingSink struct {
+	fail   bool
+	writes int
+}
+
+func (s *failingSink) Write(path string, data []byte) error {
+	if s.fail {
+		return fmt.Errorf("write failed")
+	}
+	s.writes++
+	return nil
+}
+
+func (s *failingSink) List(prefix string) ([]string, error) {
+	return []string{}, nil
+}
+
+func (s *failingSink) Read(path string) ([]byte, error) {
+	return nil, fmt.Errorf("not found")
+}
@@ -0,0 +1,83 @@
+package archive
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// ExportFilter selects archived events, zero values match all events
+type ExportFilter struct {
+	Queue    string
+	JobSetId string
+	From     time.Time
+	To       time.Time
+}
+
+// Export reads archived events of the queue in the order they were archived and calls onEvent for every event matching the filter
+func Export(sink Sink, filter *ExportFilter, onEvent func(*api.EventMessage) error) error {
+	paths, e := sink.List(url.PathEscape(filter.Queue) + "/")
+	if e != nil {
+		return e
+	}
+	sort.Strings(paths)
+
+	for _, filePath := range paths {
+		if !filter.matchesFile(filePath) {
+			continue
+		}
+		data, e := sink.Read(filePath)
+		if e != nil {
+			return e
+		}
+		messages, e := Decode(FormatFromPath(filePath), data)
+		if e != nil {
+			return e
+		}
+		for _, message := range messages {
+			event, e := api.UnwrapEvent(message)
+			if e != nil || !filter.matchesEvent(event) {
+				continue
+			}
+			if e := onEvent(message); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+// Files are skipped based on the hour in their path, see archivePath
+func (f *ExportFilter) matchesFile(filePath string) bool {
+	parts := strings.Split(filePath, "/")
+	if len(parts) != 4 {
+		return false
+	}
+	hour, e := time.Parse("2006-01-02/15", parts[1]+"/"+parts[2])
+	if e != nil {
+		return false
+	}
+	if !f.From.IsZero() && hour.Add(time.Hour).Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && hour.After(f.To) {
+		return false
+	}
+	return true
+}
+
+func (f *ExportFilter) matchesEvent(event api.Event) bool {
+	if f.JobSetId != "" && event.GetJobSetId() != f.JobSetId {
+		return false
+	}
+	if !f.From.IsZero() && event.GetCreated().Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && event.GetCreated().After(f.To) {
+		return false
+	}
+	return true
+}
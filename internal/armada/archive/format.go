@@ -0,0 +1,200 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	FormatJsonl   = "jsonl"
+	FormatParquet = "parquet"
+)
+
+// Both formats contain the event as JSON for offline analysis and as protobuf, so it can be read back losslessly
+type jsonlRecord struct {
+	Queue     string          `json:"queue"`
+	JobSetId  string          `json:"jobSetId"`
+	JobId     string          `json:"jobId,omitempty"`
+	EventType string          `json:"eventType"`
+	Created   time.Time       `json:"created"`
+	Event     json.RawMessage `json:"event"`
+	Message   []byte          `json:"message"`
+}
+
+type parquetRecord struct {
+	Queue     string `parquet:"name=queue, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	JobSetId  string `parquet:"name=job_set_id, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	JobId     string `parquet:"name=job_id, type=UTF8"`
+	EventType string `parquet:"name=event_type, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Created   int64  `parquet:"name=created, type=TIMESTAMP_MILLIS"`
+	Event     string `parquet:"name=event, type=UTF8"`
+	Message   string `parquet:"name=message, type=BYTE_ARRAY"`
+}
+
+func ValidateFormat(format string) error {
+	if format != FormatJsonl && format != FormatParquet {
+		return fmt.Errorf("unknown event archive format %q, supported formats are %s and %s", format, FormatJsonl, FormatParquet)
+	}
+	return nil
+}
+
+// FormatFromPath returns format of archive file based on its extension
+func FormatFromPath(filePath string) string {
+	return strings.TrimPrefix(path.Ext(filePath), ".")
+}
+
+func Encode(format string, messages []*api.EventMessage) ([]byte, error) {
+	switch format {
+	case FormatJsonl:
+		return encodeJsonl(messages)
+	case FormatParquet:
+		return encodeParquet(messages)
+	}
+	return nil, ValidateFormat(format)
+}
+
+func Decode(format string, data []byte) ([]*api.EventMessage, error) {
+	switch format {
+	case FormatJsonl:
+		return decodeJsonl(data)
+	case FormatParquet:
+		return decodeParquet(data)
+	}
+	return nil, ValidateFormat(format)
+}
+
+func marshalMessage(message *api.EventMessage) (api.Event, []byte, []byte, error) {
+	event, e := api.UnwrapEvent(message)
+	if e != nil {
+		return nil, nil, nil, e
+	}
+	eventJson, e := json.Marshal(event)
+	if e != nil {
+		return nil, nil, nil, e
+	}
+	messageData, e := proto.Marshal(message)
+	if e != nil {
+		return nil, nil, nil, e
+	}
+	return event, eventJson, messageData, nil
+}
+
+func encodeJsonl(messages []*api.EventMessage) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, message := range messages {
+		event, eventJson, messageData, e := marshalMessage(message)
+		if e != nil {
+			return nil, e
+		}
+		e = encoder.Encode(&jsonlRecord{
+			Queue:     event.GetQueue(),
+			JobSetId:  event.GetJobSetId(),
+			JobId:     event.GetJobId(),
+			EventType: api.EventTypeName(event),
+			Created:   event.GetCreated(),
+			Event:     eventJson,
+			Message:   messageData,
+		})
+		if e != nil {
+			return nil, e
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeJsonl(data []byte) ([]*api.EventMessage, error) {
+	messages := []*api.EventMessage{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	// events with big job specs don't fit into the default buffer
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		record := &jsonlRecord{}
+		e := json.Unmarshal(scanner.Bytes(), record)
+		if e != nil {
+			return nil, e
+		}
+		message := &api.EventMessage{}
+		e = proto.Unmarshal(record.Message, message)
+		if e != nil {
+			return nil, e
+		}
+		messages = append(messages, message)
+	}
+	return messages, scanner.Err()
+}
+
+func encodeParquet(messages []*api.EventMessage) ([]byte, error) {
+	var buf bytes.Buffer
+	parquetWriter, e := writer.NewParquetWriterFromWriter(&buf, new(parquetRecord), 1)
+	if e != nil {
+		return nil, e
+	}
+	for _, message := range messages {
+		event, eventJson, messageData, e := marshalMessage(message)
+		if e != nil {
+			return nil, e
+		}
+		e = parquetWriter.Write(&parquetRecord{
+			Queue:     event.GetQueue(),
+			JobSetId:  event.GetJobSetId(),
+			JobId:     event.GetJobId(),
+			EventType: api.EventTypeName(event),
+			Created:   event.GetCreated().UnixNano() / int64(time.Millisecond),
+			Event:     string(eventJson),
+			Message:   string(messageData),
+		})
+		if e != nil {
+			return nil, e
+		}
+	}
+	e = parquetWriter.WriteStop()
+	if e != nil {
+		return nil, e
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeParquet(data []byte) ([]*api.EventMessage, error) {
+	file, e := buffer.NewBufferFile(data)
+	if e != nil {
+		return nil, e
+	}
+	parquetReader, e := reader.NewParquetReader(file, new(parquetRecord), 1)
+	if e != nil {
+		return nil, e
+	}
+	defer parquetReader.ReadStop()
+
+	records := make([]parquetRecord, parquetReader.GetNumRows())
+	e = parquetReader.Read(&records)
+	if e != nil {
+		return nil, e
+	}
+
+	messages := make([]*api.EventMessage, 0, len(records))
+	for _, record := range records {
+		message := &api.EventMessage{}
+		e = proto.Unmarshal([]byte(record.Message), message)
+		if e != nil {
+			return nil, e
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
@@ -0,0 +1,130 @@
+package archive
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nats-io/stan.go"
+	stanPb "github.com/nats-io/stan.go/pb"
+	"github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+
+	stanUtil "github.com/G-Research/armada/internal/common/stan-util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type KafkaArchiveProcessor struct {
+	reader   *kafka.Reader
+	archiver *Archiver
+}
+
+func NewKafkaArchiveProcessor(reader *kafka.Reader, archiver *Archiver) *KafkaArchiveProcessor {
+	return &KafkaArchiveProcessor{reader: reader, archiver: archiver}
+}
+
+func (p *KafkaArchiveProcessor) ProcessEvents() {
+	bg := context.Background()
+
+	// fetched messages cannot be returned to Kafka, so nothing is fetched until the archiver has space for them
+	if p.archiver.Full() {
+		return
+	}
+
+	messages := p.readMessagesBatch(bg, 500*time.Millisecond, 500)
+	if len(messages) == 0 {
+		return
+	}
+
+	events := []*api.EventMessage{}
+	for _, msg := range messages {
+		eventMessage := &api.EventMessage{}
+		err := proto.Unmarshal(msg.Value, eventMessage)
+		if err != nil {
+			log.Errorf("Error while unmarshaling kafka message: %v", err)
+			continue
+		}
+		events = append(events, eventMessage)
+	}
+
+	if !p.archiver.Add(events, func() error {
+		return p.reader.CommitMessages(bg, messages...)
+	}) {
+		log.Errorf("Event archive buffer is full, %d fetched events will be archived only after restart", len(events))
+	}
+}
+
+func (p *KafkaArchiveProcessor) readMessagesBatch(ctx context.Context, duration time.Duration, batchSize int) []kafka.Message {
+	timeout, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	messages := []kafka.Message{}
+	for i := 0; i < batchSize; i++ {
+		msg, err := p.reader.FetchMessage(timeout)
+		if err != nil {
+			if err != context.DeadlineExceeded {
+				log.Errorf("Error while reading kafka message: %v", err)
+			}
+			break
+		}
+		messages = append(messages, msg)
+	}
+	return messages
+}
+
+type NatsArchiveProcessor struct {
+	connection  *stanUtil.DurableConnection
+	archiver    *Archiver
+	subject     string
+	group       string
+	ackWait     time.Duration
+	maxInflight int
+}
+
+// NewNatsArchiveProcessor creates processor which keeps up to maxInflight messages unacknowledged,
+// ackWait has to be longer than the time events are buffered by the archiver, otherwise they are redelivered
+func NewNatsArchiveProcessor(
+	connection *stanUtil.DurableConnection,
+	archiver *Archiver,
+	subject string,
+	group string,
+	ackWait time.Duration,
+	maxInflight int) *NatsArchiveProcessor {
+
+	return &NatsArchiveProcessor{
+		connection:  connection,
+		archiver:    archiver,
+		subject:     subject,
+		group:       group,
+		ackWait:     ackWait,
+		maxInflight: maxInflight,
+	}
+}
+
+func (p *NatsArchiveProcessor) Start() {
+	err := p.connection.QueueSubscribe(p.subject, p.group,
+		p.handleMessage,
+		stan.SetManualAckMode(),
+		stan.StartAt(stanPb.StartPosition_LastReceived),
+		stan.DurableName(p.group),
+		stan.AckWait(p.ackWait),
+		stan.MaxInflight(p.maxInflight))
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (p *NatsArchiveProcessor) handleMessage(msg *stan.Msg) {
+	eventMessage := &api.EventMessage{}
+	err := proto.Unmarshal(msg.Data, eventMessage)
+	if err != nil {
+		log.Errorf("Error while unmarshaling nats message: %v", err)
+		if err := msg.Ack(); err != nil {
+			log.Errorf("Error while ack nats message: %v", err)
+		}
+		return
+	}
+	// message which is not acknowledged is redelivered after ackWait
+	p.archiver.Add([]*api.EventMessage{eventMessage}, msg.Ack)
+}
@@ -0,0 +1,155 @@
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+)
+
+// Sink stores archive files, paths are slash separated and relative to the root of the archive
+type Sink interface {
+	Write(path string, data []byte) error
+	// List returns paths of all files starting with the prefix
+	List(prefix string) ([]string, error)
+	Read(path string) ([]byte, error)
+}
+
+func NewSink(config *configuration.EventArchiveConfig) (Sink, error) {
+	if config.S3.Bucket != "" {
+		return NewS3Sink(&config.S3)
+	}
+	if config.Local.Directory != "" {
+		return NewLocalSink(config.Local.Directory), nil
+	}
+	return nil, fmt.Errorf("event archive requires either s3 bucket or local directory to be configured")
+}
+
+const temporaryFileSuffix = ".tmp"
+
+type LocalSink struct {
+	directory string
+}
+
+func NewLocalSink(directory string) *LocalSink {
+	return &LocalSink{directory: directory}
+}
+
+func (s *LocalSink) Write(path string, data []byte) error {
+	fullPath := filepath.Join(s.directory, filepath.FromSlash(path))
+	e := os.MkdirAll(filepath.Dir(fullPath), 0755)
+	if e != nil {
+		return e
+	}
+	// readers should never see partially written file
+	e = ioutil.WriteFile(fullPath+temporaryFileSuffix, data, 0644)
+	if e != nil {
+		return e
+	}
+	return os.Rename(fullPath+temporaryFileSuffix, fullPath)
+}
+
+func (s *LocalSink) List(prefix string) ([]string, error) {
+	paths := []string{}
+	e := filepath.Walk(s.directory, func(fullPath string, info os.FileInfo, e error) error {
+		if e != nil {
+			if os.IsNotExist(e) {
+				return nil
+			}
+			return e
+		}
+		if info.IsDir() || strings.HasSuffix(fullPath, temporaryFileSuffix) {
+			return nil
+		}
+		relativePath, e := filepath.Rel(s.directory, fullPath)
+		if e != nil {
+			return e
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		if strings.HasPrefix(relativePath, prefix) {
+			paths = append(paths, relativePath)
+		}
+		return nil
+	})
+	return paths, e
+}
+
+func (s *LocalSink) Read(path string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(s.directory, filepath.FromSlash(path)))
+}
+
+type S3Sink struct {
+	client s3iface.S3API
+	bucket string
+	prefix string
+}
+
+func NewS3Sink(config *configuration.S3ArchiveConfig) (*S3Sink, error) {
+	awsConfig := &aws.Config{
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.ForcePathStyle),
+	}
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+	}
+	// without static credentials the default chain is used (environment, shared config, instance role)
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, "")
+	}
+	awsSession, e := session.NewSession(awsConfig)
+	if e != nil {
+		return nil, e
+	}
+	return &S3Sink{client: s3.New(awsSession), bucket: config.Bucket, prefix: config.Prefix}, nil
+}
+
+func (s *S3Sink) Write(filePath string, data []byte) error {
+	_, e := s.client.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(path.Join(s.prefix, filePath)),
+		Body:   bytes.NewReader(data),
+	})
+	return e
+}
+
+func (s *S3Sink) List(prefix string) ([]string, error) {
+	keyPrefix := path.Join(s.prefix, prefix)
+	if strings.HasSuffix(prefix, "/") {
+		keyPrefix += "/"
+	}
+	paths := []string{}
+	e := s.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(keyPrefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			key := strings.TrimPrefix(aws.StringValue(object.Key), s.prefix)
+			paths = append(paths, strings.TrimPrefix(key, "/"))
+		}
+		return true
+	})
+	return paths, e
+}
+
+func (s *S3Sink) Read(filePath string) ([]byte, error) {
+	output, e := s.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(path.Join(s.prefix, filePath)),
+	})
+	if e != nil {
+		return nil, e
+	}
+	defer output.Body.Close()
+	return ioutil.ReadAll(output.Body)
+}
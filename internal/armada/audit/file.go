@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const maxRecordSize = 16 * 1024 * 1024
+
+// FileSink appends records to the file as json, one record per line
+type FileSink struct {
+	path  string
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	e := os.MkdirAll(filepath.Dir(path), 0755)
+	if e != nil {
+		return nil, e
+	}
+	file, e := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if e != nil {
+		return nil, e
+	}
+	return &FileSink{path: path, file: file}, nil
+}
+
+func (s *FileSink) Write(record *api.AuditRecord) error {
+	data, e := json.Marshal(record)
+	if e != nil {
+		return e
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, e = s.file.Write(append(data, '\n'))
+	return e
+}
+
+// Query scans the whole file, it is intended for occasional queries of moderately sized logs
+func (s *FileSink) Query(request *api.AuditLogRequest) ([]*api.AuditRecord, error) {
+	file, e := os.Open(s.path)
+	if e != nil {
+		return nil, e
+	}
+	defer file.Close()
+
+	records := []*api.AuditRecord{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	for scanner.Scan() {
+		record := &api.AuditRecord{}
+		e = json.Unmarshal(scanner.Bytes(), record)
+		if e != nil {
+			return nil, e
+		}
+		if !Matches(record, request) {
+			continue
+		}
+		records = append(records, record)
+		if request.Limit > 0 && int64(len(records)) > request.Limit {
+			records = records[1:]
+		}
+	}
+	if e = scanner.Err(); e != nil {
+		return nil, e
+	}
+
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+	return records, nil
+}
+
+func (s *FileSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.file.Close()
+}
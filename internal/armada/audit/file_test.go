@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestFileSink_QueryReturnsMatchingRecordsStartingWithMostRecent(t *testing.T) {
+	withFileSink(t, func(sink *FileSink) {
+		now := time.Now().UTC()
+		records := []*api.AuditRecord{
+			{Time: now.Add(-3 * time.Minute), Principal: "alice", Method: "/api.Submit/SubmitJobs", Queue: "queue1", JobIds: []string{"job1"}, Code: "OK"},
+			{Time: now.Add(-2 * time.Minute), Principal: "bob", Method: "/api.Submit/CancelJobs", Queue: "queue1", Code: "PermissionDenied"},
+			{Time: now.Add(-time.Minute), Principal: "alice", Method: "/api.Submit/CancelJobs", Queue: "queue2", Code: "OK"},
+			{Time: now, Principal: "alice", Method: "/api.Submit/DeleteQueue", Queue: "queue1", Code: "OK"},
+		}
+		for _, record := range records {
+			assert.Nil(t, sink.Write(record))
+		}
+
+		result, e := sink.Query(&api.AuditLogRequest{Principal: "alice", Queue: "queue1"})
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.AuditRecord{records[3], records[0]}, result)
+
+		from := now.Add(-2 * time.Minute)
+		result, e = sink.Query(&api.AuditLogRequest{From: &from, Limit: 2})
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.AuditRecord{records[3], records[2]}, result)
+	})
+}
+
+func TestFileSink_RecordsAreAppendedToExistingFile(t *testing.T) {
+	withFileSink(t, func(sink *FileSink) {
+		record := &api.AuditRecord{Time: time.Now().UTC(), Principal: "alice", Code: "OK"}
+		assert.Nil(t, sink.Write(record))
+		assert.Nil(t, sink.Close())
+
+		reopened, e := NewFileSink(sink.path)
+		assert.Nil(t, e)
+		defer reopened.Close()
+		assert.Nil(t, reopened.Write(record))
+
+		result, e := reopened.Query(&api.AuditLogRequest{})
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(result))
+	})
+}
+
+func withFileSink(t *testing.T, action func(sink *FileSink)) {
+	directory, e := ioutil.TempDir("", "audit")
+	assert.Nil(t, e)
+	defer os.RemoveAll(directory)
+
+	sink, e := NewFileSink(filepath.Join(directory, "log", "audit.jsonl"))
+	assert.Nil(t, e)
+	defer sink.Close()
+
+	action(sink)
+}
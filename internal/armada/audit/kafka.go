@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// KafkaSink publishes records as json messages keyed by queue, it can't be queried
+type KafkaSink struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaSink(writer *kafka.Writer) *KafkaSink {
+	return &KafkaSink{writer: writer}
+}
+
+func (s *KafkaSink) Write(record *api.AuditRecord) error {
+	data, e := json.Marshal(record)
+	if e != nil {
+		return e
+	}
+	return s.writer.WriteMessages(context.Background(), kafka.Message{Key: []byte(record.Queue), Value: data})
+}
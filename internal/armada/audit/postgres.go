@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var (
+	auditLogTable = goqu.T("audit_log")
+
+	auditLog_id        = goqu.I("audit_log.id")
+	auditLog_time      = goqu.I("audit_log.time")
+	auditLog_principal = goqu.I("audit_log.principal")
+	auditLog_method    = goqu.I("audit_log.method")
+	auditLog_queue     = goqu.I("audit_log.queue")
+	auditLog_jobSet    = goqu.I("audit_log.jobset")
+	auditLog_record    = goqu.I("audit_log.record")
+)
+
+// PostgresSink stores records in audit_log table created by armada database migrations
+type PostgresSink struct {
+	db *goqu.Database
+}
+
+func NewPostgresSink(db *goqu.Database) *PostgresSink {
+	return &PostgresSink{db: db}
+}
+
+func (s *PostgresSink) Write(record *api.AuditRecord) error {
+	data, e := proto.Marshal(record)
+	if e != nil {
+		return e
+	}
+	_, e = s.db.Insert(auditLogTable).
+		Rows(goqu.Record{
+			"time":      record.Time,
+			"principal": record.Principal,
+			"method":    record.Method,
+			"queue":     record.Queue,
+			"jobset":    record.JobSetId,
+			"record":    data,
+		}).
+		Prepared(true).Executor().Exec()
+	return e
+}
+
+func (s *PostgresSink) Query(request *api.AuditLogRequest) ([]*api.AuditRecord, error) {
+	conditions := []exp.Expression{}
+	if request.Principal != "" {
+		conditions = append(conditions, auditLog_principal.Eq(request.Principal))
+	}
+	if request.Queue != "" {
+		conditions = append(conditions, auditLog_queue.Eq(request.Queue))
+	}
+	if request.JobSetId != "" {
+		conditions = append(conditions, auditLog_jobSet.Eq(request.JobSetId))
+	}
+	if request.Method != "" {
+		conditions = append(conditions, auditLog_method.Eq(request.Method))
+	}
+	if request.From != nil {
+		conditions = append(conditions, auditLog_time.Gte(request.From.UTC()))
+	}
+	if request.To != nil {
+		conditions = append(conditions, auditLog_time.Lt(request.To.UTC()))
+	}
+
+	ds := s.db.
+		From(auditLogTable).
+		Select(auditLog_record).
+		Where(conditions...).
+		Order(auditLog_id.Desc())
+	if request.Limit > 0 {
+		ds = ds.Limit(uint(request.Limit))
+	}
+
+	rows := [][]byte{}
+	e := ds.Prepared(true).ScanVals(&rows)
+	if e != nil {
+		return nil, e
+	}
+
+	records := make([]*api.AuditRecord, 0, len(rows))
+	for _, row := range rows {
+		record := &api.AuditRecord{}
+		e = proto.Unmarshal(row, record)
+		if e != nil {
+			return nil, e
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
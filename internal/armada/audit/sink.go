@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common/database"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Sink stores records of audited api calls
+type Sink interface {
+	Write(record *api.AuditRecord) error
+}
+
+// QueryableSink can return stored records, records are returned starting with the most recent one
+type QueryableSink interface {
+	Sink
+	Query(request *api.AuditLogRequest) ([]*api.AuditRecord, error)
+}
+
+// NewSink creates sink selected by the configuration, returned function releases resources held by the sink
+func NewSink(config *configuration.AuditConfig) (Sink, func() error, error) {
+	switch config.Sink {
+	case "file":
+		sink, e := NewFileSink(config.File.Path)
+		if e != nil {
+			return nil, nil, e
+		}
+		return sink, sink.Close, nil
+	case "postgres":
+		db, e := database.OpenPostgres(config.Postgres)
+		if e != nil {
+			return nil, nil, e
+		}
+		return NewPostgresSink(goqu.New("postgres", db)), db.Close, nil
+	case "kafka":
+		writer := kafka.NewWriter(kafka.WriterConfig{
+			Brokers: config.Kafka.Brokers,
+			Topic:   config.Kafka.Topic,
+			// records are written synchronously by audited calls, default 1s timeout would delay each of them
+			BatchTimeout: 10 * time.Millisecond,
+		})
+		return NewKafkaSink(writer), writer.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown audit sink %q, supported sinks are file, postgres and kafka", config.Sink)
+	}
+}
+
+// Matches checks if the record satisfies all filters of the request, limit is ignored
+func Matches(record *api.AuditRecord, request *api.AuditLogRequest) bool {
+	return (request.Principal == "" || record.Principal == request.Principal) &&
+		(request.Queue == "" || record.Queue == request.Queue) &&
+		(request.JobSetId == "" || record.JobSetId == request.JobSetId) &&
+		(request.Method == "" || record.Method == request.Method) &&
+		(request.From == nil || !record.Time.Before(*request.From)) &&
+		(request.To == nil || record.Time.Before(*request.To))
+}
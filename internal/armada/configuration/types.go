@@ -7,6 +7,7 @@ import (
 
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/auth/configuration"
+	"github.com/G-Research/armada/internal/common/database"
 )
 
 type ArmadaConfig struct {
@@ -22,11 +23,21 @@ type ArmadaConfig struct {
 	EventsKafka      KafkaConfig
 	EventsNats       NatsConfig
 	EventsRedis      redis.UniversalOptions
+	EventsBackend    string // Where events are stored for reading, redis or postgres
+	EventsPostgres   PostgresEventsConfig
+	JobsBackend      string // Where job queues are stored, redis or postgres
+	JobsPostgres     PostgresJobsConfig
 
-	Scheduling      SchedulingConfig
-	QueueManagement QueueManagementConfig
-	EventRetention  EventRetentionPolicy
-	Metrics         MetricsConfig
+	Scheduling       SchedulingConfig
+	QueueManagement  QueueManagementConfig
+	JobDeduplication JobDeduplicationConfig
+	EventRetention   EventRetentionPolicy
+	JobSets          JobSetConfig
+	Notifications    NotificationConfig
+	EventArchive     EventArchiveConfig
+	Audit            AuditConfig
+	ExecutorIdentity ExecutorIdentityConfig
+	Metrics          MetricsConfig
 }
 
 type SchedulingConfig struct {
@@ -46,6 +57,8 @@ type SchedulingConfig struct {
 type EventRetentionPolicy struct {
 	ExpiryEnabled     bool
 	RetentionDuration time.Duration
+	// Approximate maximum number of events kept in the stream of all events of a queue, 0 means unlimited
+	MaxQueueStreamLength int64
 }
 
 type LeaseSettings struct {
@@ -54,18 +67,33 @@ type LeaseSettings struct {
 }
 
 type KafkaConfig struct {
-	Brokers                  []string
-	Topic                    string
-	ConsumerGroupID          string
-	JobStatusConsumerGroupID string
+	Brokers                     []string
+	Topic                       string
+	ConsumerGroupID             string
+	JobStatusConsumerGroupID    string
+	NotificationConsumerGroupID string
+	ArchiveConsumerGroupID      string
 }
 
 type NatsConfig struct {
-	Servers        []string
-	ClusterID      string
-	Subject        string
-	QueueGroup     string
-	JobStatusGroup string
+	Servers           []string
+	ClusterID         string
+	Subject           string
+	QueueGroup        string
+	JobStatusGroup    string
+	NotificationGroup string
+	ArchiveGroup      string
+}
+
+type PostgresEventsConfig struct {
+	Postgres            database.PostgresConfig
+	PollInterval        time.Duration // Blocked reads check for new events at least this often, in case a notification is missed
+	ExpiryCheckInterval time.Duration // How often events older than the retention duration are deleted
+}
+
+type PostgresJobsConfig struct {
+	Postgres            database.PostgresConfig
+	ExpiryCheckInterval time.Duration // How often jobs deleted more than a week ago and expired client ids are deleted
 }
 
 type QueueManagementConfig struct {
@@ -73,6 +101,94 @@ type QueueManagementConfig struct {
 	DefaultPriorityFactor float64
 }
 
+const (
+	DeduplicationScopeQueue  = "queue"
+	DeduplicationScopeJobSet = "jobSet"
+)
+
+// JobDeduplicationConfig defines when a job submitted with the client id of an earlier job is considered its duplicate
+type JobDeduplicationConfig struct {
+	Scope  string        // queue or jobSet, client ids are compared only among jobs of the same queue or the same job set
+	Window time.Duration // How long client ids of submitted jobs are kept, zero disables deduplication
+}
+
+type JobSetConfig struct {
+	// How often closed job sets are checked and finalized once all their jobs finished
+	FinalizationInterval time.Duration
+}
+
+type NotificationConfig struct {
+	Enabled           bool
+	DeliveryInterval  time.Duration
+	DeliveryBatchSize int64
+	RequestTimeout    time.Duration
+	MaxAttempts       int32 // Failed notifications are moved to the dead letter log after this many attempts
+	RetryInterval     time.Duration
+	MaxRetryInterval  time.Duration
+	DeadLetterLogSize int64         // Number of dead letters kept per queue
+	NotifyUrlExpiry   time.Duration // How long notify url annotation of a job is kept
+	// Hosts notifications can be delivered to, "*.example.com" allows all subdomains, all hosts are allowed when empty
+	AllowedHosts []string
+	// Allow delivery to loopback, link-local and private addresses, they are rejected by default to protect internal services
+	AllowPrivateAddresses bool
+}
+
+// EventArchiveConfig configures archiving of events consumed from Kafka or NATS to files in object storage
+type EventArchiveConfig struct {
+	Enabled           bool
+	Format            string        // jsonl or parquet
+	FlushInterval     time.Duration // Maximum time events are buffered before they are written
+	MaxBatchSize      int           // Events are written once this many are buffered
+	MaxBufferedEvents int           // Events are not consumed while this many wait to be written, e.g. when the sink fails
+	Local             LocalArchiveConfig
+	S3                S3ArchiveConfig
+}
+
+type LocalArchiveConfig struct {
+	Directory string
+}
+
+// S3ArchiveConfig is used when Bucket is set, Endpoint allows using S3 compatible storage
+type S3ArchiveConfig struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	ForcePathStyle  bool
+}
+
+// AuditConfig configures audit log of mutating api calls
+type AuditConfig struct {
+	Enabled  bool
+	Sink     string // file, postgres or kafka, only file and postgres can be queried
+	File     FileAuditConfig
+	Postgres database.PostgresConfig
+	Kafka    KafkaAuditConfig
+}
+
+type FileAuditConfig struct {
+	Path string
+}
+
+type KafkaAuditConfig struct {
+	Brokers []string
+	Topic   string
+}
+
+// ExecutorIdentityConfig binds executor principals to clusters and pools they can act for
+type ExecutorIdentityConfig struct {
+	Enabled bool
+	// Map of principal name -> clusters and pools the principal can act for
+	Principals map[string]ExecutorBinding
+}
+
+type ExecutorBinding struct {
+	ClusterIds []string
+	Pools      []string // Any pool is allowed when empty
+}
+
 type MetricsConfig struct {
 	RefreshInterval time.Duration
 }
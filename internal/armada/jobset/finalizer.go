@@ -0,0 +1,207 @@
+package jobset
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Finalizer finalizes closed job sets once none of their jobs is active and reports JobSetCompletedEvent summarizing them
+type Finalizer struct {
+	queueRepository  repository.QueueRepository
+	jobRepository    repository.JobRepository
+	jobSetRepository repository.JobSetRepository
+	eventRepository  repository.EventRepository
+	eventStore       repository.EventStore
+}
+
+func NewFinalizer(
+	queueRepository repository.QueueRepository,
+	jobRepository repository.JobRepository,
+	jobSetRepository repository.JobSetRepository,
+	eventRepository repository.EventRepository,
+	eventStore repository.EventStore) *Finalizer {
+
+	return &Finalizer{
+		queueRepository:  queueRepository,
+		jobRepository:    jobRepository,
+		jobSetRepository: jobSetRepository,
+		eventRepository:  eventRepository,
+		eventStore:       eventStore,
+	}
+}
+
+func (f *Finalizer) FinalizeJobSets() {
+	queues, e := f.queueRepository.GetAllQueues()
+	if e != nil {
+		log.Errorf("Error while loading queues for job set finalization: %v", e)
+		return
+	}
+
+	for _, queue := range queues {
+		closedJobSets, e := f.jobSetRepository.GetClosedJobSets(queue.Name)
+		if e != nil {
+			log.Errorf("Error while loading closed job sets of queue %s: %v", queue.Name, e)
+			continue
+		}
+		if len(closedJobSets) == 0 {
+			continue
+		}
+
+		activeJobSets, e := f.jobRepository.GetQueueActiveJobSets(queue.Name)
+		if e != nil {
+			log.Errorf("Error while loading active job sets of queue %s: %v", queue.Name, e)
+			continue
+		}
+		active := map[string]bool{}
+		for _, jobSet := range activeJobSets {
+			active[jobSet.Name] = true
+		}
+
+		for _, jobSetId := range closedJobSets {
+			if active[jobSetId] {
+				continue
+			}
+			e := f.finalize(queue.Name, jobSetId)
+			if e != nil {
+				log.Errorf("Error while finalizing job set %s of queue %s: %v", jobSetId, queue.Name, e)
+			}
+		}
+	}
+}
+
+func (f *Finalizer) finalize(queue string, jobSetId string) error {
+	summary := newJobSetSummary()
+	lastId := ""
+	for {
+		messages, e := f.eventRepository.ReadEvents(queue, jobSetId, lastId, 500, -1)
+		if e != nil {
+			return e
+		}
+		if len(messages) == 0 {
+			break
+		}
+		for _, message := range messages {
+			lastId = message.Id
+			event, e := api.UnwrapEvent(message.Message)
+			if e != nil {
+				log.Warnf("Skipping unknown event in job set %s of queue %s: %v", jobSetId, queue, e)
+				continue
+			}
+			summary.add(event)
+		}
+	}
+
+	finalized, e := f.jobSetRepository.FinalizeJobSet(queue, jobSetId)
+	if e != nil || !finalized {
+		// the job set was finalized by other server
+		return e
+	}
+
+	// job repositories which cannot check the state of the job set atomically with adding jobs
+	// could have added jobs since active job sets were loaded
+	active, e := f.isActive(queue, jobSetId)
+	if e != nil || active {
+		if revertError := f.jobSetRepository.RevertJobSetFinalization(queue, jobSetId); revertError != nil {
+			log.Errorf("Error while reverting finalization of job set %s of queue %s: %v", jobSetId, queue, revertError)
+		}
+		return e
+	}
+
+	completedEvent, e := api.Wrap(summary.completedEvent(queue, jobSetId, time.Now()))
+	if e != nil {
+		return e
+	}
+	e = f.eventStore.ReportEvents([]*api.EventMessage{completedEvent})
+	if e != nil {
+		if revertError := f.jobSetRepository.RevertJobSetFinalization(queue, jobSetId); revertError != nil {
+			log.Errorf("Error while reverting finalization of job set %s of queue %s: %v", jobSetId, queue, revertError)
+		}
+		return e
+	}
+	log.Infof("Finalized job set %s of queue %s", jobSetId, queue)
+	return nil
+}
+
+func (f *Finalizer) isActive(queue string, jobSetId string) (bool, error) {
+	activeJobSets, e := f.jobRepository.GetQueueActiveJobSets(queue)
+	if e != nil {
+		return false, e
+	}
+	for _, jobSet := range activeJobSets {
+		if jobSet.Name == jobSetId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type jobSetSummary struct {
+	firstSubmitted time.Time
+	submitted      int32
+	started        map[string]time.Time
+	finished       map[string]api.Event
+}
+
+func newJobSetSummary() *jobSetSummary {
+	return &jobSetSummary{
+		started:  map[string]time.Time{},
+		finished: map[string]api.Event{},
+	}
+}
+
+func (s *jobSetSummary) add(event api.Event) {
+	switch event.(type) {
+	case *api.JobSubmittedEvent:
+		s.submitted++
+		if s.firstSubmitted.IsZero() || event.GetCreated().Before(s.firstSubmitted) {
+			s.firstSubmitted = event.GetCreated()
+		}
+	case *api.JobRunningEvent:
+		// multi node jobs report event for every pod, the job runs since the first one
+		if started, exists := s.started[event.GetJobId()]; !exists || event.GetCreated().Before(started) {
+			s.started[event.GetJobId()] = event.GetCreated()
+		}
+	case *api.JobSucceededEvent, *api.JobFailedEvent, *api.JobCancelledEvent:
+		if finished, exists := s.finished[event.GetJobId()]; !exists || !event.GetCreated().Before(finished.GetCreated()) {
+			s.finished[event.GetJobId()] = event
+		}
+	}
+}
+
+func (s *jobSetSummary) completedEvent(queue string, jobSetId string, now time.Time) *api.JobSetCompletedEvent {
+	event := &api.JobSetCompletedEvent{
+		JobSetId:  jobSetId,
+		Queue:     queue,
+		Created:   now,
+		Submitted: s.submitted,
+	}
+	if !s.firstSubmitted.IsZero() {
+		event.Duration = now.Sub(s.firstSubmitted)
+	}
+
+	for jobId, finished := range s.finished {
+		switch finished.(type) {
+		case *api.JobSucceededEvent:
+			event.Succeeded++
+		case *api.JobFailedEvent:
+			event.Failed++
+		case *api.JobCancelledEvent:
+			event.Cancelled++
+		}
+
+		started, exists := s.started[jobId]
+		if !exists || finished.GetCreated().Before(started) {
+			continue
+		}
+		runTime := finished.GetCreated().Sub(started)
+		event.TotalRunTime += runTime
+		if runTime > event.MaxRunTime {
+			event.MaxRunTime = runTime
+		}
+	}
+	return event
+}
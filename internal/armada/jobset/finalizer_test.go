@@ -0,0 +1,154 @@
+package jobset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var start = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestFinalizer_FinalizesClosedInactiveJobSets(t *testing.T) {
+	withFinalizer(func(f *Finalizer, jobSetRepository repository.JobSetRepository, events *fakeEventRepository) {
+		events.events = []api.Event{
+			&api.JobSubmittedEvent{JobId: "job-1", Queue: "queue", JobSetId: "set", Created: start},
+			&api.JobSubmittedEvent{JobId: "job-2", Queue: "queue", JobSetId: "set", Created: start.Add(time.Minute)},
+			&api.JobRunningEvent{JobId: "job-1", Queue: "queue", JobSetId: "set", Created: start.Add(2 * time.Minute)},
+			&api.JobSucceededEvent{JobId: "job-1", Queue: "queue", JobSetId: "set", Created: start.Add(5 * time.Minute)},
+			&api.JobCancelledEvent{JobId: "job-2", Queue: "queue", JobSetId: "set", Created: start.Add(6 * time.Minute)},
+		}
+
+		f.FinalizeJobSets()
+		assert.Empty(t, events.reported, "job set is not closed")
+
+		closed, e := jobSetRepository.CloseJobSet("queue", "set")
+		assert.NoError(t, e)
+		assert.True(t, closed)
+
+		f.FinalizeJobSets()
+		assert.Len(t, events.reported, 1)
+		completed := events.reported[0].GetJobSetCompleted()
+		assert.Equal(t, "set", completed.JobSetId)
+		assert.Equal(t, "queue", completed.Queue)
+		assert.Equal(t, int32(2), completed.Submitted)
+		assert.Equal(t, int32(1), completed.Succeeded)
+		assert.Equal(t, int32(1), completed.Cancelled)
+		assert.Equal(t, 3*time.Minute, completed.TotalRunTime)
+		assert.Equal(t, 3*time.Minute, completed.MaxRunTime)
+
+		state, e := jobSetRepository.GetJobSetState("queue", "set")
+		assert.NoError(t, e)
+		assert.Equal(t, repository.JobSetFinalized, state)
+
+		f.FinalizeJobSets()
+		assert.Len(t, events.reported, 1)
+	})
+}
+
+func TestFinalizer_RevertsFinalizationWhenJobsWereAdded(t *testing.T) {
+	withFinalizer(func(f *Finalizer, jobSetRepository repository.JobSetRepository, events *fakeEventRepository) {
+		// jobs are added after the finalizer loaded active job sets for the first time
+		f.jobRepository = &jobAddedDuringFinalization{JobRepository: f.jobRepository, jobSetId: "set"}
+
+		_, e := jobSetRepository.CloseJobSet("queue", "set")
+		assert.NoError(t, e)
+
+		f.FinalizeJobSets()
+		assert.Empty(t, events.reported)
+
+		state, e := jobSetRepository.GetJobSetState("queue", "set")
+		assert.NoError(t, e)
+		assert.Equal(t, repository.JobSetClosed, state)
+	})
+}
+
+type jobAddedDuringFinalization struct {
+	repository.JobRepository
+	jobSetId string
+	calls    int
+}
+
+func (r *jobAddedDuringFinalization) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
+	r.calls++
+	if r.calls == 1 {
+		return []*api.JobSetInfo{}, nil
+	}
+	return []*api.JobSetInfo{{Name: r.jobSetId, QueuedJobs: 1}}, nil
+}
+
+func TestJobSetSummary(t *testing.T) {
+	summary := newJobSetSummary()
+	summary.add(&api.JobSubmittedEvent{JobId: "job-1", Created: start.Add(time.Minute)})
+	summary.add(&api.JobSubmittedEvent{JobId: "job-2", Created: start})
+	summary.add(&api.JobRunningEvent{JobId: "job-1", PodNumber: 1, Created: start.Add(3 * time.Minute)})
+	summary.add(&api.JobRunningEvent{JobId: "job-1", PodNumber: 0, Created: start.Add(2 * time.Minute)})
+	summary.add(&api.JobRunningEvent{JobId: "job-2", Created: start.Add(2 * time.Minute)})
+	summary.add(&api.JobFailedEvent{JobId: "job-1", Created: start.Add(12 * time.Minute)})
+	summary.add(&api.JobSucceededEvent{JobId: "job-2", Created: start.Add(4 * time.Minute)})
+
+	completed := summary.completedEvent("queue", "set", start.Add(time.Hour))
+
+	assert.Equal(t, &api.JobSetCompletedEvent{
+		JobSetId:     "set",
+		Queue:        "queue",
+		Created:      start.Add(time.Hour),
+		Submitted:    2,
+		Succeeded:    1,
+		Failed:       1,
+		Duration:     time.Hour,
+		TotalRunTime: 12 * time.Minute,
+		MaxRunTime:   10 * time.Minute,
+	}, completed)
+}
+
+type fakeEventRepository struct {
+	repository.EventRepository
+	events   []api.Event
+	reported []*api.EventMessage
+}
+
+func (r *fakeEventRepository) ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error) {
+	if lastId != "" {
+		return []*api.EventStreamMessage{}, nil
+	}
+	messages := []*api.EventStreamMessage{}
+	for _, event := range r.events {
+		message, e := api.Wrap(event)
+		if e != nil {
+			return nil, e
+		}
+		messages = append(messages, &api.EventStreamMessage{Id: "1", Message: message})
+	}
+	return messages, nil
+}
+
+func (r *fakeEventRepository) ReportEvents(messages []*api.EventMessage) error {
+	r.reported = append(r.reported, messages...)
+	return nil
+}
+
+func withFinalizer(action func(f *Finalizer, jobSetRepository repository.JobSetRepository, events *fakeEventRepository)) {
+	db, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: db.Addr()})
+	queueRepository := repository.NewRedisQueueRepository(redisClient)
+	err = queueRepository.CreateQueue(&api.Queue{Name: "queue"})
+	if err != nil {
+		panic(err)
+	}
+	jobSetRepository := repository.NewRedisJobSetRepository(redisClient)
+	events := &fakeEventRepository{}
+
+	action(NewFinalizer(queueRepository, repository.NewRedisJobRepository(redisClient, nil, configuration.JobDeduplicationConfig{}), jobSetRepository, events, events), jobSetRepository, events)
+}
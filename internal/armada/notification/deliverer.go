@@ -0,0 +1,171 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const deliveryIdHeader = "X-Armada-Delivery"
+const eventTypeHeader = "X-Armada-Event"
+
+// Body of notification requests
+type notificationPayload struct {
+	SubscriptionId string    `json:"subscriptionId,omitempty"`
+	EventType      string    `json:"eventType"`
+	Event          api.Event `json:"event"`
+}
+
+// Deliverer POSTs scheduled notifications to their urls, failed deliveries are retried with exponential backoff
+// and moved to the dead letter log of the queue after the configured number of attempts.
+type Deliverer struct {
+	repository repository.NotificationRepository
+	config     *configuration.NotificationConfig
+	urlPolicy  *UrlPolicy
+	client     *http.Client
+}
+
+func NewDeliverer(repository repository.NotificationRepository, config *configuration.NotificationConfig) *Deliverer {
+	urlPolicy := NewUrlPolicy(config)
+	return &Deliverer{
+		repository: repository,
+		config:     config,
+		urlPolicy:  urlPolicy,
+		client:     urlPolicy.newHttpClient(config.RequestTimeout),
+	}
+}
+
+func (d *Deliverer) DeliverNotifications() {
+	// claim expires after the deliveries time out, so deliveries of a crashed server are picked up again
+	deliveries, e := d.repository.ClaimDeliveries(d.config.DeliveryBatchSize, 2*d.config.RequestTimeout)
+	if e != nil {
+		log.Errorf("Error while claiming notification deliveries: %v", e)
+		return
+	}
+
+	wg := sync.WaitGroup{}
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *repository.NotificationDelivery) {
+			defer wg.Done()
+			d.deliver(delivery)
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+func (d *Deliverer) deliver(delivery *repository.NotificationDelivery) {
+	delivery.Attempts++
+	e := d.post(delivery)
+	if e == nil {
+		e = d.repository.CompleteDelivery(delivery)
+		if e != nil {
+			log.Errorf("Error while completing notification delivery %s: %v", delivery.Id, e)
+		}
+		return
+	}
+	delivery.LastError = e.Error()
+
+	if delivery.Attempts < d.config.MaxAttempts {
+		e = d.repository.RetryDelivery(delivery, time.Now().Add(d.retryInterval(delivery.Attempts)))
+		if e != nil {
+			log.Errorf("Error while scheduling retry of notification delivery %s: %v", delivery.Id, e)
+		}
+		return
+	}
+
+	log.Warnf("Notification delivery %s to %s failed after %d attempts: %s", delivery.Id, delivery.Url, delivery.Attempts, delivery.LastError)
+	message := &api.EventMessage{}
+	e = proto.Unmarshal(delivery.Message, message)
+	if e != nil {
+		log.Errorf("Error while unmarshalling message of notification delivery %s: %v", delivery.Id, e)
+	}
+	e = d.repository.DeadLetterDelivery(delivery, &api.NotificationDeadLetter{
+		SubscriptionId: delivery.SubscriptionId,
+		Url:            delivery.Url,
+		Message:        message,
+		Attempts:       delivery.Attempts,
+		Error:          delivery.LastError,
+		Failed:         time.Now(),
+	}, d.config.DeadLetterLogSize)
+	if e != nil {
+		log.Errorf("Error while moving notification delivery %s to dead letter log: %v", delivery.Id, e)
+	}
+}
+
+func (d *Deliverer) post(delivery *repository.NotificationDelivery) error {
+	// the policy could have changed since the url was validated
+	if e := d.urlPolicy.CheckUrl(delivery.Url); e != nil {
+		return e
+	}
+	body, eventType, e := createPayload(delivery)
+	if e != nil {
+		return e
+	}
+
+	request, e := http.NewRequest(http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if e != nil {
+		return e
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(deliveryIdHeader, delivery.Id)
+	request.Header.Set(eventTypeHeader, eventType)
+	if delivery.Secret != "" {
+		request.Header.Set(api.NotificationSignatureHeader, api.SignNotification(delivery.Secret, body))
+	}
+
+	response, e := d.client.Do(request)
+	if e != nil {
+		return e
+	}
+	defer response.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %s", response.Status)
+	}
+	return nil
+}
+
+func (d *Deliverer) retryInterval(attempts int32) time.Duration {
+	interval := d.config.RetryInterval
+	for i := int32(1); i < attempts; i++ {
+		interval *= 2
+		if interval >= d.config.MaxRetryInterval {
+			return d.config.MaxRetryInterval
+		}
+	}
+	return interval
+}
+
+func createPayload(delivery *repository.NotificationDelivery) ([]byte, string, error) {
+	message := &api.EventMessage{}
+	e := proto.Unmarshal(delivery.Message, message)
+	if e != nil {
+		return nil, "", e
+	}
+	event, e := api.UnwrapEvent(message)
+	if e != nil {
+		return nil, "", e
+	}
+	eventType := api.EventTypeName(event)
+	body, e := json.Marshal(&notificationPayload{
+		SubscriptionId: delivery.SubscriptionId,
+		EventType:      eventType,
+		Event:          event,
+	})
+	return body, eventType, e
+}
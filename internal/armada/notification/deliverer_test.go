@@ -0,0 +1,55 @@
+package notification
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestDeliverer_RetriesAndDeadLettersFailedDeliveries(t *testing.T) {
+	withNotification(func(repo *repository.RedisNotificationRepository, notifier *Notifier, deliverer *Deliverer) {
+		receiver := newReceiver(http.StatusInternalServerError)
+		defer receiver.Close()
+
+		err := repo.CreateSubscription(&api.NotificationSubscription{Id: "subscription", Queue: "queue", Url: receiver.URL})
+		assert.NoError(t, err)
+		err = notifier.ReportEvents([]*api.EventMessage{wrap(&api.JobFailedEvent{JobId: "job-1", Queue: "queue", JobSetId: "set"})})
+		assert.NoError(t, err)
+
+		deliverer.DeliverNotifications()
+		deliverer.DeliverNotifications()
+		assert.Len(t, receiver.getRequests(), 1, "retry is not due yet")
+
+		time.Sleep(20 * time.Millisecond)
+		deliverer.DeliverNotifications()
+		assert.Len(t, receiver.getRequests(), 2)
+
+		deadLetters, err := repo.GetDeadLetters("queue", 10)
+		assert.NoError(t, err)
+		assert.Len(t, deadLetters, 1)
+		assert.Equal(t, "subscription", deadLetters[0].SubscriptionId)
+		assert.Equal(t, int32(2), deadLetters[0].Attempts)
+		assert.Equal(t, "job-1", deadLetters[0].Message.GetFailed().JobId)
+		assert.Contains(t, deadLetters[0].Error, "500")
+
+		time.Sleep(20 * time.Millisecond)
+		deliverer.DeliverNotifications()
+		assert.Len(t, receiver.getRequests(), 2)
+	})
+}
+
+func TestDeliverer_RetryInterval(t *testing.T) {
+	deliverer := NewDeliverer(nil, &configuration.NotificationConfig{RetryInterval: time.Second, MaxRetryInterval: 5 * time.Second})
+
+	assert.Equal(t, time.Second, deliverer.retryInterval(1))
+	assert.Equal(t, 2*time.Second, deliverer.retryInterval(2))
+	assert.Equal(t, 4*time.Second, deliverer.retryInterval(3))
+	assert.Equal(t, 5*time.Second, deliverer.retryInterval(4))
+	assert.Equal(t, 5*time.Second, deliverer.retryInterval(100))
+}
@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Jobs with notify url annotation are notified about these events, after which the url is forgotten
+var notifyUrlEventTypes = map[string]bool{
+	"JobSucceededEvent": true,
+	"JobFailedEvent":    true,
+	"JobCancelledEvent": true,
+}
+
+// Notifier matches events against notification subscriptions and schedules deliveries of matching events.
+// It implements EventStore, so it can consume the event stream using the same processors as the Redis event repository.
+type Notifier struct {
+	repository repository.NotificationRepository
+	config     *configuration.NotificationConfig
+}
+
+type subscriptionMatcher struct {
+	subscription *api.NotificationSubscription
+	filter       *api.EventFilter
+}
+
+func NewNotifier(repository repository.NotificationRepository, config *configuration.NotificationConfig) *Notifier {
+	return &Notifier{repository: repository, config: config}
+}
+
+func (n *Notifier) ReportEvents(messages []*api.EventMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	matchersByQueue := map[string][]*subscriptionMatcher{}
+	deliveries := []*repository.NotificationDelivery{}
+	notifyUrls := map[string]string{}
+	notifyUrlMessages := []*api.EventMessage{}
+	notifyUrlJobIds := []string{}
+
+	for _, message := range messages {
+		event, e := api.UnwrapEvent(message)
+		if e != nil {
+			log.Errorf("Error while unwrapping event for notification: %v", e)
+			continue
+		}
+
+		if submitted, ok := event.(*api.JobSubmittedEvent); ok {
+			if url := submitted.Job.Annotations[api.NotifyUrlAnnotation]; url != "" {
+				notifyUrls[submitted.JobId] = url
+			}
+		}
+		if notifyUrlEventTypes[api.EventTypeName(event)] {
+			notifyUrlMessages = append(notifyUrlMessages, message)
+			notifyUrlJobIds = append(notifyUrlJobIds, event.GetJobId())
+		}
+
+		matchers, loaded := matchersByQueue[event.GetQueue()]
+		if !loaded {
+			matchers, e = n.loadSubscriptions(event.GetQueue())
+			if e != nil {
+				return e
+			}
+			matchersByQueue[event.GetQueue()] = matchers
+		}
+		for _, matcher := range matchers {
+			if matcher.filter.Matches(message) {
+				delivery, e := newDelivery(event.GetQueue(), matcher.subscription.Id, matcher.subscription.Url, matcher.subscription.Secret, message)
+				if e != nil {
+					return e
+				}
+				deliveries = append(deliveries, delivery)
+			}
+		}
+	}
+
+	e := n.repository.SetJobNotifyUrls(notifyUrls, n.config.NotifyUrlExpiry)
+	if e != nil {
+		return e
+	}
+
+	notifiedJobIds := []string{}
+	if len(notifyUrlJobIds) > 0 {
+		urls, e := n.repository.GetJobNotifyUrls(notifyUrlJobIds)
+		if e != nil {
+			return e
+		}
+		for i, message := range notifyUrlMessages {
+			jobId := notifyUrlJobIds[i]
+			url, exists := urls[jobId]
+			if !exists {
+				continue
+			}
+			event, _ := api.UnwrapEvent(message)
+			delivery, e := newDelivery(event.GetQueue(), "", url, "", message)
+			if e != nil {
+				return e
+			}
+			deliveries = append(deliveries, delivery)
+			notifiedJobIds = append(notifiedJobIds, jobId)
+		}
+	}
+
+	e = n.repository.AddDeliveries(deliveries)
+	if e != nil {
+		return e
+	}
+
+	e = n.repository.DeleteJobNotifyUrls(notifiedJobIds)
+	if e != nil {
+		// urls expire eventually, failing here would cause duplicate notifications
+		log.Errorf("Error while deleting job notify urls: %v", e)
+	}
+	return nil
+}
+
+func (n *Notifier) loadSubscriptions(queue string) ([]*subscriptionMatcher, error) {
+	subscriptions, e := n.repository.GetSubscriptions(queue)
+	if e != nil {
+		return nil, e
+	}
+	matchers := make([]*subscriptionMatcher, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		filter, e := api.NewEventFilter(subscription.JobSetPatterns, subscription.EventTypes)
+		if e != nil {
+			log.Errorf("Ignoring invalid notification subscription %s of queue %s: %v", subscription.Id, queue, e)
+			continue
+		}
+		matchers = append(matchers, &subscriptionMatcher{subscription: subscription, filter: filter})
+	}
+	return matchers, nil
+}
+
+func newDelivery(queue string, subscriptionId string, url string, secret string, message *api.EventMessage) (*repository.NotificationDelivery, error) {
+	data, e := proto.Marshal(message)
+	if e != nil {
+		return nil, e
+	}
+	return &repository.NotificationDelivery{
+		Id:             util.NewULID(),
+		Queue:          queue,
+		SubscriptionId: subscriptionId,
+		Url:            url,
+		Secret:         secret,
+		Message:        data,
+	}, nil
+}
@@ -0,0 +1,147 @@
+package notification
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestNotifier_DeliversMatchingEvents(t *testing.T) {
+	withNotification(func(repo *repository.RedisNotificationRepository, notifier *Notifier, deliverer *Deliverer) {
+		receiver := newReceiver(http.StatusOK)
+		defer receiver.Close()
+
+		err := repo.CreateSubscription(&api.NotificationSubscription{
+			Id:             "subscription",
+			Queue:          "queue",
+			JobSetPatterns: []string{"nightly-*"},
+			EventTypes:     []string{"JobFailedEvent"},
+			Url:            receiver.URL,
+			Secret:         "secret",
+		})
+		assert.NoError(t, err)
+
+		err = notifier.ReportEvents([]*api.EventMessage{
+			wrap(&api.JobFailedEvent{JobId: "job-1", Queue: "queue", JobSetId: "nightly-1"}),
+			wrap(&api.JobFailedEvent{JobId: "job-2", Queue: "queue", JobSetId: "weekly-1"}),
+			wrap(&api.JobSucceededEvent{JobId: "job-3", Queue: "queue", JobSetId: "nightly-1"}),
+			wrap(&api.JobFailedEvent{JobId: "job-4", Queue: "other-queue", JobSetId: "nightly-1"}),
+		})
+		assert.NoError(t, err)
+
+		deliverer.DeliverNotifications()
+
+		requests := receiver.getRequests()
+		assert.Len(t, requests, 1)
+		assert.Contains(t, requests[0].body, `"subscriptionId":"subscription"`)
+		assert.Contains(t, requests[0].body, `"eventType":"JobFailedEvent"`)
+		assert.Contains(t, requests[0].body, `"jobId":"job-1"`)
+		assert.Equal(t, "JobFailedEvent", requests[0].header.Get(eventTypeHeader))
+		assert.Equal(t, api.SignNotification("secret", []byte(requests[0].body)), requests[0].header.Get(api.NotificationSignatureHeader))
+
+		deliverer.DeliverNotifications()
+		assert.Len(t, receiver.getRequests(), 1)
+	})
+}
+
+func TestNotifier_DeliversToNotifyUrlAnnotation(t *testing.T) {
+	withNotification(func(repo *repository.RedisNotificationRepository, notifier *Notifier, deliverer *Deliverer) {
+		receiver := newReceiver(http.StatusOK)
+		defer receiver.Close()
+
+		err := notifier.ReportEvents([]*api.EventMessage{
+			wrap(&api.JobSubmittedEvent{JobId: "job-1", Queue: "queue", JobSetId: "set", Job: api.Job{
+				Annotations: map[string]string{api.NotifyUrlAnnotation: receiver.URL},
+			}}),
+			wrap(&api.JobSubmittedEvent{JobId: "job-2", Queue: "queue", JobSetId: "set"}),
+			wrap(&api.JobRunningEvent{JobId: "job-1", Queue: "queue", JobSetId: "set"}),
+		})
+		assert.NoError(t, err)
+
+		err = notifier.ReportEvents([]*api.EventMessage{
+			wrap(&api.JobSucceededEvent{JobId: "job-1", Queue: "queue", JobSetId: "set"}),
+			wrap(&api.JobSucceededEvent{JobId: "job-2", Queue: "queue", JobSetId: "set"}),
+		})
+		assert.NoError(t, err)
+
+		deliverer.DeliverNotifications()
+
+		requests := receiver.getRequests()
+		assert.Len(t, requests, 1)
+		assert.Contains(t, requests[0].body, `"jobId":"job-1"`)
+		assert.Empty(t, requests[0].header.Get(api.NotificationSignatureHeader))
+
+		urls, err := repo.GetJobNotifyUrls([]string{"job-1"})
+		assert.NoError(t, err)
+		assert.Empty(t, urls)
+	})
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   string
+}
+
+type receiver struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	requests []receivedRequest
+}
+
+func newReceiver(statusCode int) *receiver {
+	r := &receiver{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		body, _ := ioutil.ReadAll(request.Body)
+		r.mutex.Lock()
+		r.requests = append(r.requests, receivedRequest{header: request.Header, body: string(body)})
+		r.mutex.Unlock()
+		w.WriteHeader(statusCode)
+	}))
+	return r
+}
+
+func (r *receiver) getRequests() []receivedRequest {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.requests
+}
+
+func wrap(event api.Event) *api.EventMessage {
+	message, _ := api.Wrap(event)
+	return message
+}
+
+func withNotification(action func(repo *repository.RedisNotificationRepository, notifier *Notifier, deliverer *Deliverer)) {
+	db, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: db.Addr()})
+	repo := repository.NewRedisNotificationRepository(redisClient)
+	config := &configuration.NotificationConfig{
+		DeliveryBatchSize: 10,
+		RequestTimeout:    time.Second,
+		MaxAttempts:       2,
+		RetryInterval:     10 * time.Millisecond,
+		MaxRetryInterval:  time.Second,
+		DeadLetterLogSize: 10,
+		NotifyUrlExpiry:   time.Hour,
+		// test receivers listen on loopback
+		AllowPrivateAddresses: true,
+	}
+
+	action(repo, NewNotifier(repo, config), NewDeliverer(repo, config))
+}
@@ -0,0 +1,141 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common/validation"
+)
+
+const maxRedirects = 10
+
+// Addresses of internal networks, notifications are not delivered to them unless private addresses are allowed
+var privateNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+)
+
+// UrlPolicy restricts urls notifications are delivered to, so users who can submit jobs or subscribe to notifications
+// cannot make the server send requests to internal services.
+// Host names are checked against the allowed hosts, addresses they resolve to are checked when connecting.
+type UrlPolicy struct {
+	allowedHosts          []string
+	allowPrivateAddresses bool
+}
+
+func NewUrlPolicy(config *configuration.NotificationConfig) *UrlPolicy {
+	allowedHosts := make([]string, 0, len(config.AllowedHosts))
+	for _, host := range config.AllowedHosts {
+		allowedHosts = append(allowedHosts, strings.ToLower(host))
+	}
+	return &UrlPolicy{allowedHosts: allowedHosts, allowPrivateAddresses: config.AllowPrivateAddresses}
+}
+
+// CheckUrl returns error when notifications cannot be delivered to the url
+func (p *UrlPolicy) CheckUrl(notificationUrl string) error {
+	if e := validation.ValidateNotificationUrl(notificationUrl); e != nil {
+		return e
+	}
+	parsed, _ := url.Parse(notificationUrl)
+	host := strings.ToLower(parsed.Hostname())
+
+	if !p.isHostAllowed(host) {
+		return fmt.Errorf("notification url host %s is not allowed", host)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return p.checkAddress(ip)
+	}
+	return nil
+}
+
+func (p *UrlPolicy) isHostAllowed(host string) bool {
+	if len(p.allowedHosts) == 0 {
+		return true
+	}
+	for _, allowed := range p.allowedHosts {
+		if strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:]) {
+			return true
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *UrlPolicy) checkAddress(ip net.IP) error {
+	if p.allowPrivateAddresses {
+		return nil
+	}
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return fmt.Errorf("notifications cannot be delivered to internal address %s", ip)
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return fmt.Errorf("notifications cannot be delivered to internal address %s", ip)
+		}
+	}
+	return nil
+}
+
+// newHttpClient creates client which checks addresses after host names are resolved, so host names resolving
+// to internal addresses are rejected as well, and checks urls of redirects.
+// Proxies are not used, as the address of the proxy would be checked instead of the notification url.
+func (p *UrlPolicy) newHttpClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, e := net.SplitHostPort(address)
+			if e != nil {
+				return e
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("unexpected address %s", address)
+			}
+			return p.checkAddress(ip)
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 10,
+		},
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.New("stopped after too many redirects")
+			}
+			return p.CheckUrl(request.URL.String())
+		},
+	}
+}
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, e := net.ParseCIDR(cidr)
+		if e != nil {
+			panic(e)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
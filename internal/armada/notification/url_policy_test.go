@@ -0,0 +1,59 @@
+package notification
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+)
+
+func TestUrlPolicy_CheckUrl_AllowedHosts(t *testing.T) {
+	policy := NewUrlPolicy(&configuration.NotificationConfig{AllowedHosts: []string{"hooks.example.com", "*.Example.org"}})
+
+	assert.NoError(t, policy.CheckUrl("https://hooks.example.com/hook"))
+	assert.NoError(t, policy.CheckUrl("https://ci.example.org:8443/hook"))
+	assert.Error(t, policy.CheckUrl("https://example.org/hook"))
+	assert.Error(t, policy.CheckUrl("https://other.example.com/hook"))
+	assert.Error(t, policy.CheckUrl("ftp://hooks.example.com/hook"))
+}
+
+func TestUrlPolicy_CheckUrl_RejectsInternalAddresses(t *testing.T) {
+	policy := NewUrlPolicy(&configuration.NotificationConfig{})
+
+	assert.NoError(t, policy.CheckUrl("https://example.com/hook"))
+	assert.NoError(t, policy.CheckUrl("http://8.8.8.8/hook"))
+	for _, url := range []string{
+		"http://127.0.0.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.1.2.3/hook",
+		"http://172.20.0.1/hook",
+		"http://192.168.1.1/hook",
+		"http://[::1]:8080/hook",
+		"http://[fd00::1]/hook",
+		"http://0.0.0.0/hook",
+	} {
+		assert.Error(t, policy.CheckUrl(url), url)
+	}
+
+	policy = NewUrlPolicy(&configuration.NotificationConfig{AllowPrivateAddresses: true})
+	assert.NoError(t, policy.CheckUrl("http://127.0.0.1/hook"))
+}
+
+func TestUrlPolicy_HttpClientRejectsResolvedInternalAddresses(t *testing.T) {
+	listener, e := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, e)
+	defer listener.Close()
+	go http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	_, port, _ := net.SplitHostPort(listener.Addr().String())
+	client := NewUrlPolicy(&configuration.NotificationConfig{}).newHttpClient(time.Second)
+
+	// localhost passes the url check, the address is rejected when connecting
+	_, e = client.Post("http://localhost:"+port+"/hook", "application/json", nil)
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "internal address")
+}
@@ -13,7 +13,10 @@ const (
 	CancelAnyJobs                             = "cancel_any_jobs"
 	ReprioritizeJobs                          = "reprioritize_jobs"
 	ReprioritizeAnyJobs                       = "reprioritize_any_jobs"
+	WatchEvents                               = "watch_events"
 	WatchAllEvents                            = "watch_all_events"
+	ViewAuditLog                              = "view_audit_log"
+	ManageApiTokens                           = "manage_api_tokens"
 
 	ExecuteJobs = "execute_jobs"
 )
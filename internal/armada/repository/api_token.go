@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const apiTokensKey = "ApiToken" // map tokenId -> api token protobuf object, with hashed secret
+
+type ApiTokenRepository interface {
+	CreateApiToken(token *api.ApiToken) error
+	// GetApiToken returns nil when the token does not exist
+	GetApiToken(id string) (*api.ApiToken, error)
+	GetApiTokens() ([]*api.ApiToken, error)
+	// Returns false if the token does not exist
+	DeleteApiToken(id string) (bool, error)
+}
+
+type RedisApiTokenRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisApiTokenRepository(db redis.UniversalClient) *RedisApiTokenRepository {
+	return &RedisApiTokenRepository{db: db}
+}
+
+func (repo *RedisApiTokenRepository) CreateApiToken(token *api.ApiToken) error {
+	data, e := proto.Marshal(token)
+	if e != nil {
+		return e
+	}
+	return repo.db.HSet(apiTokensKey, token.Id, data).Err()
+}
+
+func (repo *RedisApiTokenRepository) GetApiToken(id string) (*api.ApiToken, error) {
+	data, e := repo.db.HGet(apiTokensKey, id).Bytes()
+	if e == redis.Nil {
+		return nil, nil
+	}
+	if e != nil {
+		return nil, e
+	}
+	token := &api.ApiToken{}
+	e = proto.Unmarshal(data, token)
+	if e != nil {
+		return nil, e
+	}
+	return token, nil
+}
+
+func (repo *RedisApiTokenRepository) GetApiTokens() ([]*api.ApiToken, error) {
+	result, e := repo.db.HGetAll(apiTokensKey).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	tokens := make([]*api.ApiToken, 0, len(result))
+	for _, data := range result {
+		token := &api.ApiToken{}
+		e := proto.Unmarshal([]byte(data), token)
+		if e != nil {
+			return nil, e
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func (repo *RedisApiTokenRepository) DeleteApiToken(id string) (bool, error) {
+	deleted, e := repo.db.HDel(apiTokensKey, id).Result()
+	return deleted > 0, e
+}
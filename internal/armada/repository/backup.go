@@ -0,0 +1,582 @@
+package repository
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Version of the backup archive, archives of newer versions can't be restored
+const BackupVersion = 1
+
+const backupBatchSize = 1000
+
+// restoreMarkerKey holds creation time of the backup being restored, until the restore finishes.
+// Restore of the same backup can be retried into database left non-empty by failed restore.
+const restoreMarkerKey = "Backup:Restore"
+
+// Backup archive is gzip compressed json, one record per line, starting with backupHeader.
+// Objects are kept as protobuf, so fields unknown to the restoring server are not lost.
+type backupHeader struct {
+	Version int
+	Created time.Time
+}
+
+type backupRecord struct {
+	Queue    []byte          `json:",omitempty"` // api.Queue
+	Job      *jobBackup      `json:",omitempty"`
+	Cluster  *clusterBackup  `json:",omitempty"`
+	JobSets  *jobSetsBackup  `json:",omitempty"`
+	ClientId *clientIdBackup `json:",omitempty"`
+}
+
+type jobBackup struct {
+	Job           []byte           // api.Job
+	ClusterId     string           `json:",omitempty"` // cluster holding the lease, empty for queued jobs
+	StartTimes    map[string]int64 `json:",omitempty"` // clusterId -> unix nanoseconds
+	RetryAttempts int              `json:",omitempty"`
+	LeaseHistory  []string         `json:",omitempty"` // clusters the job was leased to
+}
+
+type clusterBackup struct {
+	ClusterId    string
+	UsageReport  []byte             `json:",omitempty"` // api.ClusterUsageReport
+	LeasedReport []byte             `json:",omitempty"` // api.ClusterLeasedReport
+	Priorities   map[string]float64 `json:",omitempty"`
+}
+
+type jobSetsBackup struct {
+	Queue     string
+	Closed    map[string]string `json:",omitempty"` // jobSetId -> time of closing
+	Finalized map[string]string `json:",omitempty"` // jobSetId -> time of finalization
+}
+
+type clientIdBackup struct {
+	Key   string // job:ClientId:... or job:JobSetClientId:...
+	JobId string
+	Ttl   int64 `json:",omitempty"` // milliseconds remaining at the time of backup, 0 for keys without expiry
+}
+
+type BackupSummary struct {
+	Queues     int
+	QueuedJobs int
+	LeasedJobs int
+	Clusters   int
+}
+
+// RedisBackup dumps queues, active jobs, job set states, client ids of jobs and cluster priorities kept by
+// RedisQueueRepository, RedisJobRepository, RedisJobSetRepository and RedisUsageRepository, and restores them into
+// an empty database.
+// Servers should be stopped during backup, jobs changed meanwhile could be captured in inconsistent state.
+type RedisBackup struct {
+	db      redis.UniversalClient
+	jobRepo *RedisJobRepository
+}
+
+func NewRedisBackup(db redis.UniversalClient) *RedisBackup {
+	return &RedisBackup{db: db, jobRepo: NewRedisJobRepository(db, nil, configuration.JobDeduplicationConfig{})}
+}
+
+func (b *RedisBackup) Backup(w io.Writer) (*BackupSummary, error) {
+	summary := &BackupSummary{}
+	compressed := gzip.NewWriter(w)
+	encoder := json.NewEncoder(compressed)
+
+	e := encoder.Encode(&backupHeader{Version: BackupVersion, Created: time.Now().UTC()})
+	if e != nil {
+		return nil, e
+	}
+
+	queues, e := b.db.HGetAll(queueHashKey).Result()
+	if e != nil {
+		return nil, e
+	}
+	for name, data := range queues {
+		e = encoder.Encode(&backupRecord{Queue: []byte(data)})
+		if e != nil {
+			return nil, e
+		}
+		e = b.backupQueueJobs(name, encoder, summary)
+		if e != nil {
+			return nil, e
+		}
+		e = b.backupJobSets(name, encoder)
+		if e != nil {
+			return nil, e
+		}
+		summary.Queues++
+	}
+
+	e = b.backupClientIds(encoder)
+	if e != nil {
+		return nil, e
+	}
+	e = b.backupClusters(encoder, summary)
+	if e != nil {
+		return nil, e
+	}
+	return summary, compressed.Close()
+}
+
+func (b *RedisBackup) backupQueueJobs(queue string, encoder *json.Encoder, summary *BackupSummary) error {
+	// queued and leased jobs are read at once, so jobs moving between them are not missed
+	tx := b.db.TxPipeline()
+	queuedIdsCommand := tx.ZRange(jobQueuePrefix+queue, 0, -1)
+	leasedIdsCommand := tx.ZRange(jobLeasedPrefix+queue, 0, -1)
+	_, e := tx.Exec()
+	if e != nil {
+		return e
+	}
+
+	leasedIds := leasedIdsCommand.Val()
+	clusters, e := b.jobRepo.getAssociatedCluster(leasedIds)
+	if e != nil {
+		return e
+	}
+
+	ids := append(queuedIdsCommand.Val(), leasedIds...)
+	for len(ids) > 0 {
+		take := backupBatchSize
+		if len(ids) < backupBatchSize {
+			take = len(ids)
+		}
+		jobs, e := b.getJobBackups(ids[0:take])
+		if e != nil {
+			return e
+		}
+		ids = ids[take:]
+
+		for _, job := range jobs {
+			job.ClusterId = clusters[job.jobId]
+			// leases without cluster are not consistent, such jobs are restored as queued
+			if job.ClusterId != "" {
+				summary.LeasedJobs++
+			} else {
+				summary.QueuedJobs++
+			}
+			e = encoder.Encode(&backupRecord{Job: &job.jobBackup})
+			if e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+type jobBackupWithId struct {
+	jobBackup
+	jobId string
+}
+
+func (b *RedisBackup) getJobBackups(ids []string) ([]*jobBackupWithId, error) {
+	pipe := b.db.Pipeline()
+	jobCmds := make([]*redis.StringCmd, 0, len(ids))
+	startTimeCmds := make([]*redis.StringStringMapCmd, 0, len(ids))
+	retryCmds := make([]*redis.StringCmd, 0, len(ids))
+	leaseHistoryCmds := make([]*redis.StringSliceCmd, 0, len(ids))
+	for _, id := range ids {
+		jobCmds = append(jobCmds, pipe.Get(jobObjectPrefix+id))
+		startTimeCmds = append(startTimeCmds, pipe.HGetAll(jobStartTimePrefix+id))
+		retryCmds = append(retryCmds, pipe.Get(jobRetriesPrefix+id))
+		leaseHistoryCmds = append(leaseHistoryCmds, pipe.SMembers(jobLeaseHistoryPrefix+id))
+	}
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	jobs := make([]*jobBackupWithId, 0, len(ids))
+	for i, id := range ids {
+		data, e := jobCmds[i].Bytes()
+		if e == redis.Nil {
+			log.Warnf("No job found with with job id %s", id)
+			continue
+		}
+		if e != nil {
+			return nil, e
+		}
+
+		startTimes, e := startTimeCmds[i].Result()
+		if e != nil {
+			return nil, e
+		}
+		job := &jobBackupWithId{jobId: id, jobBackup: jobBackup{Job: data}}
+		if len(startTimes) > 0 {
+			job.StartTimes = make(map[string]int64, len(startTimes))
+			for clusterId, startTime := range startTimes {
+				job.StartTimes[clusterId], e = strconv.ParseInt(startTime, 10, 64)
+				if e != nil {
+					return nil, e
+				}
+			}
+		}
+
+		retries, e := retryCmds[i].Int()
+		if e != nil && e != redis.Nil {
+			return nil, e
+		}
+		job.RetryAttempts = retries
+
+		job.LeaseHistory, e = leaseHistoryCmds[i].Result()
+		if e != nil {
+			return nil, e
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (b *RedisBackup) backupJobSets(queue string, encoder *json.Encoder) error {
+	pipe := b.db.Pipeline()
+	closedCmd := pipe.HGetAll(jobSetClosedPrefix + queue)
+	finalizedCmd := pipe.HGetAll(jobSetFinalizedPrefix + queue)
+	_, e := pipe.Exec()
+	if e != nil {
+		return e
+	}
+	if len(closedCmd.Val()) == 0 && len(finalizedCmd.Val()) == 0 {
+		return nil
+	}
+	return encoder.Encode(&backupRecord{JobSets: &jobSetsBackup{
+		Queue:     queue,
+		Closed:    closedCmd.Val(),
+		Finalized: finalizedCmd.Val(),
+	}})
+}
+
+// Client ids are kept to deduplicate jobs submitted again after the restore
+func (b *RedisBackup) backupClientIds(encoder *json.Encoder) error {
+	for _, prefix := range []string{jobClientIdPrefix, jobSetClientIdPrefix} {
+		keys, e := b.scanKeys(prefix + "*")
+		if e != nil {
+			return e
+		}
+		for len(keys) > 0 {
+			take := backupBatchSize
+			if len(keys) < backupBatchSize {
+				take = len(keys)
+			}
+			e = b.backupClientIdBatch(keys[0:take], encoder)
+			if e != nil {
+				return e
+			}
+			keys = keys[take:]
+		}
+	}
+	return nil
+}
+
+func (b *RedisBackup) backupClientIdBatch(keys []string, encoder *json.Encoder) error {
+	pipe := b.db.Pipeline()
+	jobIdCmds := make([]*redis.StringCmd, 0, len(keys))
+	ttlCmds := make([]*redis.DurationCmd, 0, len(keys))
+	for _, key := range keys {
+		jobIdCmds = append(jobIdCmds, pipe.Get(key))
+		ttlCmds = append(ttlCmds, pipe.PTTL(key))
+	}
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	for i, key := range keys {
+		jobId, e := jobIdCmds[i].Result()
+		if e == redis.Nil {
+			continue // expired meanwhile
+		}
+		if e != nil {
+			return e
+		}
+		ttl, e := ttlCmds[i].Result()
+		if e != nil {
+			return e
+		}
+		record := &clientIdBackup{Key: key, JobId: jobId}
+		if ttl > 0 {
+			record.Ttl = int64(ttl / time.Millisecond)
+		}
+		e = encoder.Encode(&backupRecord{ClientId: record})
+		if e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// scanKeys returns keys matching the pattern, in cluster mode keys are collected from all master nodes
+func (b *RedisBackup) scanKeys(pattern string) ([]string, error) {
+	cluster, isCluster := b.db.(*redis.ClusterClient)
+	if !isCluster {
+		return scanNodeKeys(b.db, pattern)
+	}
+
+	lock := sync.Mutex{}
+	keys := []string{}
+	e := cluster.ForEachMaster(func(node *redis.Client) error {
+		nodeKeys, e := scanNodeKeys(node, pattern)
+		lock.Lock()
+		defer lock.Unlock()
+		keys = append(keys, nodeKeys...)
+		return e
+	})
+	return keys, e
+}
+
+func scanNodeKeys(db redis.Cmdable, pattern string) ([]string, error) {
+	keys := []string{}
+	iterator := db.Scan(0, pattern, backupBatchSize).Iterator()
+	for iterator.Next() {
+		keys = append(keys, iterator.Val())
+	}
+	return keys, iterator.Err()
+}
+
+// dbSize returns number of keys in the database, in cluster mode keys of all master nodes are counted
+func (b *RedisBackup) dbSize() (int64, error) {
+	cluster, isCluster := b.db.(*redis.ClusterClient)
+	if !isCluster {
+		return b.db.DBSize().Result()
+	}
+
+	lock := sync.Mutex{}
+	size := int64(0)
+	e := cluster.ForEachMaster(func(node *redis.Client) error {
+		nodeSize, e := node.DBSize().Result()
+		lock.Lock()
+		defer lock.Unlock()
+		size += nodeSize
+		return e
+	})
+	return size, e
+}
+
+func (b *RedisBackup) backupClusters(encoder *json.Encoder, summary *BackupSummary) error {
+	usageReports, e := b.db.HGetAll(clusterReportKey).Result()
+	if e != nil {
+		return e
+	}
+	leasedReports, e := b.db.HGetAll(clusterLeasedReportKey).Result()
+	if e != nil {
+		return e
+	}
+
+	clusterIds := []string{}
+	for clusterId := range usageReports {
+		clusterIds = append(clusterIds, clusterId)
+	}
+	for clusterId := range leasedReports {
+		if _, exists := usageReports[clusterId]; !exists {
+			clusterIds = append(clusterIds, clusterId)
+		}
+	}
+
+	priorities, e := NewRedisUsageRepository(b.db).GetClusterPriorities(clusterIds)
+	if e != nil {
+		return e
+	}
+
+	for _, clusterId := range clusterIds {
+		cluster := &clusterBackup{ClusterId: clusterId, Priorities: priorities[clusterId]}
+		if report, exists := usageReports[clusterId]; exists {
+			cluster.UsageReport = []byte(report)
+		}
+		if report, exists := leasedReports[clusterId]; exists {
+			cluster.LeasedReport = []byte(report)
+		}
+		e = encoder.Encode(&backupRecord{Cluster: cluster})
+		if e != nil {
+			return e
+		}
+		summary.Clusters++
+	}
+	return nil
+}
+
+// Restore loads the backup into empty database.
+// Leases are renewed at the time of restore, so executors have full lease period to renew leases of jobs they still run.
+// When restore fails, restore of the same backup can be run again, as all records are written idempotently.
+func (b *RedisBackup) Restore(r io.Reader) (*BackupSummary, error) {
+	compressed, e := gzip.NewReader(r)
+	if e != nil {
+		return nil, e
+	}
+	decoder := json.NewDecoder(bufio.NewReader(compressed))
+
+	header := &backupHeader{}
+	e = decoder.Decode(header)
+	if e != nil {
+		return nil, fmt.Errorf("failed to read backup header: %v", e)
+	}
+	if header.Version < 1 || header.Version > BackupVersion {
+		return nil, fmt.Errorf("backup version %d is not supported, latest supported version is %d", header.Version, BackupVersion)
+	}
+
+	e = b.startRestore(header)
+	if e != nil {
+		return nil, e
+	}
+
+	summary := &BackupSummary{}
+	leaseTime := float64(time.Now().UnixNano())
+	pipe := b.db.Pipeline()
+	pending := 0
+	for {
+		record := &backupRecord{}
+		e = decoder.Decode(record)
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			return nil, e
+		}
+
+		switch {
+		case record.Queue != nil:
+			e = restoreQueue(pipe, record.Queue)
+			summary.Queues++
+		case record.Job != nil:
+			e = restoreJob(pipe, record.Job, leaseTime)
+			if record.Job.ClusterId != "" {
+				summary.LeasedJobs++
+			} else {
+				summary.QueuedJobs++
+			}
+		case record.Cluster != nil:
+			restoreCluster(pipe, record.Cluster)
+			summary.Clusters++
+		case record.JobSets != nil:
+			restoreJobSets(pipe, record.JobSets)
+		case record.ClientId != nil:
+			e = restoreClientId(pipe, record.ClientId, header.Created)
+		}
+		if e != nil {
+			return nil, e
+		}
+
+		pending++
+		if pending >= backupBatchSize {
+			_, e = pipe.Exec()
+			if e != nil {
+				return nil, e
+			}
+			pending = 0
+		}
+	}
+
+	_, e = pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+	e = b.db.Del(restoreMarkerKey).Err()
+	if e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (b *RedisBackup) startRestore(header *backupHeader) error {
+	backupId := strconv.FormatInt(header.Created.UnixNano(), 10)
+	size, e := b.dbSize()
+	if e != nil {
+		return e
+	}
+	if size > 0 {
+		restoring, e := b.db.Get(restoreMarkerKey).Result()
+		if e == redis.Nil {
+			return fmt.Errorf("backup can be restored only to empty database, found %d keys", size)
+		}
+		if e != nil {
+			return e
+		}
+		if restoring != backupId {
+			return fmt.Errorf("database contains unfinished restore of another backup, found %d keys", size)
+		}
+		log.Infof("Resuming unfinished restore of backup created at %s", header.Created)
+	}
+	return b.db.Set(restoreMarkerKey, backupId, 0).Err()
+}
+
+func restoreQueue(pipe redis.Pipeliner, data []byte) error {
+	queue := &api.Queue{}
+	e := proto.Unmarshal(data, queue)
+	if e != nil {
+		return e
+	}
+	pipe.HSet(queueHashKey, queue.Name, data)
+	return nil
+}
+
+func restoreJob(pipe redis.Pipeliner, backup *jobBackup, leaseTime float64) error {
+	job := &api.Job{}
+	e := proto.Unmarshal(backup.Job, job)
+	if e != nil {
+		return e
+	}
+
+	pipe.Set(jobObjectPrefix+job.Id, backup.Job, 0)
+	pipe.SAdd(jobSetPrefix+job.JobSetId, job.Id)
+	if backup.ClusterId != "" {
+		pipe.HSet(jobClusterMapKey, job.Id, backup.ClusterId)
+		pipe.ZAdd(jobLeasedPrefix+job.Queue, redis.Z{Member: job.Id, Score: leaseTime})
+	} else {
+		pipe.ZAdd(jobQueuePrefix+job.Queue, redis.Z{Member: job.Id, Score: job.Priority})
+	}
+	for clusterId, startTime := range backup.StartTimes {
+		pipe.HSet(jobStartTimePrefix+job.Id, clusterId, startTime)
+	}
+	if backup.RetryAttempts > 0 {
+		pipe.Set(jobRetriesPrefix+job.Id, backup.RetryAttempts, 0)
+	}
+	for _, clusterId := range backup.LeaseHistory {
+		pipe.SAdd(jobLeaseHistoryPrefix+job.Id, clusterId)
+	}
+	return nil
+}
+
+func restoreCluster(pipe redis.Pipeliner, cluster *clusterBackup) {
+	if cluster.UsageReport != nil {
+		pipe.HSet(clusterReportKey, cluster.ClusterId, cluster.UsageReport)
+	}
+	if cluster.LeasedReport != nil {
+		pipe.HSet(clusterLeasedReportKey, cluster.ClusterId, cluster.LeasedReport)
+	}
+	if len(cluster.Priorities) > 0 {
+		untyped := make(map[string]interface{}, len(cluster.Priorities))
+		for queue, priority := range cluster.Priorities {
+			untyped[queue] = priority
+		}
+		pipe.HMSet(clusterPrioritiesPrefix+cluster.ClusterId, untyped)
+	}
+}
+
+func restoreJobSets(pipe redis.Pipeliner, jobSets *jobSetsBackup) {
+	for jobSetId, closed := range jobSets.Closed {
+		pipe.HSet(jobSetClosedPrefix+jobSets.Queue, jobSetId, closed)
+	}
+	for jobSetId, finalized := range jobSets.Finalized {
+		pipe.HSet(jobSetFinalizedPrefix+jobSets.Queue, jobSetId, finalized)
+	}
+}
+
+func restoreClientId(pipe redis.Pipeliner, clientId *clientIdBackup, backupCreated time.Time) error {
+	if !strings.HasPrefix(clientId.Key, jobClientIdPrefix) && !strings.HasPrefix(clientId.Key, jobSetClientIdPrefix) {
+		return fmt.Errorf("unexpected client id key %s", clientId.Key)
+	}
+	if clientId.Ttl == 0 {
+		pipe.Set(clientId.Key, clientId.JobId, 0)
+		return nil
+	}
+	// deduplication window keeps running since the backup
+	ttl := time.Duration(clientId.Ttl)*time.Millisecond - time.Since(backupCreated)
+	if ttl > 0 {
+		pipe.Set(clientId.Key, clientId.JobId, ttl)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestRedisBackup_RestoresQueuesJobsAndClusters(t *testing.T) {
+	withMiniRedis(t, func(source redis.UniversalClient) {
+		queueRepo := NewRedisQueueRepository(source)
+		jobRepo := NewRedisJobRepository(source, nil, testDeduplication)
+		usageRepo := NewRedisUsageRepository(source)
+
+		assert.Nil(t, queueRepo.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 2}))
+		assert.Nil(t, queueRepo.CreateQueue(&api.Queue{Name: "queue2", PriorityFactor: 1}))
+		queuedJob := addTestJob(t, jobRepo, "queue1")
+		leasedJob := addLeasedJob(t, jobRepo, "queue1", "cluster1")
+		deletedJob := addTestJob(t, jobRepo, "queue1")
+		jobRepo.DeleteJobs([]*api.Job{deletedJob})
+		clientIdJob := addTestJobWithClientId(t, jobRepo, "queue2", "client1")
+
+		jobSetRepo := NewRedisJobSetRepository(source)
+		closed, e := jobSetRepo.CloseJobSet("queue1", "closedSet")
+		assert.Nil(t, e)
+		assert.True(t, closed)
+		closed, e = jobSetRepo.CloseJobSet("queue1", "finalizedSet")
+		assert.Nil(t, e)
+		assert.True(t, closed)
+		finalized, e := jobSetRepo.FinalizeJobSet("queue1", "finalizedSet")
+		assert.Nil(t, e)
+		assert.True(t, finalized)
+
+		startTime := time.Now()
+		assert.Nil(t, jobRepo.UpdateStartTime(leasedJob.Id, "cluster1", startTime))
+		assert.Nil(t, jobRepo.AddRetryAttempt(leasedJob.Id))
+		assert.Nil(t, jobRepo.AddRetryAttempt(leasedJob.Id))
+		assert.Nil(t, usageRepo.UpdateCluster(&api.ClusterUsageReport{ClusterId: "cluster1"}, map[string]float64{"queue1": 1.5}))
+
+		archive := &bytes.Buffer{}
+		summary, e := NewRedisBackup(source).Backup(archive)
+		assert.Nil(t, e)
+		assert.Equal(t, &BackupSummary{Queues: 2, QueuedJobs: 2, LeasedJobs: 1, Clusters: 1}, summary)
+
+		withMiniRedis(t, func(target redis.UniversalClient) {
+			summary, e := NewRedisBackup(target).Restore(archive)
+			assert.Nil(t, e)
+			assert.Equal(t, &BackupSummary{Queues: 2, QueuedJobs: 2, LeasedJobs: 1, Clusters: 1}, summary)
+
+			queue, e := NewRedisQueueRepository(target).GetQueue("queue1")
+			assert.Nil(t, e)
+			assert.Equal(t, float64(2), queue.PriorityFactor)
+
+			restoredJobRepo := NewRedisJobRepository(target, nil, testDeduplication)
+			queuedIds, e := restoredJobRepo.GetQueueJobIds("queue1")
+			assert.Nil(t, e)
+			assert.Equal(t, []string{queuedJob.Id}, queuedIds)
+
+			leasedIds, e := restoredJobRepo.GetLeasedJobIds("queue1")
+			assert.Nil(t, e)
+			assert.Equal(t, []string{leasedJob.Id}, leasedIds)
+
+			renewed, e := restoredJobRepo.RenewLease("cluster1", []string{leasedJob.Id})
+			assert.Nil(t, e)
+			assert.Equal(t, []string{leasedJob.Id}, renewed)
+
+			runInfos, e := restoredJobRepo.GetJobRunInfos([]string{leasedJob.Id})
+			assert.Nil(t, e)
+			assert.Equal(t, startTime.UnixNano(), runInfos[leasedJob.Id].StartTime.UnixNano())
+
+			retries, e := restoredJobRepo.GetNumberOfRetryAttempts(leasedJob.Id)
+			assert.Nil(t, e)
+			assert.Equal(t, 2, retries)
+
+			leaseHistory, e := restoredJobRepo.GetLeaseHistory([]string{leasedJob.Id})
+			assert.Nil(t, e)
+			assert.Equal(t, map[string][]string{leasedJob.Id: {"cluster1"}}, leaseHistory)
+
+			activeIds, e := restoredJobRepo.GetActiveJobIds("queue1", "set1")
+			assert.Nil(t, e)
+			assert.ElementsMatch(t, []string{queuedJob.Id, leasedJob.Id}, activeIds)
+
+			priorities, e := NewRedisUsageRepository(target).GetClusterPriority("cluster1")
+			assert.Nil(t, e)
+			assert.Equal(t, map[string]float64{"queue1": 1.5}, priorities)
+
+			restoredJobSetRepo := NewRedisJobSetRepository(target)
+			state, e := restoredJobSetRepo.GetJobSetState("queue1", "closedSet")
+			assert.Nil(t, e)
+			assert.Equal(t, JobSetClosed, state)
+			state, e = restoredJobSetRepo.GetJobSetState("queue1", "finalizedSet")
+			assert.Nil(t, e)
+			assert.Equal(t, JobSetFinalized, state)
+
+			duplicate := addTestJobWithClientId(t, restoredJobRepo, "queue2", "client1")
+			assert.Equal(t, clientIdJob.Id, duplicate.Id)
+		})
+	})
+}
+
+func TestRedisBackup_RestoreRequiresEmptyDatabase(t *testing.T) {
+	withMiniRedis(t, func(db redis.UniversalClient) {
+		archive := &bytes.Buffer{}
+		_, e := NewRedisBackup(db).Backup(archive)
+		assert.Nil(t, e)
+
+		assert.Nil(t, NewRedisQueueRepository(db).CreateQueue(&api.Queue{Name: "queue1"}))
+		_, e = NewRedisBackup(db).Restore(archive)
+		assert.Error(t, e)
+	})
+}
+
+func TestRedisBackup_RestoreCanBeRetriedAfterFailure(t *testing.T) {
+	withMiniRedis(t, func(source redis.UniversalClient) {
+		jobRepo := NewRedisJobRepository(source, nil, testDeduplication)
+		assert.Nil(t, NewRedisQueueRepository(source).CreateQueue(&api.Queue{Name: "queue1"}))
+		job := addTestJob(t, jobRepo, "queue1")
+
+		archive := &bytes.Buffer{}
+		_, e := NewRedisBackup(source).Backup(archive)
+		assert.Nil(t, e)
+		otherArchive := &bytes.Buffer{}
+		_, e = NewRedisBackup(source).Backup(otherArchive)
+		assert.Nil(t, e)
+
+		withMiniRedis(t, func(target redis.UniversalClient) {
+			assert.Nil(t, target.Set("unrelated", "value", 0).Err())
+			_, e := NewRedisBackup(target).Restore(bytes.NewReader(archive.Bytes()))
+			assert.Error(t, e)
+		})
+
+		withMiniRedis(t, func(target redis.UniversalClient) {
+			truncated := archive.Bytes()[0 : archive.Len()-10]
+			_, e := NewRedisBackup(target).Restore(bytes.NewReader(truncated))
+			assert.Error(t, e)
+			exists, e := target.Exists(restoreMarkerKey).Result()
+			assert.Nil(t, e)
+			assert.Equal(t, int64(1), exists)
+
+			_, e = NewRedisBackup(target).Restore(bytes.NewReader(otherArchive.Bytes()))
+			assert.Error(t, e)
+
+			summary, e := NewRedisBackup(target).Restore(bytes.NewReader(archive.Bytes()))
+			assert.Nil(t, e)
+			assert.Equal(t, &BackupSummary{Queues: 1, QueuedJobs: 1}, summary)
+
+			queuedIds, e := NewRedisJobRepository(target, nil, testDeduplication).GetQueueJobIds("queue1")
+			assert.Nil(t, e)
+			assert.Equal(t, []string{job.Id}, queuedIds)
+
+			exists, e = target.Exists(restoreMarkerKey).Result()
+			assert.Nil(t, e)
+			assert.Equal(t, int64(0), exists)
+		})
+	})
+}
+
+func withMiniRedis(t *testing.T, action func(db redis.UniversalClient)) {
+	server, e := miniredis.Run()
+	assert.Nil(t, e)
+	defer server.Close()
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+
+	action(client)
+}
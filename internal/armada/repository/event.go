@@ -1,25 +1,61 @@
 package repository
 
 import (
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 const eventStreamPrefix = "Events:"
+const queueEventStreamPrefix = "QueueEvents:"
+const jobSetSnapshotPrefix = "JobSetSnapshot:" // {queue}:{jobSetId}:{Status|Cluster|LastMessage} - map jobId -> value, {queue}:{jobSetId}:Offset - last message id
 const dataKey = "message"
 
 type EventStore interface {
 	ReportEvents(message []*api.EventMessage) error
 }
 
+// CompositeEventStore reports events to the primary store and then to secondary stores.
+// Only failures of the primary store are returned, once the primary store accepted the events reporting them
+// must not fail, otherwise callers would retry events which were already stored.
+// Failures of secondary stores are logged.
+type CompositeEventStore struct {
+	primary   EventStore
+	secondary []EventStore
+}
+
+func NewCompositeEventStore(primary EventStore, secondary ...EventStore) *CompositeEventStore {
+	return &CompositeEventStore{primary: primary, secondary: secondary}
+}
+
+func (c *CompositeEventStore) ReportEvents(messages []*api.EventMessage) error {
+	if e := c.primary.ReportEvents(messages); e != nil {
+		return e
+	}
+	for _, store := range c.secondary {
+		if e := store.ReportEvents(messages); e != nil {
+			log.Errorf("Error while reporting %d events to secondary event store: %v", len(messages), e)
+		}
+	}
+	return nil
+}
+
+// EventRepository stores reported events and reads them back as streams of job sets and queues
 type EventRepository interface {
+	EventStore
 	ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error)
 	GetLastMessageId(queue, jobSetId string) (string, error)
+	// ReadQueueEvents reads events of all job sets in the queue, message ids are specific to the queue stream
+	ReadQueueEvents(queue string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error)
+	// GetJobSetSnapshot returns current state of jobs in the job set, updated atomically with the job set event stream
+	GetJobSetSnapshot(queue, jobSetId string) (*api.JobSetState, error)
 }
 
 type RedisEventRepository struct {
@@ -41,11 +77,17 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 	}
 
 	type eventData struct {
-		key  string
-		data []byte
+		key       string
+		queueKey  string
+		snapshot  snapshotKeys
+		jobId     string
+		status    string
+		clusterId string
+		data      []byte
 	}
 	data := []eventData{}
 	uniqueJobSets := make(map[string]bool)
+	uniqueQueues := make(map[string]bool)
 
 	for _, m := range messages {
 		event, e := api.UnwrapEvent(m)
@@ -57,14 +99,28 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 			return e
 		}
 		key := getJobSetEventsKey(event.GetQueue(), event.GetJobSetId())
-		data = append(data, eventData{key: key, data: messageData})
+		queueKey := getQueueEventsKey(event.GetQueue())
+		status, clusterId := snapshotUpdate(event)
+		data = append(data, eventData{
+			key:       key,
+			queueKey:  queueKey,
+			snapshot:  getJobSetSnapshotKeys(event.GetQueue(), event.GetJobSetId()),
+			jobId:     event.GetJobId(),
+			status:    status,
+			clusterId: clusterId,
+			data:      messageData,
+		})
 		uniqueJobSets[key] = true
+		uniqueQueues[queueKey] = true
 	}
 
 	pipe := repo.db.Pipeline()
+	addEventScript.Load(pipe)
 	for _, e := range data {
+		addEventScript.Run(pipe, append([]string{e.key}, e.snapshot.all()...), e.data, e.jobId, e.status, e.clusterId)
 		pipe.XAdd(&redis.XAddArgs{
-			Stream: e.key,
+			Stream:       e.queueKey,
+			MaxLenApprox: repo.eventRetention.MaxQueueStreamLength,
 			Values: map[string]interface{}{
 				dataKey: e.data,
 			},
@@ -75,20 +131,130 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 		for key, _ := range uniqueJobSets {
 			pipe.Expire(key, repo.eventRetention.RetentionDuration)
 		}
+		for _, e := range data {
+			for _, key := range e.snapshot.all() {
+				pipe.Expire(key, repo.eventRetention.RetentionDuration)
+			}
+		}
+		for key := range uniqueQueues {
+			pipe.Expire(key, repo.eventRetention.RetentionDuration)
+		}
 	}
 
 	_, e := pipe.Exec()
 	return e
 }
 
+// Stream id of the event is generated inside the script, so the snapshot can't get out of order with the stream
+var addEventScript = redis.NewScript(`
+local stream = KEYS[1]
+local statusKey = KEYS[2]
+local clusterKey = KEYS[3]
+local lastMessageKey = KEYS[4]
+local offsetKey = KEYS[5]
+
+local data = ARGV[1]
+local jobId = ARGV[2]
+local status = ARGV[3]
+local clusterId = ARGV[4]
+
+local id = redis.call('XADD', stream, '*', 'message', data)
+if jobId ~= '' then
+	if status ~= '' then
+		redis.call('HSET', statusKey, jobId, status)
+		redis.call('HSET', clusterKey, jobId, clusterId)
+	end
+	redis.call('HSET', lastMessageKey, jobId, id)
+end
+redis.call('SET', offsetKey, id)
+return id
+`)
+
+// Returns status of the job after the event and its cluster, status is empty for events which don't change the status
+func snapshotUpdate(event api.Event) (string, string) {
+	status, clusterId, changed := jobStatusUpdate(event)
+	if !changed {
+		return "", ""
+	}
+	return strconv.Itoa(int(status)), clusterId
+}
+
+// Returns status of the job after the event and its cluster, false is returned for events which don't change the status
+func jobStatusUpdate(event api.Event) (api.JobStatus, string, bool) {
+	status, changed := api.JobStatusFromEvent(event)
+	if !changed {
+		return status, "", false
+	}
+	clusterId := ""
+	switch typed := event.(type) {
+	case *api.JobLeasedEvent:
+		clusterId = typed.ClusterId
+	case api.KubernetesEvent:
+		clusterId = typed.GetClusterId()
+	}
+	return status, clusterId, true
+}
+
+func (repo *RedisEventRepository) GetJobSetSnapshot(queue, jobSetId string) (*api.JobSetState, error) {
+	keys := getJobSetSnapshotKeys(queue, jobSetId)
+
+	tx := repo.db.TxPipeline()
+	statusCmd := tx.HGetAll(keys.status)
+	clusterCmd := tx.HGetAll(keys.cluster)
+	lastMessageCmd := tx.HGetAll(keys.lastMessage)
+	offsetCmd := tx.Get(keys.offset)
+	_, e := tx.Exec()
+	if e != nil && e != redis.Nil {
+		return nil, e
+	}
+
+	offset, e := offsetCmd.Result()
+	if e == redis.Nil {
+		offset = ""
+	} else if e != nil {
+		return nil, e
+	}
+
+	statuses := statusCmd.Val()
+	clusters := clusterCmd.Val()
+	jobs := make([]*api.JobState, 0, len(lastMessageCmd.Val()))
+	for jobId, lastMessageId := range lastMessageCmd.Val() {
+		status, _ := strconv.Atoi(statuses[jobId])
+		jobs = append(jobs, &api.JobState{
+			JobId:         jobId,
+			Status:        api.JobStatus(status),
+			ClusterId:     clusters[jobId],
+			LastMessageId: lastMessageId,
+		})
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].JobId < jobs[j].JobId
+	})
+
+	return &api.JobSetState{
+		Queue:         queue,
+		JobSetId:      jobSetId,
+		Jobs:          jobs,
+		LastMessageId: offset,
+	}, nil
+}
+
 func (repo *RedisEventRepository) ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error) {
+	return repo.readStream(getJobSetEventsKey(queue, jobSetId), lastId, limit, block)
+}
+
+func (repo *RedisEventRepository) ReadQueueEvents(queue string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error) {
+	return repo.readStream(getQueueEventsKey(queue), lastId, limit, block)
+}
+
+func (repo *RedisEventRepository) readStream(key string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error) {
 
 	if lastId == "" {
 		lastId = "0"
 	}
 
 	cmd, e := repo.db.XRead(&redis.XReadArgs{
-		Streams: []string{getJobSetEventsKey(queue, jobSetId), lastId},
+		Streams: []string{key, lastId},
 		Count:   limit,
 		Block:   block,
 	}).Result()
@@ -130,3 +296,28 @@ func (repo *RedisEventRepository) GetLastMessageId(queue, jobSetId string) (stri
 func getJobSetEventsKey(queue, jobSetId string) string {
 	return eventStreamPrefix + queue + ":" + jobSetId
 }
+
+type snapshotKeys struct {
+	status      string
+	cluster     string
+	lastMessage string
+	offset      string
+}
+
+func (k snapshotKeys) all() []string {
+	return []string{k.status, k.cluster, k.lastMessage, k.offset}
+}
+
+func getJobSetSnapshotKeys(queue, jobSetId string) snapshotKeys {
+	prefix := jobSetSnapshotPrefix + queue + ":" + jobSetId + ":"
+	return snapshotKeys{
+		status:      prefix + "Status",
+		cluster:     prefix + "Cluster",
+		lastMessage: prefix + "LastMessage",
+		offset:      prefix + "Offset",
+	}
+}
+
+func getQueueEventsKey(queue string) string {
+	return queueEventStreamPrefix + queue
+}
@@ -0,0 +1,472 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/gogo/protobuf/proto"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common/database"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const eventNotificationChannel = "armada_events" // payload is the queue of reported events
+const eventLockNamespace = 7301                  // namespace of advisory locks serializing event ids of a queue
+
+var (
+	eventTable          = goqu.T("event")
+	jobSetSnapshotTable = goqu.T("job_set_snapshot")
+
+	event_eventId  = goqu.I("event.event_id")
+	event_queue    = goqu.I("event.queue")
+	event_jobSet   = goqu.I("event.jobset")
+	event_reported = goqu.I("event.reported")
+	event_message  = goqu.I("event.message")
+
+	jobSetSnapshot_queue       = goqu.I("job_set_snapshot.queue")
+	jobSetSnapshot_jobSet      = goqu.I("job_set_snapshot.jobset")
+	jobSetSnapshot_jobId       = goqu.I("job_set_snapshot.job_id")
+	jobSetSnapshot_status      = goqu.I("job_set_snapshot.status")
+	jobSetSnapshot_cluster     = goqu.I("job_set_snapshot.cluster")
+	jobSetSnapshot_lastEventId = goqu.I("job_set_snapshot.last_event_id")
+	jobSetSnapshot_updated     = goqu.I("job_set_snapshot.updated")
+)
+
+type eventRow struct {
+	EventId int64  `db:"event_id"`
+	Message []byte `db:"message"`
+}
+
+type jobSetSnapshotRow struct {
+	JobId       string `db:"job_id"`
+	Status      int32  `db:"status"`
+	Cluster     string `db:"cluster"`
+	LastEventId int64  `db:"last_event_id"`
+}
+
+// PostgresEventRepository keeps events in Postgres, so their retention is not limited by Redis memory.
+// Readers waiting for new events are woken up by notifications from Listen, and check for new events every pollInterval
+// in case a notification is missed.
+type PostgresEventRepository struct {
+	db             *goqu.Database
+	eventRetention configuration.EventRetentionPolicy
+	pollInterval   time.Duration
+	waiters        *eventWaiters
+}
+
+func NewPostgresEventRepository(db *goqu.Database, eventRetention configuration.EventRetentionPolicy, pollInterval time.Duration) *PostgresEventRepository {
+	return &PostgresEventRepository{
+		db:             db,
+		eventRetention: eventRetention,
+		pollInterval:   pollInterval,
+		waiters:        newEventWaiters(),
+	}
+}
+
+// Listen wakes up blocked readers when events are reported by any server, until the listener is closed
+func (repo *PostgresEventRepository) Listen(listener *pq.Listener) error {
+	e := listener.Listen(eventNotificationChannel)
+	if e != nil {
+		return e
+	}
+	go func() {
+		for notification := range listener.Notify {
+			if notification == nil {
+				// connection was re-established, notifications could be lost meanwhile
+				repo.waiters.wakeAll()
+				continue
+			}
+			repo.waiters.wake(notification.Extra)
+		}
+	}()
+	return nil
+}
+
+func (repo *PostgresEventRepository) ReportEvents(messages []*api.EventMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	events := make([]api.Event, 0, len(messages))
+	data := make([][]byte, 0, len(messages))
+	uniqueQueues := map[string]bool{}
+	for _, message := range messages {
+		event, e := api.UnwrapEvent(message)
+		if e != nil {
+			return e
+		}
+		messageData, e := proto.Marshal(message)
+		if e != nil {
+			return e
+		}
+		events = append(events, event)
+		data = append(data, messageData)
+		uniqueQueues[event.GetQueue()] = true
+	}
+	queues := make([]string, 0, len(uniqueQueues))
+	for queue := range uniqueQueues {
+		queues = append(queues, queue)
+	}
+	// locks are always taken in the same order to avoid deadlocks
+	sort.Strings(queues)
+
+	now := time.Now().UTC()
+	return repo.db.WithTx(func(tx *goqu.TxDatabase) error {
+		// ids are allocated and committed by one transaction of the queue at a time,
+		// so readers never skip events which are committed later with lower ids
+		for _, queue := range queues {
+			_, e := tx.Exec("SELECT pg_advisory_xact_lock($1, hashtext($2))", eventLockNamespace, queue)
+			if e != nil {
+				return e
+			}
+		}
+
+		ids, e := allocateEventIds(tx, len(events))
+		if e != nil {
+			return e
+		}
+
+		eventRecords := make([]interface{}, 0, len(events))
+		snapshots := newJobSetSnapshotUpdates()
+		for i, event := range events {
+			eventRecords = append(eventRecords, goqu.Record{
+				"event_id": ids[i],
+				"queue":    event.GetQueue(),
+				"jobset":   event.GetJobSetId(),
+				"reported": now,
+				"message":  data[i],
+			})
+			snapshots.add(event, ids[i])
+		}
+
+		_, e = tx.Insert(eventTable).Rows(eventRecords...).Prepared(true).Executor().Exec()
+		if e != nil {
+			return e
+		}
+
+		snapshotRecords := snapshots.records(now)
+		if len(snapshotRecords) > 0 {
+			// status 0 means the events did not change status of the job
+			_, e = tx.Insert(jobSetSnapshotTable).
+				Rows(snapshotRecords...).
+				OnConflict(goqu.DoUpdate("queue, jobset, job_id", goqu.Record{
+					"status":        goqu.L("CASE WHEN EXCLUDED.status = 0 THEN job_set_snapshot.status ELSE EXCLUDED.status END"),
+					"cluster":       goqu.L("CASE WHEN EXCLUDED.status = 0 THEN job_set_snapshot.cluster ELSE EXCLUDED.cluster END"),
+					"last_event_id": goqu.L("EXCLUDED.last_event_id"),
+					"updated":       goqu.L("EXCLUDED.updated"),
+				})).
+				Prepared(true).Executor().Exec()
+			if e != nil {
+				return e
+			}
+		}
+
+		// notifications are delivered when the transaction commits
+		for _, queue := range queues {
+			_, e := tx.Exec("SELECT pg_notify($1, $2)", eventNotificationChannel, queue)
+			if e != nil {
+				return e
+			}
+		}
+		return nil
+	})
+}
+
+func allocateEventIds(tx *goqu.TxDatabase, count int) ([]int64, error) {
+	ids := make([]int64, 0, count)
+	e := tx.ScanVals(&ids, "SELECT nextval(pg_get_serial_sequence('event', 'event_id')) FROM generate_series(1, $1)", count)
+	if e != nil {
+		return nil, e
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+type jobSetSnapshotKey struct {
+	queue    string
+	jobSetId string
+	jobId    string
+}
+
+type jobSetSnapshotUpdate struct {
+	status      api.JobStatus
+	clusterId   string
+	lastEventId int64
+}
+
+// Changes of the snapshot are merged per job, as one insert can't update the same row twice
+type jobSetSnapshotUpdates struct {
+	keys    []jobSetSnapshotKey
+	updates map[jobSetSnapshotKey]*jobSetSnapshotUpdate
+}
+
+func newJobSetSnapshotUpdates() *jobSetSnapshotUpdates {
+	return &jobSetSnapshotUpdates{updates: map[jobSetSnapshotKey]*jobSetSnapshotUpdate{}}
+}
+
+func (s *jobSetSnapshotUpdates) add(event api.Event, eventId int64) {
+	if event.GetJobId() == "" {
+		return
+	}
+	key := jobSetSnapshotKey{queue: event.GetQueue(), jobSetId: event.GetJobSetId(), jobId: event.GetJobId()}
+	update, exists := s.updates[key]
+	if !exists {
+		update = &jobSetSnapshotUpdate{}
+		s.updates[key] = update
+		s.keys = append(s.keys, key)
+	}
+	update.lastEventId = eventId
+
+	if status, clusterId, changed := jobStatusUpdate(event); changed {
+		update.status = status
+		update.clusterId = clusterId
+	}
+}
+
+func (s *jobSetSnapshotUpdates) records(now time.Time) []interface{} {
+	records := make([]interface{}, 0, len(s.keys))
+	for _, key := range s.keys {
+		update := s.updates[key]
+		records = append(records, goqu.Record{
+			"queue":         key.queue,
+			"jobset":        key.jobSetId,
+			"job_id":        key.jobId,
+			"status":        int32(update.status),
+			"cluster":       update.clusterId,
+			"last_event_id": update.lastEventId,
+			"updated":       now,
+		})
+	}
+	return records
+}
+
+func (repo *PostgresEventRepository) ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error) {
+	return repo.readBlocking(queue, lastId, limit, block, event_jobSet.Eq(jobSetId))
+}
+
+func (repo *PostgresEventRepository) ReadQueueEvents(queue string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error) {
+	return repo.readBlocking(queue, lastId, limit, block)
+}
+
+// readBlocking follows semantics of redis XREAD, negative block does not wait and zero block waits until there are new events
+func (repo *PostgresEventRepository) readBlocking(queue string, lastId string, limit int64, block time.Duration, filters ...goqu.Expression) ([]*api.EventStreamMessage, error) {
+	lastEventId, e := parseEventId(lastId)
+	if e != nil {
+		return nil, e
+	}
+
+	var deadline <-chan time.Time
+	if block > 0 {
+		timer := time.NewTimer(block)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	poll := time.NewTicker(repo.pollInterval)
+	defer poll.Stop()
+
+	for {
+		// waiter is registered before reading, so events reported in between are not missed
+		wake, stopWaiting := repo.waiters.add(queue)
+		messages, e := repo.read(queue, lastEventId, limit, filters)
+		if e != nil || len(messages) > 0 || block < 0 {
+			stopWaiting()
+			return messages, e
+		}
+
+		select {
+		case <-wake:
+		case <-poll.C:
+		case <-deadline:
+			stopWaiting()
+			return messages, nil
+		}
+		stopWaiting()
+	}
+}
+
+func (repo *PostgresEventRepository) read(queue string, lastEventId int64, limit int64, filters []goqu.Expression) ([]*api.EventStreamMessage, error) {
+	conditions := append([]goqu.Expression{event_queue.Eq(queue), event_eventId.Gt(lastEventId)}, filters...)
+	ds := repo.db.
+		From(eventTable).
+		Select(event_eventId, event_message).
+		Where(conditions...).
+		Order(event_eventId.Asc()).
+		Limit(uint(limit))
+
+	rows := []*eventRow{}
+	e := ds.Prepared(true).ScanStructs(&rows)
+	if e != nil {
+		return nil, e
+	}
+
+	messages := make([]*api.EventStreamMessage, 0, len(rows))
+	for _, row := range rows {
+		msg := &api.EventMessage{}
+		e = proto.Unmarshal(row.Message, msg)
+		if e != nil {
+			return nil, e
+		}
+		messages = append(messages, &api.EventStreamMessage{Id: formatEventId(row.EventId), Message: msg})
+	}
+	return messages, nil
+}
+
+func (repo *PostgresEventRepository) GetLastMessageId(queue, jobSetId string) (string, error) {
+	lastEventId, e := getLastEventId(repo.db, queue, jobSetId)
+	if e != nil {
+		return "", e
+	}
+	if !lastEventId.Valid {
+		return "0", nil
+	}
+	return formatEventId(lastEventId.Int64), nil
+}
+
+func (repo *PostgresEventRepository) GetJobSetSnapshot(queue, jobSetId string) (*api.JobSetState, error) {
+	// snapshot and last event id are read from the same database snapshot, as they are written by the same transactions
+	tx, e := repo.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if e != nil {
+		return nil, e
+	}
+
+	rows := []*jobSetSnapshotRow{}
+	var lastEventId sql.NullInt64
+	e = tx.Wrap(func() error {
+		e := tx.From(jobSetSnapshotTable).
+			Select(jobSetSnapshot_jobId, jobSetSnapshot_status, jobSetSnapshot_cluster, jobSetSnapshot_lastEventId).
+			Where(jobSetSnapshot_queue.Eq(queue), jobSetSnapshot_jobSet.Eq(jobSetId)).
+			Order(jobSetSnapshot_jobId.Asc()).
+			Prepared(true).
+			ScanStructs(&rows)
+		if e != nil {
+			return e
+		}
+		lastEventId, e = getLastEventId(tx, queue, jobSetId)
+		return e
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	jobs := make([]*api.JobState, 0, len(rows))
+	for _, row := range rows {
+		jobs = append(jobs, &api.JobState{
+			JobId:         row.JobId,
+			Status:        api.JobStatus(row.Status),
+			ClusterId:     row.Cluster,
+			LastMessageId: formatEventId(row.LastEventId),
+		})
+	}
+	snapshot := &api.JobSetState{Queue: queue, JobSetId: jobSetId, Jobs: jobs}
+	if lastEventId.Valid {
+		snapshot.LastMessageId = formatEventId(lastEventId.Int64)
+	}
+	return snapshot, nil
+}
+
+// DeleteExpiredEvents deletes events and job snapshots older than the retention duration
+func (repo *PostgresEventRepository) DeleteExpiredEvents() {
+	if !repo.eventRetention.ExpiryEnabled {
+		return
+	}
+	expired := time.Now().UTC().Add(-repo.eventRetention.RetentionDuration)
+
+	result, e := repo.db.Delete(eventTable).Where(event_reported.Lt(expired)).Prepared(true).Executor().Exec()
+	if e != nil {
+		log.Errorf("Error while deleting expired events: %v", e)
+		return
+	}
+	deleted, _ := result.RowsAffected()
+
+	_, e = repo.db.Delete(jobSetSnapshotTable).Where(jobSetSnapshot_updated.Lt(expired)).Prepared(true).Executor().Exec()
+	if e != nil {
+		log.Errorf("Error while deleting expired job set snapshots: %v", e)
+		return
+	}
+	log.Infof("Deleted %d expired events", deleted)
+}
+
+func getLastEventId(db database.GoquDatabase, queue, jobSetId string) (sql.NullInt64, error) {
+	var lastEventId sql.NullInt64
+	_, e := db.From(eventTable).
+		Select(goqu.MAX(event_eventId)).
+		Where(event_queue.Eq(queue), event_jobSet.Eq(jobSetId)).
+		Prepared(true).
+		ScanVal(&lastEventId)
+	return lastEventId, e
+}
+
+func parseEventId(id string) (int64, error) {
+	if id == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+func formatEventId(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// eventWaiters wakes up readers waiting for new events of a queue
+type eventWaiters struct {
+	mutex   sync.Mutex
+	waiters map[string]map[chan struct{}]bool
+}
+
+func newEventWaiters() *eventWaiters {
+	return &eventWaiters{waiters: map[string]map[chan struct{}]bool{}}
+}
+
+func (w *eventWaiters) add(queue string) (<-chan struct{}, func()) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	wake := make(chan struct{}, 1)
+	if w.waiters[queue] == nil {
+		w.waiters[queue] = map[chan struct{}]bool{}
+	}
+	w.waiters[queue][wake] = true
+
+	return wake, func() {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		delete(w.waiters[queue], wake)
+		if len(w.waiters[queue]) == 0 {
+			delete(w.waiters, queue)
+		}
+	}
+}
+
+func (w *eventWaiters) wake(queue string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for wake := range w.waiters[queue] {
+		notify(wake)
+	}
+}
+
+func (w *eventWaiters) wakeAll() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for _, queueWaiters := range w.waiters {
+		for wake := range queueWaiters {
+			notify(wake)
+		}
+	}
+}
+
+func notify(wake chan struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
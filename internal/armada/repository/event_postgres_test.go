@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository/schema"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestPostgresEventRepository_ReadEvents(t *testing.T) {
+	withPostgresEventRepository(t, func(r *PostgresEventRepository) {
+		reportEvents(t, r,
+			&api.JobSubmittedEvent{JobId: "job1", JobSetId: "set", Queue: "queue"},
+			&api.JobSubmittedEvent{JobId: "job2", JobSetId: "other", Queue: "queue"},
+			&api.JobQueuedEvent{JobId: "job1", JobSetId: "set", Queue: "queue"},
+			&api.JobSubmittedEvent{JobId: "job3", JobSetId: "set", Queue: "other-queue"})
+
+		messages, e := r.ReadEvents("queue", "set", "", 100, -1)
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(messages))
+		assert.NotNil(t, messages[0].Message.GetSubmitted())
+		assert.NotNil(t, messages[1].Message.GetQueued())
+
+		messages, e = r.ReadEvents("queue", "set", messages[0].Id, 100, -1)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(messages))
+		assert.NotNil(t, messages[0].Message.GetQueued())
+
+		lastId, e := r.GetLastMessageId("queue", "set")
+		assert.Nil(t, e)
+		assert.Equal(t, messages[0].Id, lastId)
+
+		messages, e = r.ReadQueueEvents("queue", "", 100, -1)
+		assert.Nil(t, e)
+		assert.Equal(t, 3, len(messages))
+	})
+}
+
+func TestPostgresEventRepository_GetLastMessageId_EmptyJobSet(t *testing.T) {
+	withPostgresEventRepository(t, func(r *PostgresEventRepository) {
+		lastId, e := r.GetLastMessageId("queue", "set")
+		assert.Nil(t, e)
+		assert.Equal(t, "0", lastId)
+	})
+}
+
+func TestPostgresEventRepository_ReadEvents_BlocksUntilEventIsReported(t *testing.T) {
+	withPostgresEventRepository(t, func(r *PostgresEventRepository) {
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			reportEvents(t, r, &api.JobSubmittedEvent{JobId: "job1", JobSetId: "set", Queue: "queue"})
+		}()
+
+		messages, e := r.ReadEvents("queue", "set", "", 100, 5*time.Second)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(messages))
+	})
+}
+
+func TestPostgresEventRepository_ReadEvents_ReturnsEmptyAfterBlockTimeout(t *testing.T) {
+	withPostgresEventRepository(t, func(r *PostgresEventRepository) {
+		messages, e := r.ReadEvents("queue", "set", "", 100, 100*time.Millisecond)
+		assert.Nil(t, e)
+		assert.Equal(t, 0, len(messages))
+	})
+}
+
+func TestPostgresEventRepository_GetJobSetSnapshot(t *testing.T) {
+	withPostgresEventRepository(t, func(r *PostgresEventRepository) {
+		reportEvents(t, r,
+			&api.JobSubmittedEvent{JobId: "job1", JobSetId: "set", Queue: "queue"},
+			&api.JobSubmittedEvent{JobId: "job2", JobSetId: "set", Queue: "queue"},
+			&api.JobLeasedEvent{JobId: "job1", JobSetId: "set", Queue: "queue", ClusterId: "cluster"})
+		reportEvents(t, r,
+			&api.JobRunningEvent{JobId: "job1", JobSetId: "set", Queue: "queue", ClusterId: "cluster"},
+			&api.JobUtilisationEvent{JobId: "job1", JobSetId: "set", Queue: "queue", ClusterId: "cluster"})
+
+		messages, e := r.ReadEvents("queue", "set", "", 100, -1)
+		assert.Nil(t, e)
+		assert.Equal(t, 5, len(messages))
+
+		snapshot, e := r.GetJobSetSnapshot("queue", "set")
+		assert.Nil(t, e)
+		assert.Equal(t, messages[4].Id, snapshot.LastMessageId)
+		assert.Equal(t, []*api.JobState{
+			{JobId: "job1", Status: api.JobStatus_Running, ClusterId: "cluster", LastMessageId: messages[4].Id},
+			{JobId: "job2", Status: api.JobStatus_Submitted, LastMessageId: messages[1].Id},
+		}, snapshot.Jobs)
+	})
+}
+
+func TestPostgresEventRepository_DeleteExpiredEvents(t *testing.T) {
+	withPostgresEventRepository(t, func(r *PostgresEventRepository) {
+		reportEvents(t, r, &api.JobSubmittedEvent{JobId: "job1", JobSetId: "set", Queue: "queue"})
+
+		r.eventRetention = configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: time.Hour}
+		r.DeleteExpiredEvents()
+		messages, e := r.ReadEvents("queue", "set", "", 100, -1)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(messages))
+
+		r.eventRetention = configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: -time.Hour}
+		r.DeleteExpiredEvents()
+		messages, e = r.ReadEvents("queue", "set", "", 100, -1)
+		assert.Nil(t, e)
+		assert.Equal(t, 0, len(messages))
+
+		snapshot, e := r.GetJobSetSnapshot("queue", "set")
+		assert.Nil(t, e)
+		assert.Empty(t, snapshot.Jobs)
+	})
+}
+
+func TestEventWaiters_WakesWaitersOfQueue(t *testing.T) {
+	waiters := newEventWaiters()
+	wake, stopWaiting := waiters.add("queue")
+	otherWake, stopWaitingOther := waiters.add("other")
+
+	waiters.wake("queue")
+	waiters.wake("queue")
+	assert.Equal(t, 1, len(wake))
+	assert.Equal(t, 0, len(otherWake))
+
+	waiters.wakeAll()
+	assert.Equal(t, 1, len(otherWake))
+
+	stopWaiting()
+	stopWaitingOther()
+	assert.Empty(t, waiters.waiters)
+}
+
+func reportEvents(t *testing.T, r *PostgresEventRepository, events ...api.Event) {
+	messages := []*api.EventMessage{}
+	for _, event := range events {
+		message, e := api.Wrap(event)
+		assert.Nil(t, e)
+		messages = append(messages, message)
+	}
+	e := r.ReportEvents(messages)
+	assert.Nil(t, e)
+}
+
+func withPostgresEventRepository(t *testing.T, action func(r *PostgresEventRepository)) {
+	withPostgresDatabase(t, func(db *goqu.Database) {
+		action(NewPostgresEventRepository(db, configuration.EventRetentionPolicy{}, 50*time.Millisecond))
+	})
+}
+
+func withPostgresDatabase(t *testing.T, action func(db *goqu.Database)) {
+	dbName := "test_" + util.NewULID()
+	connectionString := "host=localhost port=5432 user=postgres password=psw sslmode=disable"
+	db, err := sql.Open("postgres", connectionString)
+	defer db.Close()
+
+	assert.Nil(t, err)
+
+	_, err = db.Exec("CREATE DATABASE " + dbName)
+	assert.Nil(t, err)
+
+	testDb, err := sql.Open("postgres", connectionString+" dbname="+dbName)
+	assert.Nil(t, err)
+
+	defer func() {
+		err = testDb.Close()
+		assert.Nil(t, err)
+		// disconnect all db user before cleanup
+		_, err = db.Exec(
+			`SELECT pg_terminate_backend(pg_stat_activity.pid)
+			 FROM pg_stat_activity WHERE pg_stat_activity.datname = '` + dbName + `';`)
+		assert.Nil(t, err)
+		_, err = db.Exec("DROP DATABASE " + dbName)
+		assert.Nil(t, err)
+	}()
+
+	err = schema.UpdateDatabase(testDb)
+	assert.Nil(t, err)
+
+	action(goqu.New("postgres", testDb))
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type fakeEventStore struct {
+	reported [][]*api.EventMessage
+	err      error
+}
+
+func (s *fakeEventStore) ReportEvents(messages []*api.EventMessage) error {
+	s.reported = append(s.reported, messages)
+	return s.err
+}
+
+func TestCompositeEventStore_IgnoresSecondaryStoreFailures(t *testing.T) {
+	primary := &fakeEventStore{}
+	secondary := &fakeEventStore{err: errors.New("notifier failed")}
+	store := NewCompositeEventStore(primary, secondary)
+
+	messages := []*api.EventMessage{{}}
+	assert.NoError(t, store.ReportEvents(messages))
+	assert.Len(t, primary.reported, 1)
+	assert.Len(t, secondary.reported, 1)
+}
+
+func TestCompositeEventStore_ReturnsPrimaryStoreFailure(t *testing.T) {
+	primary := &fakeEventStore{err: errors.New("redis failed")}
+	secondary := &fakeEventStore{}
+	store := NewCompositeEventStore(primary, secondary)
+
+	assert.Error(t, store.ReportEvents([]*api.EventMessage{{}}))
+	assert.Empty(t, secondary.reported)
+}
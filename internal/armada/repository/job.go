@@ -11,20 +11,23 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/internal/common/validation"
 	"github.com/G-Research/armada/pkg/api"
 )
 
-const jobObjectPrefix = "Job:"             // {jobId}            - job protobuf object
-const jobStartTimePrefix = "Job:StartTime" // {jobId}            - map clusterId -> startTime
-const jobQueuePrefix = "Job:Queue:"        // {queue}            - sorted set of jobIds by priority
-const jobLeasedPrefix = "Job:Leased:"      // {queue}            - sorted set of jobIds by lease renewal time
-const jobSetPrefix = "Job:Set:"            // {jobSetId}         - set of jobIds
-const jobClusterMapKey = "Job:ClusterId"   //                    - map jobId -> cluster
-const jobRetriesPrefix = "Job:Retries:"    // {jobId}            - number of retry attempts
-const jobClientIdPrefix = "job:ClientId:"  // {queue}:{clientId} - corresponding jobId
+const jobObjectPrefix = "Job:"                     // {jobId}                       - job protobuf object
+const jobStartTimePrefix = "Job:StartTime"         // {jobId}                       - map clusterId -> startTime
+const jobQueuePrefix = "Job:Queue:"                // {queue}                       - sorted set of jobIds by priority
+const jobLeasedPrefix = "Job:Leased:"              // {queue}                       - sorted set of jobIds by lease renewal time
+const jobSetPrefix = "Job:Set:"                    // {jobSetId}                    - set of jobIds
+const jobClusterMapKey = "Job:ClusterId"           //                               - map jobId -> cluster
+const jobRetriesPrefix = "Job:Retries:"            // {jobId}                       - number of retry attempts
+const jobLeaseHistoryPrefix = "Job:LeaseHistory:"  // {jobId}                       - set of clusters the job was leased to
+const jobClientIdPrefix = "job:ClientId:"          // {queue}:{clientId}            - corresponding jobId
+const jobSetClientIdPrefix = "job:JobSetClientId:" // {queue}:{jobSetId}:{clientId} - corresponding jobId
 const keySeparator = ":"
 
 const queueResourcesBatchSize = 20000
@@ -50,6 +53,11 @@ type JobRepository interface {
 	UpdateStartTime(jobId string, clusterId string, startTime time.Time) error
 	UpdatePriority(jobs []*api.Job, newPriority float64) (map[string]string, error)
 	GetJobRunInfos(jobIds []string) (map[string]*RunInfo, error)
+	// GetLeasedClusterIds returns clusters the jobs are currently leased to, jobs which are not leased are omitted
+	GetLeasedClusterIds(jobIds []string) (map[string]string, error)
+	// GetLeaseHistory returns all clusters the jobs were leased to, including the current lease.
+	// History of deleted jobs is kept as long as the deleted jobs are, jobs which were never leased are omitted.
+	GetLeaseHistory(jobIds []string) (map[string][]string, error)
 	GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error)
 	AddRetryAttempt(jobId string) error
 	GetNumberOfRetryAttempts(jobId string) (int, error)
@@ -58,16 +66,26 @@ type JobRepository interface {
 type RedisJobRepository struct {
 	db               redis.UniversalClient
 	defaultJobLimits common.ComputeResources
+	deduplication    configuration.JobDeduplicationConfig
 }
 
-func NewRedisJobRepository(db redis.UniversalClient, defaultJobLimits common.ComputeResources) *RedisJobRepository {
+func NewRedisJobRepository(
+	db redis.UniversalClient,
+	defaultJobLimits common.ComputeResources,
+	deduplication configuration.JobDeduplicationConfig) *RedisJobRepository {
+
 	if defaultJobLimits == nil {
 		defaultJobLimits = common.ComputeResources{}
 	}
-	return &RedisJobRepository{db: db, defaultJobLimits: defaultJobLimits}
+	return &RedisJobRepository{db: db, defaultJobLimits: defaultJobLimits, deduplication: deduplication}
 }
 
 func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, owner string, ownershipGroups []string) ([]*api.Job, error) {
+	return createJobs(request, owner, ownershipGroups, repo.defaultJobLimits)
+}
+
+// createJobs validates the request and creates jobs with default limits applied, it is shared by all repository implementations
+func createJobs(request *api.JobSubmitRequest, owner string, ownershipGroups []string, defaultJobLimits common.ComputeResources) ([]*api.Job, error) {
 	jobs := make([]*api.Job, 0, len(request.JobRequestItems))
 
 	if request.JobSetId == "" {
@@ -98,7 +116,7 @@ func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, owner
 		}
 
 		for j, podSpec := range item.GetAllPodSpecs() {
-			repo.applyDefaults(podSpec)
+			applyDefaults(podSpec, defaultJobLimits)
 			e := validation.ValidatePodSpec(podSpec)
 			if e != nil {
 				return nil, fmt.Errorf("error validating pod spec of job with index %v, pod: %v: %v", i, j, e)
@@ -147,6 +165,7 @@ type SubmitJobResult struct {
 	Error             error
 }
 
+// AddJobs stores jobs, jobs of closed or finalized job sets are rejected with error in their result
 func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
 	pipe := repo.db.Pipeline()
 
@@ -160,7 +179,7 @@ func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, er
 			return nil, e
 		}
 
-		result := addJob(pipe, job, &jobData)
+		result := addJob(pipe, job, &jobData, repo.deduplication)
 		saveResults = append(saveResults, result)
 	}
 
@@ -232,6 +251,8 @@ func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
 		deletionResult.removeStartTimeResult = pipe.Del(jobStartTimePrefix + job.Id)
 		deletionResult.deleteJobSetIndexResult = pipe.SRem(jobSetPrefix+job.JobSetId, job.Id)
 		deletionResult.deleteJobRetriesResult = pipe.Del(jobRetriesPrefix + job.Id)
+		// lease history is kept with the deleted job, so late events of executors which ran it can be verified
+		pipe.Expire(jobLeaseHistoryPrefix+job.Id, time.Hour*24*7)
 
 		if !deletionResult.expiryAlreadySet {
 			deletionResult.setJobExpiryResult = pipe.Expire(jobObjectPrefix+job.Id, time.Hour*24*7)
@@ -383,24 +404,32 @@ func (repo *RedisJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job,
 			}
 		}
 		d, _ := cmd.Bytes()
-		job := &api.Job{}
-		e = proto.Unmarshal(d, job)
+		job, e := unmarshalJob(d)
 		if e != nil {
 			return nil, e
 		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
 
-		for _, podSpec := range job.GetAllPodSpecs() {
-			// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
-			for k, v := range job.RequiredNodeLabels {
-				if podSpec.NodeSelector == nil {
-					podSpec.NodeSelector = map[string]string{}
-				}
-				podSpec.NodeSelector[k] = v
+func unmarshalJob(data []byte) (*api.Job, error) {
+	job := &api.Job{}
+	e := proto.Unmarshal(data, job)
+	if e != nil {
+		return nil, e
+	}
+
+	for _, podSpec := range job.GetAllPodSpecs() {
+		// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
+		for k, v := range job.RequiredNodeLabels {
+			if podSpec.NodeSelector == nil {
+				podSpec.NodeSelector = map[string]string{}
 			}
+			podSpec.NodeSelector[k] = v
 		}
-		jobs = append(jobs, job)
 	}
-	return jobs, nil
+	return job, nil
 }
 
 func (repo *RedisJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
@@ -443,6 +472,11 @@ func (repo *RedisJobRepository) GetQueueSizes(queues []*api.Queue) (sizes []int6
 }
 
 func (repo *RedisJobRepository) IterateQueueJobs(queueName string, action func(*api.Job)) error {
+	return iterateQueueJobs(repo, queueName, action)
+}
+
+// iterateQueueJobs loads queued jobs in batches, so the whole queue is never held in memory
+func iterateQueueJobs(repo JobRepository, queueName string, action func(*api.Job)) error {
 	queuedIds, e := repo.GetQueueJobIds(queueName)
 	if e != nil {
 		return e
@@ -470,6 +504,30 @@ func (repo *RedisJobRepository) GetLeasedJobIds(queue string) ([]string, error)
 	return repo.db.ZRange(jobLeasedPrefix+queue, 0, -1).Result()
 }
 
+func (repo *RedisJobRepository) GetLeasedClusterIds(jobIds []string) (map[string]string, error) {
+	return repo.getAssociatedCluster(jobIds)
+}
+
+func (repo *RedisJobRepository) GetLeaseHistory(jobIds []string) (map[string][]string, error) {
+	pipe := repo.db.Pipeline()
+	cmds := make(map[string]*redis.StringSliceCmd, len(jobIds))
+	for _, jobId := range jobIds {
+		cmds[jobId] = pipe.SMembers(jobLeaseHistoryPrefix + jobId)
+	}
+	_, e := pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+
+	history := make(map[string][]string, len(jobIds))
+	for jobId, cmd := range cmds {
+		if clusterIds := cmd.Val(); len(clusterIds) > 0 {
+			history[jobId] = clusterIds
+		}
+	}
+	return history, nil
+}
+
 func (repo *RedisJobRepository) getAssociatedCluster(jobIds []string) (map[string]string, error) {
 	associatedCluster := make(map[string]string, len(jobIds))
 	pipe := repo.db.Pipeline()
@@ -838,7 +896,7 @@ func (repo *RedisJobRepository) leaseJobs(clusterId string, jobs []*api.Job) ([]
 	return leasedJobs, nil
 }
 
-func (repo *RedisJobRepository) applyDefaults(spec *v1.PodSpec) {
+func applyDefaults(spec *v1.PodSpec, defaultJobLimits common.ComputeResources) {
 	if spec != nil {
 		for i := range spec.Containers {
 			c := &spec.Containers[i]
@@ -848,7 +906,7 @@ func (repo *RedisJobRepository) applyDefaults(spec *v1.PodSpec) {
 			if c.Resources.Requests == nil {
 				c.Resources.Requests = map[v1.ResourceName]resource.Quantity{}
 			}
-			for k, v := range repo.defaultJobLimits {
+			for k, v := range defaultJobLimits {
 				_, limitExists := c.Resources.Limits[v1.ResourceName(k)]
 				_, requestExists := c.Resources.Limits[v1.ResourceName(k)]
 				if !limitExists && !requestExists {
@@ -860,10 +918,22 @@ func (repo *RedisJobRepository) applyDefaults(spec *v1.PodSpec) {
 	}
 }
 
-func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte) *redis.Cmd {
+func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte, deduplication configuration.JobDeduplicationConfig) *redis.Cmd {
+	clientIdKey := jobClientIdPrefix + job.Queue + keySeparator + job.ClientId
+	if deduplication.Scope == configuration.DeduplicationScopeJobSet {
+		clientIdKey = jobSetClientIdPrefix + job.Queue + keySeparator + job.JobSetId + keySeparator + job.ClientId
+	}
+	// empty client id disables deduplication in the script
+	clientId := job.ClientId
+	window := int64(deduplication.Window / time.Millisecond)
+	if window <= 0 {
+		clientId = ""
+	}
+	// state of the job set is checked by the script, so jobs cannot be added to job set which is being closed and finalized
 	return addJobScript.Run(db,
-		[]string{jobQueuePrefix + job.Queue, jobObjectPrefix + job.Id, jobSetPrefix + job.JobSetId, jobClientIdPrefix + job.Queue + keySeparator + job.ClientId},
-		job.Id, job.Priority, *jobData, job.ClientId)
+		[]string{jobQueuePrefix + job.Queue, jobObjectPrefix + job.Id, jobSetPrefix + job.JobSetId, clientIdKey,
+			jobSetClosedPrefix + job.Queue, jobSetFinalizedPrefix + job.Queue},
+		job.Id, job.Priority, *jobData, clientId, window, job.JobSetId)
 }
 
 var addJobScript = redis.NewScript(`
@@ -871,18 +941,26 @@ local queueKey = KEYS[1]
 local jobKey = KEYS[2]
 local jobSetKey = KEYS[3]
 local jobClientIdKey = KEYS[4]
+local jobSetClosedKey = KEYS[5]
+local jobSetFinalizedKey = KEYS[6]
 
 local jobId = ARGV[1]
 local jobPriority = ARGV[2]
 local jobData = ARGV[3]
 local clientId = ARGV[4]
+local deduplicationWindow = ARGV[5]
+local jobSetId = ARGV[6]
+
+if redis.call('HEXISTS', jobSetClosedKey, jobSetId) == 1 or redis.call('HEXISTS', jobSetFinalizedKey, jobSetId) == 1 then
+	return redis.error_reply('Job set ' .. jobSetId .. ' is closed')
+end
 
 if clientId ~= '' then
 	local existingJobId = redis.call('GET', jobClientIdKey)
 	if existingJobId then 
 		return existingJobId
 	end
-	redis.call('SET', jobClientIdKey, jobId, 'EX', 14400)
+	redis.call('SET', jobClientIdKey, jobId, 'PX', deduplicationWindow)
 end
 
 redis.call('SET', jobKey, jobData)
@@ -893,7 +971,7 @@ return jobId
 `)
 
 func leaseJob(db redis.Cmdable, queueName string, clusterId string, jobId string, now time.Time) *redis.Cmd {
-	return leaseJobScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey},
+	return leaseJobScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey, jobLeaseHistoryPrefix + jobId},
 		clusterId, jobId, float64(now.UnixNano()))
 }
 
@@ -904,6 +982,7 @@ var leaseJobScript = redis.NewScript(`
 local queue = KEYS[1]
 local leasedJobsSet = KEYS[2]
 local clusterAssociation = KEYS[3]
+local leaseHistory = KEYS[4]
 
 local clusterId = ARGV[1]
 local jobId = ARGV[2]
@@ -913,6 +992,7 @@ local exists = redis.call('ZREM', queue, jobId)
 
 if exists == 1 then 
 	redis.call('HSET', clusterAssociation, jobId, clusterId)
+	redis.call('SADD', leaseHistory, clusterId)
 	return redis.call('ZADD', leasedJobsSet, currentTime, jobId)
 else
 	local currentClusterId = redis.call('HGET', clusterAssociation, jobId)
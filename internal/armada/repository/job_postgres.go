@@ -0,0 +1,748 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	jobStateDeleted = 0
+	jobStateQueued  = 1
+	jobStateLeased  = 2
+)
+
+const deletedJobRetention = 7 * 24 * time.Hour // same as expiry of deleted jobs in redis
+
+var (
+	jobTable             = goqu.T("job")
+	jobClientIdTable     = goqu.T("job_client_id")
+	jobStartTimeTable    = goqu.T("job_start_time")
+	jobRetryTable        = goqu.T("job_retry")
+	jobLeaseHistoryTable = goqu.T("job_lease_history")
+
+	job_jobId    = goqu.I("job.job_id")
+	job_queue    = goqu.I("job.queue")
+	job_jobSet   = goqu.I("job.jobset")
+	job_priority = goqu.I("job.priority")
+	job_state    = goqu.I("job.state")
+	job_cluster  = goqu.I("job.cluster")
+	job_leased   = goqu.I("job.leased")
+	job_deleted  = goqu.I("job.deleted")
+	job_message  = goqu.I("job.message")
+
+	jobClientId_jobId   = goqu.I("job_client_id.job_id")
+	jobClientId_expires = goqu.I("job_client_id.expires")
+
+	jobStartTime_jobId   = goqu.I("job_start_time.job_id")
+	jobStartTime_cluster = goqu.I("job_start_time.cluster")
+	jobStartTime_started = goqu.I("job_start_time.started")
+
+	jobRetry_jobId    = goqu.I("job_retry.job_id")
+	jobRetry_attempts = goqu.I("job_retry.attempts")
+
+	jobLeaseHistory_jobId   = goqu.I("job_lease_history.job_id")
+	jobLeaseHistory_cluster = goqu.I("job_lease_history.cluster")
+)
+
+type jobRow struct {
+	JobId   string `db:"job_id"`
+	Message []byte `db:"message"`
+}
+
+type jobRunInfoRow struct {
+	JobId   string `db:"job_id"`
+	Cluster string `db:"cluster"`
+	Started int64  `db:"started"`
+}
+
+type jobSetInfoRow struct {
+	JobSet     string `db:"jobset"`
+	QueuedJobs int32  `db:"queued_jobs"`
+	LeasedJobs int32  `db:"leased_jobs"`
+}
+
+type queueSizeRow struct {
+	Queue string `db:"queue"`
+	Size  int64  `db:"size"`
+}
+
+// PostgresJobRepository keeps the job queues in Postgres, every state change of a job is done by a single statement
+// or transaction, so lease, renewal, expiry and return of leases have the same semantics as RedisJobRepository.
+// Deleted jobs are kept for a week like in Redis, DeleteExpiredJobs removes them.
+type PostgresJobRepository struct {
+	db               *goqu.Database
+	defaultJobLimits common.ComputeResources
+	deduplication    configuration.JobDeduplicationConfig
+}
+
+func NewPostgresJobRepository(
+	db *goqu.Database,
+	defaultJobLimits common.ComputeResources,
+	deduplication configuration.JobDeduplicationConfig) *PostgresJobRepository {
+
+	if defaultJobLimits == nil {
+		defaultJobLimits = common.ComputeResources{}
+	}
+	return &PostgresJobRepository{db: db, defaultJobLimits: defaultJobLimits, deduplication: deduplication}
+}
+
+func (repo *PostgresJobRepository) CreateJobs(request *api.JobSubmitRequest, owner string, ownershipGroups []string) ([]*api.Job, error) {
+	return createJobs(request, owner, ownershipGroups, repo.defaultJobLimits)
+}
+
+// AddJobs stores jobs. State of job sets is kept in Redis, so it cannot be checked in the same transaction,
+// job sets which receive jobs while they are being finalized are moved back to closed by the finalizer.
+func (repo *PostgresJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
+	result := make([]*SubmitJobResult, 0, len(jobs))
+	now := time.Now().UTC()
+
+	e := repo.db.WithTx(func(tx *goqu.TxDatabase) error {
+		records := make([]interface{}, 0, len(jobs))
+		for _, job := range jobs {
+			jobId := job.Id
+			if job.ClientId != "" && repo.deduplication.Window > 0 {
+				var e error
+				jobId, e = registerClientId(tx, job, repo.deduplication, now)
+				if e != nil {
+					return e
+				}
+			}
+			result = append(result, &SubmitJobResult{
+				JobId:             jobId,
+				SubmittedJob:      job,
+				DuplicateDetected: jobId != job.Id,
+			})
+			if jobId != job.Id {
+				continue
+			}
+
+			jobData, e := proto.Marshal(job)
+			if e != nil {
+				return e
+			}
+			records = append(records, goqu.Record{
+				"job_id":   job.Id,
+				"queue":    job.Queue,
+				"jobset":   job.JobSetId,
+				"priority": job.Priority,
+				"state":    jobStateQueued,
+				"message":  jobData,
+			})
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		_, e := tx.Insert(jobTable).Rows(records...).Prepared(true).Executor().Exec()
+		return e
+	})
+	if e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+// registerClientId returns id of the job submitted recently with the same client id to the queue (or job set),
+// or id of the provided job if there is no such job
+func registerClientId(tx *goqu.TxDatabase, job *api.Job, deduplication configuration.JobDeduplicationConfig, now time.Time) (string, error) {
+	jobSetId := ""
+	if deduplication.Scope == configuration.DeduplicationScopeJobSet {
+		jobSetId = job.JobSetId
+	}
+
+	var jobId string
+	_, e := tx.Insert(jobClientIdTable).
+		Rows(goqu.Record{
+			"queue":     job.Queue,
+			"jobset":    jobSetId,
+			"client_id": job.ClientId,
+			"job_id":    job.Id,
+			"expires":   now.Add(deduplication.Window),
+		}).
+		OnConflict(goqu.DoUpdate("queue, jobset, client_id", goqu.Record{
+			"job_id":  goqu.L("CASE WHEN job_client_id.expires < ? THEN EXCLUDED.job_id ELSE job_client_id.job_id END", now),
+			"expires": goqu.L("CASE WHEN job_client_id.expires < ? THEN EXCLUDED.expires ELSE job_client_id.expires END", now),
+		})).
+		Returning(jobClientId_jobId).
+		Prepared(true).
+		Executor().
+		ScanVal(&jobId)
+	return jobId, e
+}
+
+func (repo *PostgresJobRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
+	rows := []*jobRow{}
+	e := repo.db.From(jobTable).
+		Select(job_jobId, job_message).
+		Where(job_queue.Eq(queue), job_state.Eq(jobStateQueued)).
+		Order(job_priority.Asc(), job_jobId.Asc()).
+		Limit(uint(limit)).
+		Prepared(true).
+		ScanStructs(&rows)
+	if e != nil {
+		return nil, e
+	}
+	return unmarshalJobRows(rows)
+}
+
+// returns list of jobs which are successfully leased
+func (repo *PostgresJobRepository) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
+	leasedIds, e := repo.leaseJobs(clusterId, getJobIds(jobs))
+	if e != nil {
+		return nil, e
+	}
+
+	leased := make(map[string]bool, len(leasedIds))
+	for _, id := range leasedIds {
+		leased[id] = true
+	}
+	leasedJobs := make([]*api.Job, 0)
+	for _, job := range jobs {
+		if leased[job.Id] {
+			leasedJobs = append(leasedJobs, job)
+		}
+	}
+	return leasedJobs, nil
+}
+
+func (repo *PostgresJobRepository) RenewLease(clusterId string, jobIds []string) ([]string, error) {
+	return repo.leaseJobs(clusterId, jobIds)
+}
+
+// leaseJobs leases queued jobs to the cluster and renews leases the cluster already holds,
+// jobs leased by a different cluster and deleted jobs are left untouched
+func (repo *PostgresJobRepository) leaseJobs(clusterId string, jobIds []string) ([]string, error) {
+	leasedIds := make([]string, 0)
+	if len(jobIds) == 0 {
+		return leasedIds, nil
+	}
+
+	e := repo.db.WithTx(func(tx *goqu.TxDatabase) error {
+		e := tx.Update(jobTable).
+			Set(goqu.Record{
+				"state":   jobStateLeased,
+				"cluster": clusterId,
+				"leased":  time.Now().UTC(),
+			}).
+			Where(
+				job_jobId.In(jobIds),
+				goqu.Or(
+					job_state.Eq(jobStateQueued),
+					goqu.And(job_state.Eq(jobStateLeased), job_cluster.Eq(clusterId)))).
+			Returning(job_jobId).
+			Prepared(true).
+			Executor().
+			ScanVals(&leasedIds)
+		if e != nil || len(leasedIds) == 0 {
+			return e
+		}
+
+		history := make([]interface{}, 0, len(leasedIds))
+		for _, jobId := range leasedIds {
+			history = append(history, goqu.Record{"job_id": jobId, "cluster": clusterId})
+		}
+		_, e = tx.Insert(jobLeaseHistoryTable).
+			Rows(history...).
+			OnConflict(goqu.DoNothing()).
+			Prepared(true).
+			Executor().
+			Exec()
+		return e
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	if len(leasedIds) < len(jobIds) {
+		log.WithField("clusterId", clusterId).
+			Infof("%d jobs were not leased, they are already allocated to different cluster or cancelled", len(jobIds)-len(leasedIds))
+	}
+	return leasedIds, nil
+}
+
+func (repo *PostgresJobRepository) ExpireLeases(queue string, deadline time.Time) ([]*api.Job, error) {
+	rows := []*jobRow{}
+	e := repo.db.Update(jobTable).
+		Set(goqu.Record{
+			"state":   jobStateQueued,
+			"cluster": nil,
+			"leased":  nil,
+		}).
+		Where(job_queue.Eq(queue), job_state.Eq(jobStateLeased), job_leased.Lt(deadline.UTC())).
+		Returning(job_jobId, job_message).
+		Prepared(true).
+		Executor().
+		ScanStructs(&rows)
+	if e != nil {
+		return nil, e
+	}
+
+	expired, e := unmarshalJobRows(rows)
+	if e != nil {
+		return nil, e
+	}
+	if expired == nil {
+		expired = make([]*api.Job, 0)
+	}
+	return expired, nil
+}
+
+func (repo *PostgresJobRepository) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	rows := []*jobRow{}
+	e := repo.db.Update(jobTable).
+		Set(goqu.Record{
+			"state":   jobStateQueued,
+			"cluster": nil,
+			"leased":  nil,
+		}).
+		Where(job_jobId.Eq(jobId), job_state.Eq(jobStateLeased), job_cluster.Eq(clusterId)).
+		Returning(job_jobId, job_message).
+		Prepared(true).
+		Executor().
+		ScanStructs(&rows)
+	if e != nil {
+		return nil, e
+	}
+	if len(rows) > 0 {
+		return unmarshalJob(rows[0].Message)
+	}
+
+	jobs, e := repo.GetExistingJobsByIds([]string{jobId})
+	if e != nil {
+		return nil, e
+	}
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("Job not found %s", jobId)
+	}
+	return nil, nil
+}
+
+func (repo *PostgresJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
+	cancelledJobs := map[*api.Job]error{}
+	if len(jobs) == 0 {
+		return cancelledJobs
+	}
+
+	jobIds := getJobIds(jobs)
+	deletedJobIds := []string{}
+	deletedStartTimeIds := []string{}
+	deletedRetryIds := []string{}
+
+	e := repo.db.WithTx(func(tx *goqu.TxDatabase) error {
+		e := tx.Update(jobTable).
+			Set(goqu.Record{
+				"state":   jobStateDeleted,
+				"cluster": nil,
+				"leased":  nil,
+				"deleted": time.Now().UTC(),
+			}).
+			Where(job_jobId.In(jobIds), job_deleted.IsNull()).
+			Returning(job_jobId).
+			Prepared(true).
+			Executor().
+			ScanVals(&deletedJobIds)
+		if e != nil {
+			return e
+		}
+		e = tx.Delete(jobStartTimeTable).
+			Where(jobStartTime_jobId.In(jobIds)).
+			Returning(jobStartTime_jobId).
+			Prepared(true).
+			Executor().
+			ScanVals(&deletedStartTimeIds)
+		if e != nil {
+			return e
+		}
+		return tx.Delete(jobRetryTable).
+			Where(jobRetry_jobId.In(jobIds)).
+			Returning(jobRetry_jobId).
+			Prepared(true).
+			Executor().
+			ScanVals(&deletedRetryIds)
+	})
+
+	if e != nil {
+		for _, job := range jobs {
+			cancelledJobs[job] = e
+		}
+		return cancelledJobs
+	}
+
+	modified := util.StringListToSet(append(append(deletedJobIds, deletedStartTimeIds...), deletedRetryIds...))
+	for _, job := range jobs {
+		if modified[job.Id] {
+			cancelledJobs[job] = nil
+		}
+	}
+	return cancelledJobs
+}
+
+// Returns existing jobs by Id
+// If an Id is supplied that no longer exists, that job will simply be omitted from the result.
+// No error will be thrown for missing jobs
+func (repo *PostgresJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows := []*jobRow{}
+	e := repo.db.From(jobTable).
+		Select(job_jobId, job_message).
+		Where(job_jobId.In(ids)).
+		Prepared(true).
+		ScanStructs(&rows)
+	if e != nil {
+		return nil, e
+	}
+
+	messages := make(map[string][]byte, len(rows))
+	for _, row := range rows {
+		messages[row.JobId] = row.Message
+	}
+
+	var jobs []*api.Job
+	for _, id := range ids {
+		message, exists := messages[id]
+		if !exists {
+			log.Warnf("No job found with with job id %s", id)
+			continue
+		}
+		job, e := unmarshalJob(message)
+		if e != nil {
+			return nil, e
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (repo *PostgresJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
+	sizes, e := repo.getQueueSizes(queues)
+	if e != nil {
+		return nil, e
+	}
+
+	var active []*api.Queue
+	for _, queue := range queues {
+		if sizes[queue.Name] > 0 {
+			active = append(active, queue)
+		}
+	}
+	return active, nil
+}
+
+func (repo *PostgresJobRepository) GetQueueSizes(queues []*api.Queue) ([]int64, error) {
+	sizes, e := repo.getQueueSizes(queues)
+	if e != nil {
+		return nil, e
+	}
+
+	result := []int64{}
+	for _, queue := range queues {
+		result = append(result, sizes[queue.Name])
+	}
+	return result, nil
+}
+
+func (repo *PostgresJobRepository) getQueueSizes(queues []*api.Queue) (map[string]int64, error) {
+	sizes := map[string]int64{}
+	if len(queues) == 0 {
+		return sizes, nil
+	}
+
+	names := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		names = append(names, queue.Name)
+	}
+
+	rows := []*queueSizeRow{}
+	e := repo.db.From(jobTable).
+		Select(job_queue, goqu.COUNT("*").As("size")).
+		Where(job_queue.In(names), job_state.Eq(jobStateQueued)).
+		GroupBy(job_queue).
+		Prepared(true).
+		ScanStructs(&rows)
+	if e != nil {
+		return nil, e
+	}
+
+	for _, row := range rows {
+		sizes[row.Queue] = row.Size
+	}
+	return sizes, nil
+}
+
+func (repo *PostgresJobRepository) IterateQueueJobs(queueName string, action func(*api.Job)) error {
+	return iterateQueueJobs(repo, queueName, action)
+}
+
+func (repo *PostgresJobRepository) GetQueueJobIds(queueName string) ([]string, error) {
+	ids := []string{}
+	e := repo.db.From(jobTable).
+		Select(job_jobId).
+		Where(job_queue.Eq(queueName), job_state.Eq(jobStateQueued)).
+		Order(job_priority.Asc(), job_jobId.Asc()).
+		Prepared(true).
+		ScanVals(&ids)
+	return ids, e
+}
+
+func (repo *PostgresJobRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
+	ids := []string{}
+	e := repo.db.From(jobTable).
+		Select(job_jobId).
+		Where(job_queue.Eq(queue), job_jobSet.Eq(jobSetId), job_state.In(jobStateQueued, jobStateLeased)).
+		Prepared(true).
+		ScanVals(&ids)
+	return ids, e
+}
+
+func (repo *PostgresJobRepository) GetLeasedJobIds(queue string) ([]string, error) {
+	ids := []string{}
+	e := repo.db.From(jobTable).
+		Select(job_jobId).
+		Where(job_queue.Eq(queue), job_state.Eq(jobStateLeased)).
+		Order(job_leased.Asc()).
+		Prepared(true).
+		ScanVals(&ids)
+	return ids, e
+}
+
+// UpdateStartTime keeps the earliest start time of the job for each cluster, see RedisJobRepository.UpdateStartTime
+func (repo *PostgresJobRepository) UpdateStartTime(jobId string, clusterId string, startTime time.Time) error {
+	var existingJobId string
+	found, e := repo.db.From(jobTable).
+		Select(job_jobId).
+		Where(job_jobId.Eq(jobId)).
+		Prepared(true).
+		ScanVal(&existingJobId)
+	if e != nil {
+		return e
+	}
+	if !found {
+		return fmt.Errorf(JobNotFound)
+	}
+
+	_, e = repo.db.Insert(jobStartTimeTable).
+		Rows(goqu.Record{
+			"job_id":  jobId,
+			"cluster": clusterId,
+			"started": startTime.UnixNano(),
+		}).
+		OnConflict(goqu.DoUpdate("job_id, cluster", goqu.Record{
+			"started": goqu.L("LEAST(job_start_time.started, EXCLUDED.started)"),
+		})).
+		Prepared(true).
+		Executor().
+		Exec()
+	return e
+}
+
+// Updates priority if job exists, does not error if job doesn't exist
+func (repo *PostgresJobRepository) UpdatePriority(jobs []*api.Job, newPriority float64) (map[string]string, error) {
+	jobDatas := make([][]byte, len(jobs))
+	for i, job := range jobs {
+		job.Priority = newPriority
+		jobData, err := proto.Marshal(job)
+		jobDatas[i] = jobData
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := repo.db.WithTx(func(tx *goqu.TxDatabase) error {
+		for i, job := range jobs {
+			// deleted jobs are finished and updating their priority is irrelevant
+			_, e := tx.Update(jobTable).
+				Set(goqu.Record{
+					"priority": newPriority,
+					"message":  jobDatas[i],
+				}).
+				Where(job_jobId.Eq(job.Id), job_deleted.IsNull()).
+				Prepared(true).
+				Executor().
+				Exec()
+			if e != nil {
+				return e
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, job := range jobs {
+		result[job.Id] = ""
+	}
+	return result, nil
+}
+
+/*
+Returns the run info of each job id for the cluster they are currently associated with (leased by)
+Jobs with no value will be omitted from the results, see RedisJobRepository.GetJobRunInfos
+*/
+func (repo *PostgresJobRepository) GetJobRunInfos(jobIds []string) (map[string]*RunInfo, error) {
+	runInfos := make(map[string]*RunInfo, len(jobIds))
+	if len(jobIds) == 0 {
+		return runInfos, nil
+	}
+
+	rows := []*jobRunInfoRow{}
+	e := repo.db.From(jobTable).
+		Join(jobStartTimeTable, goqu.On(jobStartTime_jobId.Eq(job_jobId), jobStartTime_cluster.Eq(job_cluster))).
+		Select(job_jobId, job_cluster, jobStartTime_started).
+		Where(job_jobId.In(jobIds)).
+		Prepared(true).
+		ScanStructs(&rows)
+	if e != nil {
+		return runInfos, e
+	}
+
+	for _, row := range rows {
+		runInfos[row.JobId] = &RunInfo{
+			StartTime:        time.Unix(0, row.Started),
+			CurrentClusterId: row.Cluster,
+		}
+	}
+	return runInfos, nil
+}
+
+func (repo *PostgresJobRepository) GetLeasedClusterIds(jobIds []string) (map[string]string, error) {
+	leasedClusters := make(map[string]string, len(jobIds))
+	if len(jobIds) == 0 {
+		return leasedClusters, nil
+	}
+
+	rows := []*jobRunInfoRow{}
+	e := repo.db.From(jobTable).
+		Select(job_jobId, job_cluster).
+		Where(job_jobId.In(jobIds), job_state.Eq(jobStateLeased)).
+		Prepared(true).
+		ScanStructs(&rows)
+	if e != nil {
+		return leasedClusters, e
+	}
+
+	for _, row := range rows {
+		leasedClusters[row.JobId] = row.Cluster
+	}
+	return leasedClusters, nil
+}
+
+func (repo *PostgresJobRepository) GetLeaseHistory(jobIds []string) (map[string][]string, error) {
+	history := make(map[string][]string, len(jobIds))
+	if len(jobIds) == 0 {
+		return history, nil
+	}
+
+	rows := []*jobRunInfoRow{}
+	e := repo.db.From(jobLeaseHistoryTable).
+		Select(jobLeaseHistory_jobId, jobLeaseHistory_cluster).
+		Where(jobLeaseHistory_jobId.In(jobIds)).
+		Prepared(true).
+		ScanStructs(&rows)
+	if e != nil {
+		return nil, e
+	}
+
+	for _, row := range rows {
+		history[row.JobId] = append(history[row.JobId], row.Cluster)
+	}
+	return history, nil
+}
+
+func (repo *PostgresJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
+	rows := []*jobSetInfoRow{}
+	e := repo.db.From(jobTable).
+		Select(
+			job_jobSet,
+			goqu.L("COUNT(*) FILTER (WHERE job.state = ?)", jobStateQueued).As("queued_jobs"),
+			goqu.L("COUNT(*) FILTER (WHERE job.state = ?)", jobStateLeased).As("leased_jobs")).
+		Where(job_queue.Eq(queue), job_state.In(jobStateQueued, jobStateLeased)).
+		GroupBy(job_jobSet).
+		Prepared(true).
+		ScanStructs(&rows)
+	if e != nil {
+		return nil, e
+	}
+
+	result := []*api.JobSetInfo{}
+	for _, row := range rows {
+		result = append(result, &api.JobSetInfo{Name: row.JobSet, QueuedJobs: row.QueuedJobs, LeasedJobs: row.LeasedJobs})
+	}
+	return result, nil
+}
+
+func (repo *PostgresJobRepository) AddRetryAttempt(jobId string) error {
+	_, e := repo.db.Insert(jobRetryTable).
+		Rows(goqu.Record{"job_id": jobId, "attempts": 1}).
+		OnConflict(goqu.DoUpdate("job_id", goqu.Record{
+			"attempts": goqu.L("job_retry.attempts + 1"),
+		})).
+		Prepared(true).
+		Executor().
+		Exec()
+	return e
+}
+
+func (repo *PostgresJobRepository) GetNumberOfRetryAttempts(jobId string) (int, error) {
+	var retries int
+	_, e := repo.db.From(jobRetryTable).
+		Select(jobRetry_attempts).
+		Where(jobRetry_jobId.Eq(jobId)).
+		Prepared(true).
+		ScanVal(&retries)
+	if e != nil {
+		return 0, e
+	}
+	return retries, nil
+}
+
+// DeleteExpiredJobs deletes jobs deleted more than a week ago and expired client ids used for deduplication
+func (repo *PostgresJobRepository) DeleteExpiredJobs() {
+	now := time.Now().UTC()
+
+	result, e := repo.db.Delete(jobTable).Where(job_deleted.Lt(now.Add(-deletedJobRetention))).Prepared(true).Executor().Exec()
+	if e != nil {
+		log.Errorf("Error while deleting expired jobs: %v", e)
+		return
+	}
+	deleted, _ := result.RowsAffected()
+
+	_, e = repo.db.Delete(jobClientIdTable).Where(jobClientId_expires.Lt(now)).Prepared(true).Executor().Exec()
+	if e != nil {
+		log.Errorf("Error while deleting expired job client ids: %v", e)
+		return
+	}
+	log.Infof("Deleted %d expired jobs", deleted)
+}
+
+func unmarshalJobRows(rows []*jobRow) ([]*api.Job, error) {
+	var jobs []*api.Job
+	for _, row := range rows {
+		job, e := unmarshalJob(row.Message)
+		if e != nil {
+			return nil, e
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func getJobIds(jobs []*api.Job) []string {
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestPostgresJobRepository_DeleteExpiredJobs(t *testing.T) {
+	withPostgresJobRepository(t, func(r *PostgresJobRepository) {
+		deletedJob := addTestJob(t, r, "queue1")
+		recentlyDeletedJob := addTestJob(t, r, "queue1")
+		queuedJob := addTestJob(t, r, "queue1")
+
+		r.DeleteJobs([]*api.Job{deletedJob, recentlyDeletedJob})
+		_, e := r.db.Update(jobTable).
+			Set(goqu.Record{"deleted": time.Now().UTC().Add(-deletedJobRetention - time.Hour)}).
+			Where(job_jobId.Eq(deletedJob.Id)).
+			Executor().Exec()
+		assert.Nil(t, e)
+
+		r.DeleteExpiredJobs()
+
+		jobs, e := r.GetExistingJobsByIds([]string{deletedJob.Id, recentlyDeletedJob.Id, queuedJob.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(jobs))
+		assert.Equal(t, recentlyDeletedJob.Id, jobs[0].Id)
+		assert.Equal(t, queuedJob.Id, jobs[1].Id)
+	})
+}
+
+func TestPostgresJobRepository_ClientIdCanBeReusedAfterExpiry(t *testing.T) {
+	withPostgresJobRepository(t, func(r *PostgresJobRepository) {
+		job1 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
+
+		_, e := r.db.Update(jobClientIdTable).
+			Set(goqu.Record{"expires": time.Now().UTC().Add(-time.Minute)}).
+			Executor().Exec()
+		assert.Nil(t, e)
+
+		job2 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
+		assert.NotEqual(t, job1.Id, job2.Id)
+
+		job3 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
+		assert.Equal(t, job2.Id, job3.Id)
+	})
+}
+
+func withPostgresJobRepository(t *testing.T, action func(r *PostgresJobRepository)) {
+	withPostgresDatabase(t, func(db *goqu.Database) {
+		action(NewPostgresJobRepository(db, nil, testDeduplication))
+	})
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const jobSetClosedPrefix = "JobSet:Closed:"       // {queue}  - map jobSetId -> time of closing, for job sets waiting for finalization
+const jobSetFinalizedPrefix = "JobSet:Finalized:" // {queue}  - map jobSetId -> time of finalization
+
+type JobSetState int
+
+const (
+	JobSetOpen JobSetState = iota
+	JobSetClosed
+	JobSetFinalized
+)
+
+type JobSetRepository interface {
+	// CloseJobSet returns false if the job set was already closed
+	CloseJobSet(queue string, jobSetId string) (bool, error)
+	GetJobSetState(queue string, jobSetId string) (JobSetState, error)
+	// GetClosedJobSets returns ids of closed job sets of the queue which are not finalized yet
+	GetClosedJobSets(queue string) ([]string, error)
+	// FinalizeJobSet marks closed job set as finalized, it returns false if the job set is not closed or already finalized
+	FinalizeJobSet(queue string, jobSetId string) (bool, error)
+	// RevertJobSetFinalization moves finalized job set back to closed, so finalization can be attempted again
+	RevertJobSetFinalization(queue string, jobSetId string) error
+}
+
+type RedisJobSetRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisJobSetRepository(db redis.UniversalClient) *RedisJobSetRepository {
+	return &RedisJobSetRepository{db: db}
+}
+
+func (repo *RedisJobSetRepository) CloseJobSet(queue string, jobSetId string) (bool, error) {
+	state, e := repo.GetJobSetState(queue, jobSetId)
+	if e != nil || state != JobSetOpen {
+		return false, e
+	}
+	return repo.db.HSetNX(jobSetClosedPrefix+queue, jobSetId, time.Now().UnixNano()).Result()
+}
+
+func (repo *RedisJobSetRepository) GetJobSetState(queue string, jobSetId string) (JobSetState, error) {
+	pipe := repo.db.Pipeline()
+	closedCmd := pipe.HExists(jobSetClosedPrefix+queue, jobSetId)
+	finalizedCmd := pipe.HExists(jobSetFinalizedPrefix+queue, jobSetId)
+	_, e := pipe.Exec()
+	if e != nil {
+		return JobSetOpen, e
+	}
+
+	if finalizedCmd.Val() {
+		return JobSetFinalized, nil
+	}
+	if closedCmd.Val() {
+		return JobSetClosed, nil
+	}
+	return JobSetOpen, nil
+}
+
+func (repo *RedisJobSetRepository) GetClosedJobSets(queue string) ([]string, error) {
+	return repo.db.HKeys(jobSetClosedPrefix + queue).Result()
+}
+
+func (repo *RedisJobSetRepository) FinalizeJobSet(queue string, jobSetId string) (bool, error) {
+	result, e := finalizeJobSetScript.Run(repo.db,
+		[]string{jobSetClosedPrefix + queue, jobSetFinalizedPrefix + queue},
+		jobSetId, time.Now().UnixNano()).Int()
+	if e != nil {
+		return false, e
+	}
+	return result == 1, nil
+}
+
+var finalizeJobSetScript = redis.NewScript(`
+local closed = KEYS[1]
+local finalized = KEYS[2]
+local jobSetId = ARGV[1]
+local finalizedTime = ARGV[2]
+
+if redis.call('HDEL', closed, jobSetId) == 1 then
+	redis.call('HSET', finalized, jobSetId, finalizedTime)
+	return 1
+end
+return 0
+`)
+
+func (repo *RedisJobSetRepository) RevertJobSetFinalization(queue string, jobSetId string) error {
+	pipe := repo.db.TxPipeline()
+	pipe.HDel(jobSetFinalizedPrefix+queue, jobSetId)
+	pipe.HSet(jobSetClosedPrefix+queue, jobSetId, time.Now().UnixNano())
+	_, e := pipe.Exec()
+	return e
+}
@@ -5,18 +5,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/go-redis/redis"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 func TestJobDoubleSubmit(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job1 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
 		job2 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
 		assert.Equal(t, job1.Id, job2.Id)
@@ -24,15 +26,44 @@ func TestJobDoubleSubmit(t *testing.T) {
 }
 
 func TestJobAddDifferentQueuesCanHaveSameClientId(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job1 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
 		job2 := addTestJobWithClientId(t, r, "queue2", "my-job-1")
 		assert.NotEqual(t, job1.Id, job2.Id)
 	})
 }
 
+func TestJobDoubleSubmit_ScopedByJobSet(t *testing.T) {
+	deduplication := configuration.JobDeduplicationConfig{Scope: configuration.DeduplicationScopeJobSet, Window: time.Hour}
+	withRepositoryUsingConfig(t, nil, deduplication, func(r JobRepository) {
+		job1 := addTestJobToJobSet(t, r, "queue1", "set1", "my-job-1")
+		job2 := addTestJobToJobSet(t, r, "queue1", "set1", "my-job-1")
+		job3 := addTestJobToJobSet(t, r, "queue1", "set2", "my-job-1")
+		assert.Equal(t, job1.Id, job2.Id)
+		assert.NotEqual(t, job1.Id, job3.Id)
+	})
+}
+
+func TestJobDoubleSubmit_AfterDeduplicationWindow(t *testing.T) {
+	deduplication := configuration.JobDeduplicationConfig{Scope: configuration.DeduplicationScopeQueue, Window: 50 * time.Millisecond}
+	withRepositoryUsingConfig(t, nil, deduplication, func(r JobRepository) {
+		job1 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
+		time.Sleep(100 * time.Millisecond)
+		job2 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
+		assert.NotEqual(t, job1.Id, job2.Id)
+	})
+}
+
+func TestJobDoubleSubmit_DeduplicationDisabled(t *testing.T) {
+	withRepositoryUsingConfig(t, nil, configuration.JobDeduplicationConfig{}, func(r JobRepository) {
+		job1 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
+		job2 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
+		assert.NotEqual(t, job1.Id, job2.Id)
+	})
+}
+
 func TestJobCanBeLeasedOnlyOnce(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 
@@ -43,7 +74,7 @@ func TestJobCanBeLeasedOnlyOnce(t *testing.T) {
 }
 
 func TestJobLeaseCanBeRenewed(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 
 		renewed, e := r.RenewLease("cluster1", []string{job.Id})
@@ -54,7 +85,7 @@ func TestJobLeaseCanBeRenewed(t *testing.T) {
 }
 
 func TestJobLeaseExpiry(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 		deadline := time.Now()
 		addLeasedJob(t, r, "queue1", "cluster1")
@@ -70,7 +101,7 @@ func TestJobLeaseExpiry(t *testing.T) {
 }
 
 func TestEvenExpiredLeaseCanBeRenewed(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 		deadline := time.Now()
 
@@ -84,8 +115,27 @@ func TestEvenExpiredLeaseCanBeRenewed(t *testing.T) {
 	})
 }
 
+func TestLeaseHistoryIsKeptAfterLeaseIsReturnedAndJobDeleted(t *testing.T) {
+	withRepository(t, func(r JobRepository) {
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+		queuedJob := addTestJob(t, r, "queue1")
+
+		_, e := r.ReturnLease("cluster1", job.Id)
+		assert.Nil(t, e)
+		leased, e := r.TryLeaseJobs("cluster2", "queue1", []*api.Job{job})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(leased))
+		r.DeleteJobs([]*api.Job{job})
+
+		history, e := r.GetLeaseHistory([]string{job.Id, queuedJob.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(history))
+		assert.ElementsMatch(t, []string{"cluster1", "cluster2"}, history[job.Id])
+	})
+}
+
 func TestRenewingLeaseFailsForJobAssignedToDifferentCluster(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 
 		renewed, e := r.RenewLease("cluster2", []string{job.Id})
@@ -95,7 +145,7 @@ func TestRenewingLeaseFailsForJobAssignedToDifferentCluster(t *testing.T) {
 }
 
 func TestRenewingNonExistentLease(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		renewed, e := r.RenewLease("cluster2", []string{"missingJobId"})
 		assert.Nil(t, e)
 		assert.Equal(t, 0, len(renewed))
@@ -103,7 +153,7 @@ func TestRenewingNonExistentLease(t *testing.T) {
 }
 
 func TestDeletingExpiredJobShouldDeleteJobFromQueue(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 		deadline := time.Now()
 
@@ -125,7 +175,7 @@ func TestDeletingExpiredJobShouldDeleteJobFromQueue(t *testing.T) {
 }
 
 func TestReturnLeaseShouldReturnJobToQueue(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 
 		returned, e := r.ReturnLease("cluster1", job.Id)
@@ -140,7 +190,7 @@ func TestReturnLeaseShouldReturnJobToQueue(t *testing.T) {
 }
 
 func TestReturnLeaseFromDifferentClusterIsNoop(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 
 		returned, e := r.ReturnLease("cluster2", job.Id)
@@ -154,7 +204,7 @@ func TestReturnLeaseFromDifferentClusterIsNoop(t *testing.T) {
 }
 
 func TestReturnLeaseForJobInQueueIsNoop(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addTestJob(t, r, "queue1")
 
 		returned, e := r.ReturnLease("cluster2", job.Id)
@@ -164,7 +214,7 @@ func TestReturnLeaseForJobInQueueIsNoop(t *testing.T) {
 }
 
 func TestDeleteRunningJob(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 
 		result := r.DeleteJobs([]*api.Job{job})
@@ -175,7 +225,7 @@ func TestDeleteRunningJob(t *testing.T) {
 }
 
 func TestDeleteQueuedJob(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job := addTestJob(t, r, "queue1")
 
 		result := r.DeleteJobs([]*api.Job{job})
@@ -186,7 +236,7 @@ func TestDeleteQueuedJob(t *testing.T) {
 }
 
 func TestDeleteWithSomeMissingJobs(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		missingJob := &api.Job{Id: "jobId"}
 		runningJob := addLeasedJob(t, r, "queue1", "cluster1")
 		result := r.DeleteJobs([]*api.Job{missingJob, runningJob})
@@ -202,7 +252,7 @@ func TestDeleteWithSomeMissingJobs(t *testing.T) {
 }
 
 func TestReturnLeaseForDeletedJobShouldKeepJobDeleted(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 
 		job := addLeasedJob(t, r, "cancel-test-queue", "cluster")
 
@@ -220,7 +270,7 @@ func TestReturnLeaseForDeletedJobShouldKeepJobDeleted(t *testing.T) {
 }
 
 func TestGetActiveJobIds(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		addTestJob(t, r, "queue1")
 		addLeasedJob(t, r, "queue1", "cluster1")
 		addTestJob(t, r, "queue2")
@@ -232,7 +282,7 @@ func TestGetActiveJobIds(t *testing.T) {
 }
 
 func TestGetLeasedJobIds(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		addTestJob(t, r, "queue1")
 		leasedJob1 := addLeasedJob(t, r, "queue1", "cluster1")
 		leasedJob2 := addLeasedJob(t, r, "queue1", "cluster2")
@@ -249,7 +299,7 @@ func TestGetLeasedJobIds(t *testing.T) {
 }
 
 func TestUpdateStartTime(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
 
 		startTime := time.Now()
@@ -259,7 +309,7 @@ func TestUpdateStartTime(t *testing.T) {
 }
 
 func TestUpdateStartTime_UsesEarlierTime(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
 
 		startTime := time.Now()
@@ -278,7 +328,7 @@ func TestUpdateStartTime_UsesEarlierTime(t *testing.T) {
 }
 
 func TestUpdateStartTime_NonExistentJob(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		startTime := time.Now()
 		err := r.UpdateStartTime("NonExistent", "cluster1", startTime)
 		assert.NotNil(t, err)
@@ -289,7 +339,7 @@ func TestUpdateStartTime_NonExistentJob(t *testing.T) {
 // Saving/reading the start time shouldn't adjust the actual time it happened
 // i.e If the start time happened "now" but in a different time zone, the difference between the start time and now should be ~0 seconds
 func TestSaveAndRetrieveStartTime_HandlesDifferentTimeZones(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		loc, err := time.LoadLocation("Asia/Shanghai")
 		assert.Nil(t, err)
 		now := time.Now().UTC()
@@ -309,7 +359,7 @@ func TestSaveAndRetrieveStartTime_HandlesDifferentTimeZones(t *testing.T) {
 }
 
 func TestGetJobRunInfos(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		leasedJob1 := addLeasedJob(t, r, "queue1", "cluster1")
 		leasedJob2 := addLeasedJob(t, r, "queue1", "cluster2")
 
@@ -326,7 +376,7 @@ func TestGetJobRunInfos(t *testing.T) {
 }
 
 func TestGetJobRunInfos_HandlesJobWithoutClusterAssociation(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		job1 := addTestJob(t, r, "queue1")
 		leasedJob1 := addLeasedJob(t, r, "queue1", "cluster1")
 
@@ -344,7 +394,7 @@ func TestGetJobRunInfos_HandlesJobWithoutClusterAssociation(t *testing.T) {
 }
 
 func TestGetJobRunInfos_ReturnStartTimeForCurrentAssociatedCluster(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		leasedJob1 := addLeasedJob(t, r, "queue1", "cluster1")
 
 		startTime := time.Now()
@@ -362,7 +412,7 @@ func TestGetJobRunInfos_ReturnStartTimeForCurrentAssociatedCluster(t *testing.T)
 }
 
 func TestGetQueueActiveJobSets(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		addTestJob(t, r, "queue1")
 		addLeasedJob(t, r, "queue1", "cluster1")
 		addTestJob(t, r, "queue2")
@@ -383,7 +433,7 @@ func TestCreateJob_ApplyDefaultLimitss(t *testing.T) {
 		"memory":            resource.MustParse("512Mi"),
 		"ephemeral-storage": resource.MustParse("4Gi")}
 
-	withRepositoryUsingJobDefaults(defaults, func(r *RedisJobRepository) {
+	withRepositoryUsingJobDefaults(t, defaults, func(r JobRepository) {
 		testCases := map[*v1.ResourceList]v1.ResourceList{
 			nil: {
 				"cpu":               resource.MustParse("1"),
@@ -421,7 +471,7 @@ func TestCreateJob_ApplyDefaultLimitss(t *testing.T) {
 }
 
 func TestNumberOfRetryAttemptsIsZeroForNonExistentJob(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		retries, err := r.GetNumberOfRetryAttempts("nonexistent-job-id")
 
 		assert.Nil(t, err)
@@ -430,7 +480,7 @@ func TestNumberOfRetryAttemptsIsZeroForNonExistentJob(t *testing.T) {
 }
 
 func TestNumberOfRetryAttemptsIsZeroForNewJob(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		testJob := addLeasedJob(t, r, "some-queue", "cluster-1")
 
 		retries, err := r.GetNumberOfRetryAttempts(testJob.Id)
@@ -441,7 +491,7 @@ func TestNumberOfRetryAttemptsIsZeroForNewJob(t *testing.T) {
 }
 
 func TestAddRetryAttemptCreatesKeyIfJobDoesNotExist(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		err := r.AddRetryAttempt("nonexistent-job-id")
 
 		assert.Nil(t, err)
@@ -454,7 +504,7 @@ func TestAddRetryAttemptCreatesKeyIfJobDoesNotExist(t *testing.T) {
 }
 
 func TestJobRetriesAreIncrementedCorrectly(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		testJob := addLeasedJob(t, r, "some-queue", "cluster-1")
 
 		expectedRetries := 7
@@ -472,7 +522,7 @@ func TestJobRetriesAreIncrementedCorrectly(t *testing.T) {
 }
 
 func TestRetriesOfDeletedJobShouldBeZero(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		testJob := addLeasedJob(t, r, "some-queue", "cluster-1")
 
 		for i := 0; i < 11; i++ {
@@ -490,7 +540,7 @@ func TestRetriesOfDeletedJobShouldBeZero(t *testing.T) {
 }
 
 func TestIterateQueueJobs(t *testing.T) {
-	withRepository(func(r *RedisJobRepository) {
+	withRepository(t, func(r JobRepository) {
 		addedJobs := []*api.Job{}
 		for i := 0; i < 10; i++ {
 			addedJobs = append(addedJobs, addTestJob(t, r, "q1"))
@@ -508,7 +558,7 @@ func TestIterateQueueJobs(t *testing.T) {
 	})
 }
 
-func addLeasedJob(t *testing.T, r *RedisJobRepository, queue string, cluster string) *api.Job {
+func addLeasedJob(t *testing.T, r JobRepository, queue string, cluster string) *api.Job {
 	job := addTestJob(t, r, queue)
 	leased, e := r.TryLeaseJobs(cluster, queue, []*api.Job{job})
 	assert.Nil(t, e)
@@ -517,21 +567,25 @@ func addLeasedJob(t *testing.T, r *RedisJobRepository, queue string, cluster str
 	return job
 }
 
-func addTestJob(t *testing.T, r *RedisJobRepository, queue string) *api.Job {
+func addTestJob(t *testing.T, r JobRepository, queue string) *api.Job {
 	return addTestJobWithClientId(t, r, queue, "")
 }
 
-func addTestJobWithClientId(t *testing.T, r *RedisJobRepository, queue string, clientId string) *api.Job {
+func addTestJobWithClientId(t *testing.T, r JobRepository, queue string, clientId string) *api.Job {
+	return addTestJobToJobSet(t, r, queue, "set1", clientId)
+}
+
+func addTestJobToJobSet(t *testing.T, r JobRepository, queue string, jobSetId string, clientId string) *api.Job {
 	cpu := resource.MustParse("1")
 	memory := resource.MustParse("512Mi")
 
-	return addTestJobWithRequirements(t, r, queue, clientId, 1, v1.ResourceRequirements{
+	return addTestJobWithJobSet(t, r, queue, jobSetId, clientId, 1, v1.ResourceRequirements{
 		Limits:   v1.ResourceList{"cpu": cpu, "memory": memory},
 		Requests: v1.ResourceList{"cpu": cpu, "memory": memory},
 	})
 }
 
-func addTestJobWithPriority(t *testing.T, r *RedisJobRepository, queue string, priority float64) *api.Job {
+func addTestJobWithPriority(t *testing.T, r JobRepository, queue string, priority float64) *api.Job {
 	cpu := resource.MustParse("1")
 	memory := resource.MustParse("512Mi")
 
@@ -541,11 +595,14 @@ func addTestJobWithPriority(t *testing.T, r *RedisJobRepository, queue string, p
 	})
 }
 
-func addTestJobWithRequirements(t *testing.T, r *RedisJobRepository, queue string, clientId string, priority float64, requirements v1.ResourceRequirements) *api.Job {
+func addTestJobWithRequirements(t *testing.T, r JobRepository, queue string, clientId string, priority float64, requirements v1.ResourceRequirements) *api.Job {
+	return addTestJobWithJobSet(t, r, queue, "set1", clientId, priority, requirements)
+}
 
+func addTestJobWithJobSet(t *testing.T, r JobRepository, queue string, jobSetId string, clientId string, priority float64, requirements v1.ResourceRequirements) *api.Job {
 	jobs, e := r.CreateJobs(&api.JobSubmitRequest{
 		Queue:    queue,
-		JobSetId: "set1",
+		JobSetId: jobSetId,
 		JobRequestItems: []*api.JobSubmitRequestItem{
 			{
 				Priority: priority,
@@ -571,17 +628,51 @@ func addTestJobWithRequirements(t *testing.T, r *RedisJobRepository, queue strin
 	return jobs[0]
 }
 
-func withRepository(action func(r *RedisJobRepository)) {
-	withRepositoryUsingJobDefaults(nil, action)
+var testDeduplication = configuration.JobDeduplicationConfig{Scope: configuration.DeduplicationScopeQueue, Window: time.Hour}
+
+// withRepository runs the action against each implementation of JobRepository
+func withRepository(t *testing.T, action func(r JobRepository)) {
+	withRepositoryUsingJobDefaults(t, nil, action)
 }
 
-func withRepositoryUsingJobDefaults(jobDefaultLimit common.ComputeResources, action func(r *RedisJobRepository)) {
+func withRepositoryUsingJobDefaults(t *testing.T, jobDefaultLimit common.ComputeResources, action func(r JobRepository)) {
+	withRepositoryUsingConfig(t, jobDefaultLimit, testDeduplication, action)
+}
+
+func withRepositoryUsingConfig(t *testing.T, jobDefaultLimit common.ComputeResources, deduplication configuration.JobDeduplicationConfig, action func(r JobRepository)) {
+	withRedisJobRepository(jobDefaultLimit, deduplication, action)
+	withPostgresDatabase(t, func(db *goqu.Database) {
+		action(NewPostgresJobRepository(db, jobDefaultLimit, deduplication))
+	})
+}
+
+func withRedisJobRepository(jobDefaultLimit common.ComputeResources, deduplication configuration.JobDeduplicationConfig, action func(r JobRepository)) {
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 	defer client.FlushDB()
 	defer client.Close()
 
 	client.FlushDB()
 
-	repo := NewRedisJobRepository(client, jobDefaultLimit)
+	repo := NewRedisJobRepository(client, jobDefaultLimit, deduplication)
 	action(repo)
 }
+
+func TestAddJobs_RejectsJobsOfClosedJobSet(t *testing.T) {
+	withRedisJobRepository(nil, testDeduplication, func(r JobRepository) {
+		redisRepository := r.(*RedisJobRepository)
+		jobSetRepository := NewRedisJobSetRepository(redisRepository.db)
+		closed, e := jobSetRepository.CloseJobSet("queue1", "set1")
+		assert.NoError(t, e)
+		assert.True(t, closed)
+
+		results, e := r.AddJobs([]*api.Job{{Id: util.NewULID(), Queue: "queue1", JobSetId: "set1"}})
+		assert.NoError(t, e)
+		assert.Len(t, results, 1)
+		assert.Error(t, results[0].Error)
+
+		results, e = r.AddJobs([]*api.Job{{Id: util.NewULID(), Queue: "queue1", JobSetId: "set2"}})
+		assert.NoError(t, e)
+		assert.Len(t, results, 1)
+		assert.NoError(t, results[0].Error)
+	})
+}
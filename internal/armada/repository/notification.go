@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const notificationSubscriptionsPrefix = "Notification:Subscriptions:" // {queue}  - map subscriptionId -> subscription protobuf object
+const notificationDeliveriesKey = "Notification:Deliveries"           //          - map deliveryId -> delivery json
+const notificationDueKey = "Notification:Due"                         //          - sorted set of deliveryIds by next attempt time
+const notificationDeadLettersPrefix = "Notification:DeadLetters:"     // {queue}  - list of dead letter protobuf objects, newest first
+const jobNotifyUrlPrefix = "Notification:JobUrl:"                     // {jobId}  - notify url from job annotation
+
+// NotificationDelivery is a notification waiting to be delivered
+type NotificationDelivery struct {
+	Id             string
+	Queue          string
+	SubscriptionId string
+	Url            string
+	Secret         string
+	Message        []byte // EventMessage protobuf object
+	Attempts       int32
+	LastError      string
+}
+
+type NotificationRepository interface {
+	CreateSubscription(subscription *api.NotificationSubscription) error
+	GetSubscriptions(queue string) ([]*api.NotificationSubscription, error)
+	// Returns false if the subscription does not exist
+	DeleteSubscription(queue string, id string) (bool, error)
+
+	SetJobNotifyUrls(urls map[string]string, expiry time.Duration) error
+	GetJobNotifyUrls(jobIds []string) (map[string]string, error)
+	DeleteJobNotifyUrls(jobIds []string) error
+
+	AddDeliveries(deliveries []*NotificationDelivery) error
+	// ClaimDeliveries returns deliveries which are due, claimed deliveries are not returned again until claimExpiry passes
+	ClaimDeliveries(limit int64, claimExpiry time.Duration) ([]*NotificationDelivery, error)
+	RetryDelivery(delivery *NotificationDelivery, retryAt time.Time) error
+	CompleteDelivery(delivery *NotificationDelivery) error
+	// DeadLetterDelivery removes the delivery and adds the dead letter to the log of the queue, keeping logSize latest dead letters
+	DeadLetterDelivery(delivery *NotificationDelivery, deadLetter *api.NotificationDeadLetter, logSize int64) error
+	GetDeadLetters(queue string, limit int64) ([]*api.NotificationDeadLetter, error)
+}
+
+type RedisNotificationRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisNotificationRepository(db redis.UniversalClient) *RedisNotificationRepository {
+	return &RedisNotificationRepository{db: db}
+}
+
+func (repo *RedisNotificationRepository) CreateSubscription(subscription *api.NotificationSubscription) error {
+	data, e := proto.Marshal(subscription)
+	if e != nil {
+		return e
+	}
+	return repo.db.HSet(notificationSubscriptionsPrefix+subscription.Queue, subscription.Id, data).Err()
+}
+
+func (repo *RedisNotificationRepository) GetSubscriptions(queue string) ([]*api.NotificationSubscription, error) {
+	result, e := repo.db.HGetAll(notificationSubscriptionsPrefix + queue).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	subscriptions := make([]*api.NotificationSubscription, 0, len(result))
+	for _, data := range result {
+		subscription := &api.NotificationSubscription{}
+		e := proto.Unmarshal([]byte(data), subscription)
+		if e != nil {
+			return nil, e
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func (repo *RedisNotificationRepository) DeleteSubscription(queue string, id string) (bool, error) {
+	deleted, e := repo.db.HDel(notificationSubscriptionsPrefix+queue, id).Result()
+	if e != nil {
+		return false, e
+	}
+	return deleted > 0, nil
+}
+
+func (repo *RedisNotificationRepository) SetJobNotifyUrls(urls map[string]string, expiry time.Duration) error {
+	if len(urls) == 0 {
+		return nil
+	}
+	pipe := repo.db.Pipeline()
+	for jobId, url := range urls {
+		pipe.Set(jobNotifyUrlPrefix+jobId, url, expiry)
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
+func (repo *RedisNotificationRepository) GetJobNotifyUrls(jobIds []string) (map[string]string, error) {
+	urls := map[string]string{}
+	if len(jobIds) == 0 {
+		return urls, nil
+	}
+
+	keys := make([]string, 0, len(jobIds))
+	for _, jobId := range jobIds {
+		keys = append(keys, jobNotifyUrlPrefix+jobId)
+	}
+	result, e := repo.db.MGet(keys...).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	for i, url := range result {
+		if url != nil {
+			urls[jobIds[i]] = url.(string)
+		}
+	}
+	return urls, nil
+}
+
+func (repo *RedisNotificationRepository) DeleteJobNotifyUrls(jobIds []string) error {
+	if len(jobIds) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(jobIds))
+	for _, jobId := range jobIds {
+		keys = append(keys, jobNotifyUrlPrefix+jobId)
+	}
+	return repo.db.Del(keys...).Err()
+}
+
+func (repo *RedisNotificationRepository) AddDeliveries(deliveries []*NotificationDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	now := float64(time.Now().UnixNano())
+	pipe := repo.db.TxPipeline()
+	for _, delivery := range deliveries {
+		data, e := json.Marshal(delivery)
+		if e != nil {
+			return e
+		}
+		pipe.HSet(notificationDeliveriesKey, delivery.Id, data)
+		pipe.ZAdd(notificationDueKey, redis.Z{Member: delivery.Id, Score: now})
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
+func (repo *RedisNotificationRepository) ClaimDeliveries(limit int64, claimExpiry time.Duration) ([]*NotificationDelivery, error) {
+	now := time.Now()
+	result, e := claimDeliveriesScript.Run(repo.db, []string{notificationDueKey},
+		now.UnixNano(), now.Add(claimExpiry).UnixNano(), limit).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	ids := result.([]interface{})
+	if len(ids) == 0 {
+		return []*NotificationDelivery{}, nil
+	}
+	fields := make([]string, 0, len(ids))
+	for _, id := range ids {
+		fields = append(fields, id.(string))
+	}
+
+	data, e := repo.db.HMGet(notificationDeliveriesKey, fields...).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	deliveries := make([]*NotificationDelivery, 0, len(data))
+	for i, d := range data {
+		if d == nil {
+			// delivery was completed by other server after its claim expired
+			repo.db.ZRem(notificationDueKey, fields[i])
+			continue
+		}
+		delivery := &NotificationDelivery{}
+		e := json.Unmarshal([]byte(d.(string)), delivery)
+		if e != nil {
+			return nil, e
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+var claimDeliveriesScript = redis.NewScript(`
+local due = KEYS[1]
+local now = ARGV[1]
+local claimExpiry = ARGV[2]
+local limit = ARGV[3]
+
+local ids = redis.call('ZRANGEBYSCORE', due, '-inf', now, 'LIMIT', 0, limit)
+for _, id in ipairs(ids) do
+	redis.call('ZADD', due, claimExpiry, id)
+end
+return ids
+`)
+
+func (repo *RedisNotificationRepository) RetryDelivery(delivery *NotificationDelivery, retryAt time.Time) error {
+	data, e := json.Marshal(delivery)
+	if e != nil {
+		return e
+	}
+	pipe := repo.db.TxPipeline()
+	pipe.HSet(notificationDeliveriesKey, delivery.Id, data)
+	pipe.ZAdd(notificationDueKey, redis.Z{Member: delivery.Id, Score: float64(retryAt.UnixNano())})
+	_, e = pipe.Exec()
+	return e
+}
+
+func (repo *RedisNotificationRepository) CompleteDelivery(delivery *NotificationDelivery) error {
+	pipe := repo.db.TxPipeline()
+	pipe.ZRem(notificationDueKey, delivery.Id)
+	pipe.HDel(notificationDeliveriesKey, delivery.Id)
+	_, e := pipe.Exec()
+	return e
+}
+
+func (repo *RedisNotificationRepository) DeadLetterDelivery(delivery *NotificationDelivery, deadLetter *api.NotificationDeadLetter, logSize int64) error {
+	data, e := proto.Marshal(deadLetter)
+	if e != nil {
+		return e
+	}
+	key := notificationDeadLettersPrefix + delivery.Queue
+
+	pipe := repo.db.TxPipeline()
+	pipe.ZRem(notificationDueKey, delivery.Id)
+	pipe.HDel(notificationDeliveriesKey, delivery.Id)
+	pipe.LPush(key, data)
+	if logSize > 0 {
+		pipe.LTrim(key, 0, logSize-1)
+	}
+	_, e = pipe.Exec()
+	return e
+}
+
+func (repo *RedisNotificationRepository) GetDeadLetters(queue string, limit int64) ([]*api.NotificationDeadLetter, error) {
+	result, e := repo.db.LRange(notificationDeadLettersPrefix+queue, 0, limit-1).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	deadLetters := make([]*api.NotificationDeadLetter, 0, len(result))
+	for _, data := range result {
+		deadLetter := &api.NotificationDeadLetter{}
+		e := proto.Unmarshal([]byte(data), deadLetter)
+		if e != nil {
+			return nil, e
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
@@ -9,14 +10,22 @@ import (
 	"github.com/G-Research/armada/pkg/api"
 )
 
-const queueHashKey = "Queue"
+const (
+	queueHashKey        = "Queue"
+	queueVersionHashKey = "QueueVersion" // queue name -> version incremented on every change of the queue
+)
 
 var ErrQueueNotFound = errors.New("Queue does not exist")
+var ErrQueueAlreadyExists = errors.New("Queue already exists")
+var ErrQueueVersionConflict = errors.New("Queue was changed since it was read")
 
 type QueueRepository interface {
 	GetAllQueues() ([]*api.Queue, error)
 	GetQueue(name string) (*api.Queue, error)
+	// CreateQueue stores new queue, existing queues are not overwritten
 	CreateQueue(queue *api.Queue) error
+	// UpdateQueue replaces the queue if it was not changed since its version was read, version of the queue is updated on success
+	UpdateQueue(queue *api.Queue) error
 	DeleteQueue(name string) error
 }
 
@@ -29,15 +38,18 @@ func NewRedisQueueRepository(db redis.UniversalClient) *RedisQueueRepository {
 }
 
 func (r *RedisQueueRepository) GetAllQueues() ([]*api.Queue, error) {
-	result, err := r.db.HGetAll(queueHashKey).Result()
+	pipe := r.db.TxPipeline()
+	queuesCmd := pipe.HGetAll(queueHashKey)
+	versionsCmd := pipe.HGetAll(queueVersionHashKey)
+	_, err := pipe.Exec()
 	if err != nil {
 		return nil, err
 	}
 
+	versions := versionsCmd.Val()
 	queues := make([]*api.Queue, 0)
-	for _, v := range result {
-		queue := &api.Queue{}
-		e := proto.Unmarshal([]byte(v), queue)
+	for name, v := range queuesCmd.Val() {
+		queue, e := unmarshalQueue(v, versions[name])
 		if e != nil {
 			return nil, e
 		}
@@ -47,30 +59,115 @@ func (r *RedisQueueRepository) GetAllQueues() ([]*api.Queue, error) {
 }
 
 func (r *RedisQueueRepository) GetQueue(name string) (*api.Queue, error) {
-	result, err := r.db.HGet(queueHashKey, name).Result()
+	pipe := r.db.TxPipeline()
+	queueCmd := pipe.HGet(queueHashKey, name)
+	versionCmd := pipe.HGet(queueVersionHashKey, name)
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	result, err := queueCmd.Result()
 	if err == redis.Nil {
 		return nil, ErrQueueNotFound
 	} else if err != nil {
 		return nil, err
 	}
-	queue := &api.Queue{}
-	e := proto.Unmarshal([]byte(result), queue)
-	if e != nil {
-		return nil, e
+	version, err := versionCmd.Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
 	}
-	return queue, nil
+	return unmarshalQueue(result, version)
 }
 
 func (r *RedisQueueRepository) CreateQueue(queue *api.Queue) error {
-	data, e := proto.Marshal(queue)
+	data, e := marshalQueue(queue)
+	if e != nil {
+		return e
+	}
+	result, e := createQueueScript.Run(r.db, []string{queueHashKey, queueVersionHashKey}, queue.Name, data).Int64()
 	if e != nil {
 		return e
 	}
-	result := r.db.HSet(queueHashKey, queue.Name, data)
-	return result.Err()
+	if result == -1 {
+		return ErrQueueAlreadyExists
+	}
+	queue.Version = uint64(result)
+	return nil
 }
 
+var createQueueScript = redis.NewScript(`
+local queueHashKey = KEYS[1]
+local queueVersionHashKey = KEYS[2]
+local name = ARGV[1]
+local data = ARGV[2]
+
+if redis.call('HSETNX', queueHashKey, name, data) == 0 then
+	return -1
+end
+return redis.call('HINCRBY', queueVersionHashKey, name, 1)
+`)
+
+func (r *RedisQueueRepository) UpdateQueue(queue *api.Queue) error {
+	data, e := marshalQueue(queue)
+	if e != nil {
+		return e
+	}
+	result, e := updateQueueScript.Run(r.db, []string{queueHashKey, queueVersionHashKey}, queue.Name, queue.Version, data).Int64()
+	if e != nil {
+		return e
+	}
+	switch result {
+	case -1:
+		return ErrQueueNotFound
+	case -2:
+		return ErrQueueVersionConflict
+	}
+	queue.Version = uint64(result)
+	return nil
+}
+
+var updateQueueScript = redis.NewScript(`
+local queueHashKey = KEYS[1]
+local queueVersionHashKey = KEYS[2]
+local name = ARGV[1]
+local expectedVersion = tonumber(ARGV[2])
+local data = ARGV[3]
+
+if redis.call('HEXISTS', queueHashKey, name) == 0 then
+	return -1
+end
+local version = tonumber(redis.call('HGET', queueVersionHashKey, name) or 0)
+if version ~= expectedVersion then
+	return -2
+end
+redis.call('HSET', queueHashKey, name, data)
+return redis.call('HINCRBY', queueVersionHashKey, name, 1)
+`)
+
 func (r *RedisQueueRepository) DeleteQueue(name string) error {
-	result := r.db.HDel(queueHashKey, name)
-	return result.Err()
+	pipe := r.db.TxPipeline()
+	pipe.HDel(queueHashKey, name)
+	pipe.HDel(queueVersionHashKey, name)
+	_, e := pipe.Exec()
+	return e
+}
+
+// version is kept separately, so it can be compared by scripts
+func marshalQueue(queue *api.Queue) ([]byte, error) {
+	withoutVersion := *queue
+	withoutVersion.Version = 0
+	return proto.Marshal(&withoutVersion)
+}
+
+func unmarshalQueue(data string, version string) (*api.Queue, error) {
+	queue := &api.Queue{}
+	e := proto.Unmarshal([]byte(data), queue)
+	if e != nil {
+		return nil, e
+	}
+	if version != "" {
+		queue.Version, e = strconv.ParseUint(version, 10, 64)
+		if e != nil {
+			return nil, e
+		}
+	}
+	return queue, nil
 }
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestRedisQueueRepository_CreateQueue_DoesNotOverwriteExistingQueue(t *testing.T) {
+	withMiniRedis(t, func(db redis.UniversalClient) {
+		r := NewRedisQueueRepository(db)
+		assert.Nil(t, r.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1}))
+		assert.Equal(t, ErrQueueAlreadyExists, r.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 2}))
+
+		queue, e := r.GetQueue("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, float64(1), queue.PriorityFactor)
+		assert.Equal(t, uint64(1), queue.Version)
+	})
+}
+
+func TestRedisQueueRepository_UpdateQueue(t *testing.T) {
+	withMiniRedis(t, func(db redis.UniversalClient) {
+		r := NewRedisQueueRepository(db)
+		assert.Nil(t, r.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1}))
+
+		queue, e := r.GetQueue("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, uint64(1), queue.Version)
+
+		queue.PriorityFactor = 2
+		assert.Nil(t, r.UpdateQueue(queue))
+		assert.Equal(t, uint64(2), queue.Version)
+
+		updated, e := r.GetQueue("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, queue, updated)
+	})
+}
+
+func TestRedisQueueRepository_UpdateQueue_RejectsStaleVersion(t *testing.T) {
+	withMiniRedis(t, func(db redis.UniversalClient) {
+		r := NewRedisQueueRepository(db)
+		assert.Nil(t, r.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1}))
+
+		first, e := r.GetQueue("queue1")
+		assert.Nil(t, e)
+		second, e := r.GetQueue("queue1")
+		assert.Nil(t, e)
+
+		first.PriorityFactor = 2
+		assert.Nil(t, r.UpdateQueue(first))
+
+		second.PriorityFactor = 3
+		assert.Equal(t, ErrQueueVersionConflict, r.UpdateQueue(second))
+
+		queues, e := r.GetAllQueues()
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.Queue{first}, queues)
+	})
+}
+
+func TestRedisQueueRepository_UpdateQueue_WhenQueueDoesNotExist(t *testing.T) {
+	withMiniRedis(t, func(db redis.UniversalClient) {
+		r := NewRedisQueueRepository(db)
+		assert.Equal(t, ErrQueueNotFound, r.UpdateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1}))
+
+		assert.Nil(t, r.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1}))
+		assert.Nil(t, r.DeleteQueue("queue1"))
+		assert.Equal(t, ErrQueueNotFound, r.UpdateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1, Version: 1}))
+	})
+}
@@ -0,0 +1,28 @@
+-- events of all job sets, ids of events of each queue are increasing in the order of commit
+CREATE TABLE event
+(
+    event_id bigserial     NOT NULL PRIMARY KEY,
+    queue    varchar(512)  NOT NULL,
+    jobset   varchar(1024) NOT NULL,
+    reported timestamp     NOT NULL,
+    message  bytea         NOT NULL
+);
+
+CREATE INDEX idx_event_queue_jobset_event_id ON event (queue, jobset, event_id);
+CREATE INDEX idx_event_queue_event_id ON event (queue, event_id);
+CREATE INDEX idx_event_reported ON event (reported);
+
+-- current state of jobs maintained together with events, see GetJobSetState
+CREATE TABLE job_set_snapshot
+(
+    queue         varchar(512)  NOT NULL,
+    jobset        varchar(1024) NOT NULL,
+    job_id        varchar(32)   NOT NULL,
+    status        smallint      NOT NULL,
+    cluster       varchar(512)  NOT NULL,
+    last_event_id bigint        NOT NULL,
+    updated       timestamp     NOT NULL,
+    PRIMARY KEY (queue, jobset, job_id)
+);
+
+CREATE INDEX idx_job_set_snapshot_updated ON job_set_snapshot (updated);
@@ -0,0 +1,44 @@
+-- jobs of all queues, state is 1 for queued, 2 for leased and 0 for deleted jobs
+CREATE TABLE job
+(
+    job_id   varchar(32)      NOT NULL PRIMARY KEY,
+    queue    varchar(512)     NOT NULL,
+    jobset   varchar(1024)    NOT NULL,
+    priority double precision NOT NULL,
+    state    smallint         NOT NULL,
+    cluster  varchar(512)     NULL,
+    leased   timestamp        NULL,
+    deleted  timestamp        NULL,
+    message  bytea            NOT NULL
+);
+
+CREATE INDEX idx_job_queue_state_priority_job_id ON job (queue, state, priority, job_id);
+CREATE INDEX idx_job_queue_jobset ON job (queue, jobset);
+CREATE INDEX idx_job_deleted ON job (deleted);
+
+-- client ids of recently submitted jobs, used to detect duplicate submissions
+CREATE TABLE job_client_id
+(
+    queue     varchar(512) NOT NULL,
+    client_id varchar(512) NOT NULL,
+    job_id    varchar(32)  NOT NULL,
+    expires   timestamp    NOT NULL,
+    PRIMARY KEY (queue, client_id)
+);
+
+CREATE INDEX idx_job_client_id_expires ON job_client_id (expires);
+
+-- earliest start time of the job on each cluster in unix nanoseconds
+CREATE TABLE job_start_time
+(
+    job_id  varchar(32)  NOT NULL,
+    cluster varchar(512) NOT NULL,
+    started bigint       NOT NULL,
+    PRIMARY KEY (job_id, cluster)
+);
+
+CREATE TABLE job_retry
+(
+    job_id   varchar(32) NOT NULL PRIMARY KEY,
+    attempts integer     NOT NULL
+);
@@ -0,0 +1,4 @@
+-- client ids can be scoped by job sets, jobset is empty when they are scoped by queues
+ALTER TABLE job_client_id ADD COLUMN jobset varchar(1024) NOT NULL DEFAULT '';
+ALTER TABLE job_client_id DROP CONSTRAINT job_client_id_pkey;
+ALTER TABLE job_client_id ADD PRIMARY KEY (queue, jobset, client_id);
@@ -0,0 +1,15 @@
+-- records of mutating api calls, see audit.PostgresSink
+CREATE TABLE audit_log
+(
+    id        bigserial     NOT NULL PRIMARY KEY,
+    time      timestamp     NOT NULL,
+    principal varchar(512)  NOT NULL,
+    method    varchar(512)  NOT NULL,
+    queue     varchar(512)  NOT NULL,
+    jobset    varchar(1024) NOT NULL,
+    record    bytea         NOT NULL
+);
+
+CREATE INDEX idx_audit_log_time ON audit_log (time);
+CREATE INDEX idx_audit_log_principal_id ON audit_log (principal, id);
+CREATE INDEX idx_audit_log_queue_id ON audit_log (queue, id);
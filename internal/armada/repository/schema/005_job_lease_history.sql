@@ -0,0 +1,7 @@
+-- clusters jobs were leased to, rows are removed together with expired jobs
+CREATE TABLE job_lease_history
+(
+    job_id  varchar(32)  NOT NULL REFERENCES job (job_id) ON DELETE CASCADE,
+    cluster varchar(512) NOT NULL,
+    PRIMARY KEY (job_id, cluster)
+);
@@ -0,0 +1,19 @@
+package schema
+
+import (
+	"database/sql"
+
+	"github.com/rakyll/statik/fs"
+
+	"github.com/G-Research/armada/internal/armada/repository/schema/statik"
+	"github.com/G-Research/armada/internal/common/database"
+)
+
+// UpdateDatabase uses its own version sequence, so the database can be shared with Lookout
+func UpdateDatabase(db *sql.DB) error {
+	vfs, err := fs.NewWithNamespace(statik.ArmadaSql)
+	if err != nil {
+		return err
+	}
+	return database.UpdateDatabase(db, vfs, "armada_database_version")
+}
@@ -0,0 +1,14 @@
+// Code generated by statik. DO NOT EDIT.
+
+package statik
+
+import (
+	"github.com/rakyll/statik/fs"
+)
+
+const ArmadaSql = "armada/sql" // static asset namespace
+
+func init() {
+	data := "PK\x03\x04\x14\x00\x08\x00\x00\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00001_events.sqlUT\x05\x00\x01\x80Cm8-- events of all job sets, ids of events of each queue are increasing in the order of commit\nCREATE TABLE event\n(\n    event_id bigserial     NOT NULL PRIMARY KEY,\n    queue    varchar(512)  NOT NULL,\n    jobset   varchar(1024) NOT NULL,\n    reported timestamp     NOT NULL,\n    message  bytea         NOT NULL\n);\n\nCREATE INDEX idx_event_queue_jobset_event_id ON event (queue, jobset, event_id);\nCREATE INDEX idx_event_queue_event_id ON event (queue, event_id);\nCREATE INDEX idx_event_reported ON event (reported);\n\n-- current state of jobs maintained together with events, see GetJobSetState\nCREATE TABLE job_set_snapshot\n(\n    queue         varchar(512)  NOT NULL,\n    jobset        varchar(1024) NOT NULL,\n    job_id        varchar(32)   NOT NULL,\n    status        smallint      NOT NULL,\n    cluster       varchar(512)  NOT NULL,\n    last_event_id bigint        NOT NULL,\n    updated       timestamp     NOT NULL,\n    PRIMARY KEY (queue, jobset, job_id)\n);\n\nCREATE INDEX idx_job_set_snapshot_updated ON job_set_snapshot (updated);\nPK\x07\x08v\x08\x08\x91\x0b\x04\x00\x00\x0b\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x00\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0c\x00	\x00002_jobs.sqlUT\x05\x00\x01\x80Cm8-- jobs of all queues, state is 1 for queued, 2 for leased and 0 for deleted jobs\nCREATE TABLE job\n(\n    job_id   varchar(32)      NOT NULL PRIMARY KEY,\n    queue    varchar(512)     NOT NULL,\n    jobset   varchar(1024)    NOT NULL,\n    priority double precision NOT NULL,\n    state    smallint         NOT NULL,\n    cluster  varchar(512)     NULL,\n    leased   timestamp        NULL,\n    deleted  timestamp        NULL,\n    message  bytea            NOT NULL\n);\n\nCREATE INDEX idx_job_queue_state_priority_job_id ON job (queue, state, priority, job_id);\nCREATE INDEX idx_job_queue_jobset ON job (queue, jobset);\nCREATE INDEX idx_job_deleted ON job (deleted);\n\n-- client ids of recently submitted jobs, used to detect duplicate submissions\nCREATE TABLE job_client_id\n(\n    queue     varchar(512) NOT NULL,\n    client_id varchar(512) NOT NULL,\n    job_id    varchar(32)  NOT NULL,\n    expires   timestamp    NOT NULL,\n    PRIMARY KEY (queue, client_id)\n);\n\nCREATE INDEX idx_job_client_id_expires ON job_client_id (expires);\n\n-- earliest start time of the job on each cluster in unix nanoseconds\nCREATE TABLE job_start_time\n(\n    job_id  varchar(32)  NOT NULL,\n    cluster varchar(512) NOT NULL,\n    started bigint       NOT NULL,\n    PRIMARY KEY (job_id, cluster)\n);\n\nCREATE TABLE job_retry\n(\n    job_id   varchar(32) NOT NULL PRIMARY KEY,\n    attempts integer     NOT NULL\n);\nPK\x07\x08\xa9O\x91\x90_\x05\x00\x00_\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x00\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00003_job_client_id_jobset.sqlUT\x05\x00\x01\x80Cm8-- client ids can be scoped by job sets, jobset is empty when they are scoped by queues\nALTER TABLE job_client_id ADD COLUMN jobset varchar(1024) NOT NULL DEFAULT '';\nALTER TABLE job_client_id DROP CONSTRAINT job_client_id_pkey;\nALTER TABLE job_client_id ADD PRIMARY KEY (queue, jobset, client_id);\nPK\x07\x08\xc4\x13\x95\xf5+\x01\x00\x00+\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x00\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00004_audit_log.sqlUT\x05\x00\x01\x80Cm8-- records of mutating api calls, see audit.PostgresSink\nCREATE TABLE audit_log\n(\n    id        bigserial     NOT NULL PRIMARY KEY,\n    time      timestamp     NOT NULL,\n    principal varchar(512)  NOT NULL,\n    method    varchar(512)  NOT NULL,\n    queue     varchar(512)  NOT NULL,\n    jobset    varchar(1024) NOT NULL,\n    record    bytea         NOT NULL\n);\n\nCREATE INDEX idx_audit_log_time ON audit_log (time);\nCREATE INDEX idx_audit_log_principal_id ON audit_log (principal, id);\nCREATE INDEX idx_audit_log_queue_id ON audit_log (queue, id);\nPK\x07\x08r)e_$\x02\x00\x00$\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x00\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00005_job_lease_history.sqlUT\x05\x00\x01\x80Cm8-- clusters jobs were leased to, rows are removed together with expired jobs\nCREATE TABLE job_lease_history\n(\n    job_id  varchar(32)  NOT NULL REFERENCES job (job_id) ON DELETE CASCADE,\n    cluster varchar(512) NOT NULL,\n    PRIMARY KEY (job_id, cluster)\n);\nPK\x07\x08\x0d\xa1\x80\x7f\x03\x01\x00\x00\x03\x01\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x00\x00\x00\x00!(v\x08\x08\x91\x0b\x04\x00\x00\x0b\x04\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00001_events.sqlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x00\x00\x00\x00!(\xa9O\x91\x90_\x05\x00\x00_\x05\x00\x00\x0c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81P\x04\x00\x00002_jobs.sqlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x00\x00\x00\x00!(\xc4\x13\x95\xf5+\x01\x00\x00+\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf2	\x00\x00003_job_client_id_jobset.sqlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x00\x00\x00\x00!(r)e_$\x02\x00\x00$\x02\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81p\x0b\x00\x00004_audit_log.sqlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x00\x00\x00\x00!(\x0d\xa1\x80\x7f\x03\x01\x00\x00\x03\x01\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdc\x0d\x00\x00005_job_lease_history.sqlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x05\x00\x05\x00s\x01\x00\x00/\x0f\x00\x00\x00\x00"
+	fs.RegisterWithNamespace("armada/sql", data)
+}
@@ -0,0 +1,214 @@
+package scheduling
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// ExplainClusterScheduling evaluates the job against node types reported by the cluster, the cluster is schedulable
+// in the same cases as MatchSchedulingRequirements returns true
+func ExplainClusterScheduling(job *api.Job, schedulingInfo *api.ClusterSchedulingInfoReport) *api.ClusterExplanation {
+	explanation := &api.ClusterExplanation{
+		ClusterId:   schedulingInfo.ClusterId,
+		Pool:        schedulingInfo.Pool,
+		Schedulable: true,
+		Reasons:     explainMinimumJobSize(job, schedulingInfo.MinimumJobSize),
+	}
+	if len(explanation.Reasons) > 0 {
+		explanation.Schedulable = false
+	}
+
+	podSpecs := job.GetAllPodSpecs()
+	podMatches := make([]bool, len(podSpecs))
+	for _, nodeType := range schedulingInfo.NodeTypes {
+		nodeTypeExplanation := &api.NodeTypeExplanation{NodeType: nodeType}
+		for i, podSpec := range podSpecs {
+			reasons := explainNodeType(podSpec, nodeType)
+			if len(reasons) == 0 {
+				podMatches[i] = true
+			}
+			for _, reason := range reasons {
+				nodeTypeExplanation.Reasons = append(nodeTypeExplanation.Reasons, podReason(len(podSpecs), i, reason))
+			}
+		}
+		nodeTypeExplanation.Matches = len(nodeTypeExplanation.Reasons) == 0
+		explanation.NodeTypes = append(explanation.NodeTypes, nodeTypeExplanation)
+	}
+
+	if len(schedulingInfo.NodeTypes) == 0 {
+		explanation.Schedulable = false
+		explanation.Reasons = append(explanation.Reasons, "cluster did not report any schedulable nodes")
+		return explanation
+	}
+	for i, matches := range podMatches {
+		if !matches {
+			explanation.Schedulable = false
+			reason := "job does not fit any node type of the cluster"
+			if len(podSpecs) > 1 {
+				reason = fmt.Sprintf("pod %d of the job does not fit any node type of the cluster", i)
+			}
+			explanation.Reasons = append(explanation.Reasons, reason)
+		}
+	}
+	return explanation
+}
+
+// ExplainQueueLimits evaluates current usage of the queue in the pool against its resource limits and its share
+// of the pool, both calculated the same way as when jobs are leased to clusters of the pool.
+// Reports and priorities have to be of active clusters of the pool only.
+func ExplainQueueLimits(
+	config *configuration.SchedulingConfig,
+	job *api.Job,
+	queue *api.Queue,
+	pool string,
+	activeQueues []*api.Queue,
+	activePoolClusterReports map[string]*api.ClusterUsageReport,
+	poolLeasedJobReports map[string]*api.ClusterLeasedReport,
+	clusterPriorities map[string]map[string]float64) *api.PoolExplanation {
+
+	explanation := &api.PoolExplanation{Pool: pool}
+
+	totalCapacity := &common.ComputeResources{}
+	poolCapacity := common.ComputeResources{}
+	for _, clusterReport := range activePoolClusterReports {
+		totalCapacity.Add(clusterReport.ClusterAvailableCapacity)
+		poolCapacity.Add(clusterReport.ClusterCapacity)
+	}
+
+	resourceLimit := queueResourceLimit(queue, totalCapacity.MulByResource(config.MaximalResourceFractionPerQueue), totalCapacity)
+	queueAllocation := CombineLeasedReportResourceByQueue(poolLeasedJobReports)[queue.Name].AsFloat()
+	jobRequest := common.TotalJobResourceRequest(job).AsFloat()
+	for _, resourceName := range sortedResourceNames(jobRequest) {
+		limit, limited := resourceLimit[resourceName]
+		requested := jobRequest[resourceName]
+		if !limited || requested <= 0 || queueAllocation[resourceName]+requested <= limit {
+			continue
+		}
+		explanation.Reasons = append(explanation.Reasons, fmt.Sprintf(
+			"queue limit of %s reached: queue uses %s of %s allowed in the pool and the job requests %s",
+			resourceName, formatResource(queueAllocation[resourceName]), formatResource(limit), formatResource(requested)))
+	}
+
+	// the queue of queued job is always active, it is added in case the list of active queues is outdated
+	queues := []*api.Queue{queue}
+	for _, q := range activeQueues {
+		if q.Name != queue.Name {
+			queues = append(queues, q)
+		}
+	}
+	priorities := CalculateQueuesPriorityInfo(clusterPriorities, activePoolClusterReports, queues)
+	inverseSum := 0.0
+	for _, info := range priorities {
+		inverseSum += 1 / info.Priority
+	}
+	queuePriority := priorities[queue]
+	explanation.ActiveQueues = int32(len(queues))
+	explanation.QueuePriority = queuePriority.Priority
+	explanation.QueueFairShare = (1 / queuePriority.Priority) / inverseSum
+
+	scarcity := config.GetResourceScarcity(pool)
+	if scarcity == nil {
+		scarcity = ResourceScarcityFromReports(activePoolClusterReports)
+	}
+	if capacityUsage := ResourcesAsUsage(scarcity, poolCapacity); capacityUsage > 0 {
+		explanation.QueueUsage = ResourcesAsUsage(scarcity, queuePriority.CurrentUsage) / capacityUsage
+	}
+	if len(queues) > 1 && explanation.QueueUsage >= explanation.QueueFairShare {
+		explanation.Reasons = append(explanation.Reasons, fmt.Sprintf(
+			"queue uses %.0f%% of the pool which is at least its fair share of %.0f%% given by its priority %.2f among %d active queues, other queues are preferred",
+			explanation.QueueUsage*100, explanation.QueueFairShare*100, explanation.QueuePriority, explanation.ActiveQueues))
+	}
+	return explanation
+}
+
+func explainMinimumJobSize(job *api.Job, minimumJobSize common.ComputeResources) []string {
+	reasons := []string{}
+	jobRequest := common.TotalJobResourceRequest(job)
+	for _, resourceName := range sortedQuantityNames(minimumJobSize) {
+		minimum := minimumJobSize[resourceName]
+		requested := jobRequest[resourceName]
+		if requested.Cmp(minimum) < 0 {
+			reasons = append(reasons, fmt.Sprintf("job requests %s %s which is less than minimum job size %s of the cluster",
+				requested.String(), resourceName, minimum.String()))
+		}
+	}
+	return reasons
+}
+
+func explainNodeType(podSpec *v1.PodSpec, nodeType *api.NodeType) []string {
+	reasons := []string{}
+
+	podRequest := common.TotalPodResourceRequest(podSpec)
+	for _, resourceName := range sortedQuantityNames(podRequest) {
+		requested := podRequest[resourceName]
+		allocatable := nodeType.AllocatableResources[resourceName]
+		if requested.Cmp(allocatable) > 0 {
+			reasons = append(reasons, fmt.Sprintf("insufficient %s: pod requests %s and node type has %s allocatable",
+				resourceName, requested.String(), allocatable.String()))
+		}
+	}
+
+	selectorKeys := make([]string, 0, len(podSpec.NodeSelector))
+	for key := range podSpec.NodeSelector {
+		selectorKeys = append(selectorKeys, key)
+	}
+	sort.Strings(selectorKeys)
+	for _, key := range selectorKeys {
+		value := podSpec.NodeSelector[key]
+		label, exists := nodeType.Labels[key]
+		if !exists {
+			reasons = append(reasons, fmt.Sprintf("node selector %s=%s does not match: node type has no label %s", key, value, key))
+		} else if label != value {
+			reasons = append(reasons, fmt.Sprintf("node selector %s=%s does not match: node type has label %s=%s", key, value, key, label))
+		}
+	}
+
+	for _, taint := range nodeType.Taints {
+		// same as in tolerates, only hard constraints are checked
+		if taint.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !tolerationsTolerateTaint(podSpec.Tolerations, &taint) {
+			reasons = append(reasons, fmt.Sprintf("untolerated taint %s", taint.ToString()))
+		}
+	}
+	return reasons
+}
+
+func podReason(podCount int, podIndex int, reason string) string {
+	if podCount > 1 {
+		return fmt.Sprintf("pod %d: %s", podIndex, reason)
+	}
+	return reason
+}
+
+func sortedQuantityNames(resources map[string]resource.Quantity) []string {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedResourceNames(resources common.ComputeResourcesFloat) []string {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func formatResource(value float64) string {
+	return strconv.FormatFloat(math.Round(value*1000)/1000, 'f', -1, 64)
+}
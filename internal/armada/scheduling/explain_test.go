@@ -0,0 +1,153 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_ExplainClusterScheduling_ReasonsPerNodeType(t *testing.T) {
+	request := v1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("2Gi")}
+	job := &api.Job{PodSpec: &v1.PodSpec{
+		NodeSelector: map[string]string{"armada/region": "eu"},
+		Tolerations:  []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists}},
+		Containers:   []v1.Container{{Resources: v1.ResourceRequirements{Limits: request, Requests: request}}},
+	}}
+	large := common.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")}
+	schedulingInfo := &api.ClusterSchedulingInfoReport{
+		ClusterId: "cluster-1",
+		Pool:      "cpu",
+		NodeTypes: []*api.NodeType{
+			{Labels: map[string]string{"armada/region": "us"}, AllocatableResources: large},
+			{Labels: map[string]string{"armada/region": "eu"}, AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("4Gi")}},
+			{
+				Labels:               map[string]string{"armada/region": "eu"},
+				Taints:               []v1.Taint{{Key: "dedicated", Value: "ml", Effect: v1.TaintEffectNoSchedule}, {Key: "gpu", Effect: v1.TaintEffectNoSchedule}},
+				AllocatableResources: large,
+			},
+		},
+	}
+
+	explanation := ExplainClusterScheduling(job, schedulingInfo)
+
+	assert.False(t, explanation.Schedulable)
+	assert.Equal(t, MatchSchedulingRequirements(job, schedulingInfo), explanation.Schedulable)
+	assert.Equal(t, "cluster-1", explanation.ClusterId)
+	assert.Equal(t, "cpu", explanation.Pool)
+	assert.Equal(t, []string{"job does not fit any node type of the cluster"}, explanation.Reasons)
+	assert.Len(t, explanation.NodeTypes, 3)
+	assert.Equal(t, []string{"node selector armada/region=eu does not match: node type has label armada/region=us"}, explanation.NodeTypes[0].Reasons)
+	assert.Equal(t, []string{"insufficient cpu: pod requests 2 and node type has 1 allocatable"}, explanation.NodeTypes[1].Reasons)
+	assert.Equal(t, []string{"untolerated taint dedicated=ml:NoSchedule"}, explanation.NodeTypes[2].Reasons)
+
+	schedulingInfo.NodeTypes = append(schedulingInfo.NodeTypes, &api.NodeType{
+		Labels:               map[string]string{"armada/region": "eu"},
+		Taints:               []v1.Taint{{Key: "spot", Effect: v1.TaintEffectPreferNoSchedule}},
+		AllocatableResources: large,
+	})
+
+	explanation = ExplainClusterScheduling(job, schedulingInfo)
+
+	assert.True(t, explanation.Schedulable)
+	assert.Equal(t, MatchSchedulingRequirements(job, schedulingInfo), explanation.Schedulable)
+	assert.Empty(t, explanation.Reasons)
+	assert.True(t, explanation.NodeTypes[3].Matches)
+	assert.Empty(t, explanation.NodeTypes[3].Reasons)
+}
+
+func Test_ExplainClusterScheduling_MinimumJobSizeAndMissingNodes(t *testing.T) {
+	request := v1.ResourceList{"cpu": resource.MustParse("1")}
+	job := &api.Job{PodSpec: &v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Limits: request, Requests: request}}}}}
+
+	explanation := ExplainClusterScheduling(job, &api.ClusterSchedulingInfoReport{
+		MinimumJobSize: common.ComputeResources{"nvidia.com/gpu": resource.MustParse("1")},
+	})
+
+	assert.False(t, explanation.Schedulable)
+	assert.Equal(t, []string{
+		"job requests 0 nvidia.com/gpu which is less than minimum job size 1 of the cluster",
+		"cluster did not report any schedulable nodes",
+	}, explanation.Reasons)
+}
+
+func Test_ExplainClusterScheduling_PrefixesReasonsWithPodIndex(t *testing.T) {
+	job := &api.Job{PodSpecs: []*v1.PodSpec{
+		{},
+		{NodeSelector: map[string]string{"armada/zone": "1"}},
+	}}
+
+	explanation := ExplainClusterScheduling(job, &api.ClusterSchedulingInfoReport{NodeTypes: []*api.NodeType{{}}})
+
+	assert.False(t, explanation.Schedulable)
+	assert.Equal(t, []string{"pod 1 of the job does not fit any node type of the cluster"}, explanation.Reasons)
+	assert.Equal(t, []string{"pod 1: node selector armada/zone=1 does not match: node type has no label armada/zone"}, explanation.NodeTypes[0].Reasons)
+}
+
+func Test_ExplainQueueLimits_QueueLimitReached(t *testing.T) {
+	request := v1.ResourceList{"cpu": resource.MustParse("4"), "memory": resource.MustParse("1Gi")}
+	job := &api.Job{PodSpec: &v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Limits: request, Requests: request}}}}}
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	config := &configuration.SchedulingConfig{MaximalResourceFractionPerQueue: map[string]float64{"cpu": 0.5, "memory": 0.5}}
+	capacity := common.ComputeResources{"cpu": resource.MustParse("20"), "memory": resource.MustParse("20Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"cluster-1": {ClusterId: "cluster-1", Pool: "cpu", ReportTime: time.Now(), ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	leasedReports := map[string]*api.ClusterLeasedReport{
+		"cluster-1": {ClusterId: "cluster-1", Queues: []*api.QueueLeasedReport{
+			{Name: "queue1", ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("8")}},
+		}},
+	}
+
+	explanation := ExplainQueueLimits(config, job, queue, "cpu", []*api.Queue{}, clusterReports, leasedReports, map[string]map[string]float64{})
+
+	assert.Equal(t, "cpu", explanation.Pool)
+	assert.Equal(t, int32(1), explanation.ActiveQueues)
+	assert.Equal(t, 1.0, explanation.QueueFairShare)
+	assert.Equal(t, []string{"queue limit of cpu reached: queue uses 8 of 10 allowed in the pool and the job requests 4"}, explanation.Reasons)
+
+	queue.ResourceLimits = map[string]float64{"cpu": 1}
+	explanation = ExplainQueueLimits(config, job, queue, "cpu", []*api.Queue{}, clusterReports, leasedReports, map[string]map[string]float64{})
+	assert.Empty(t, explanation.Reasons)
+}
+
+func Test_ExplainQueueLimits_LowPriorityShare(t *testing.T) {
+	job := &api.Job{PodSpec: &v1.PodSpec{}}
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+	config := &configuration.SchedulingConfig{ResourceScarcity: map[string]float64{"cpu": 1}}
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"cluster-1": {
+			ClusterId:                "cluster-1",
+			Pool:                     "cpu",
+			ReportTime:               time.Now(),
+			ClusterCapacity:          capacity,
+			ClusterAvailableCapacity: capacity,
+			Queues: []*api.QueueReport{
+				{Name: "queue1", Resources: common.ComputeResources{"cpu": resource.MustParse("6")}},
+				{Name: "queue2", Resources: common.ComputeResources{"cpu": resource.MustParse("2")}},
+			},
+		},
+	}
+	clusterPriorities := map[string]map[string]float64{"cluster-1": {"queue1": 6, "queue2": 2}}
+
+	explanation := ExplainQueueLimits(config, job, queue1, "cpu", []*api.Queue{queue1, queue2}, clusterReports, map[string]*api.ClusterLeasedReport{}, clusterPriorities)
+
+	assert.Equal(t, int32(2), explanation.ActiveQueues)
+	assert.Equal(t, 6.0, explanation.QueuePriority)
+	assert.Equal(t, 0.25, explanation.QueueFairShare)
+	assert.Equal(t, 0.6, explanation.QueueUsage)
+	assert.Equal(t, []string{
+		"queue uses 60% of the pool which is at least its fair share of 25% given by its priority 6.00 among 2 active queues, other queues are preferred",
+	}, explanation.Reasons)
+
+	explanation = ExplainQueueLimits(config, job, queue2, "cpu", []*api.Queue{queue1, queue2}, clusterReports, map[string]*api.ClusterLeasedReport{}, clusterPriorities)
+	assert.Empty(t, explanation.Reasons)
+}
@@ -104,11 +104,7 @@ func calculateQueueSchedulingLimits(
 	currentQueueResourceAllocation map[string]common.ComputeResources) map[*api.Queue]*QueueSchedulingInfo {
 	schedulingInfo := make(map[*api.Queue]*QueueSchedulingInfo, len(activeQueues))
 	for _, queue := range activeQueues {
-		remainingGlobalLimit := resourceLimitPerQueue.DeepCopy()
-		if len(queue.ResourceLimits) > 0 {
-			customQueueLimit := totalCapacity.MulByResource(queue.ResourceLimits)
-			remainingGlobalLimit = remainingGlobalLimit.MergeWith(customQueueLimit)
-		}
+		remainingGlobalLimit := queueResourceLimit(queue, resourceLimitPerQueue, totalCapacity)
 		if usage, ok := currentQueueResourceAllocation[queue.Name]; ok {
 			remainingGlobalLimit.Sub(usage.AsFloat())
 			remainingGlobalLimit.LimitToZero()
@@ -122,6 +118,16 @@ func calculateQueueSchedulingLimits(
 	return schedulingInfo
 }
 
+// queueResourceLimit returns the maximal resource the queue can use, queue resource limits take precedence over the global one
+func queueResourceLimit(queue *api.Queue, resourceLimitPerQueue common.ComputeResourcesFloat, totalCapacity *common.ComputeResources) common.ComputeResourcesFloat {
+	limit := resourceLimitPerQueue.DeepCopy()
+	if len(queue.ResourceLimits) > 0 {
+		customQueueLimit := totalCapacity.MulByResource(queue.ResourceLimits)
+		limit = limit.MergeWith(customQueueLimit)
+	}
+	return limit
+}
+
 func (c *leaseContext) scheduleJobs(limit int) ([]*api.Job, error) {
 	jobs := []*api.Job{}
 
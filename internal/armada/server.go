@@ -1,23 +1,32 @@
 package armada
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/go-redis/redis"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/lib/pq"
 	"github.com/segmentio/kafka-go"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 
+	"github.com/G-Research/armada/internal/armada/archive"
+	"github.com/G-Research/armada/internal/armada/audit"
 	"github.com/G-Research/armada/internal/armada/cache"
 	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/jobset"
 	"github.com/G-Research/armada/internal/armada/metrics"
+	"github.com/G-Research/armada/internal/armada/notification"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/armada/scheduling"
 	"github.com/G-Research/armada/internal/armada/server"
 	"github.com/G-Research/armada/internal/common/auth"
 	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/database"
 	grpcCommon "github.com/G-Research/armada/internal/common/grpc"
 	stan_util "github.com/G-Research/armada/internal/common/stan-util"
 	"github.com/G-Research/armada/internal/common/task"
@@ -29,24 +38,49 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 
-	grpcServer := grpcCommon.CreateGrpcServer(auth.ConfigureAuth(config.Auth))
-
-	taskManager := task.NewBackgroundTaskManager(metrics.MetricPrefix)
-
 	db := createRedisClient(&config.Redis)
 	eventsDb := createRedisClient(&config.EventsRedis)
 
-	jobRepository := repository.NewRedisJobRepository(db, config.Scheduling.DefaultJobLimits)
+	apiTokenRepository := repository.NewRedisApiTokenRepository(db)
+	auditSink, closeAuditSink := createAuditSink(&config.Audit)
+	unaryInterceptors := []grpc.UnaryServerInterceptor{}
+	if auditSink != nil {
+		unaryInterceptors = append(unaryInterceptors, grpcCommon.AuditUnaryServerInterceptor(auditSink, server.AuditedMethods))
+	}
+	serverCredentials, err := auth.ConfigureServerCredentials(config.Auth)
+	if err != nil {
+		panic(err)
+	}
+	grpcServer := grpcCommon.CreateGrpcServer(auth.ConfigureAuth(config.Auth, apiTokenRepository), serverCredentials, unaryInterceptors...)
+
+	taskManager := task.NewBackgroundTaskManager(metrics.MetricPrefix)
+
+	jobRepository, closeJobRepository := createJobRepository(config, db, taskManager)
 	usageRepository := repository.NewRedisUsageRepository(db)
 	queueRepository := repository.NewRedisQueueRepository(db)
 	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(db)
+	jobSetRepository := repository.NewRedisJobSetRepository(db)
 
 	queueCache := cache.NewQueueCache(queueRepository, jobRepository, schedulingInfoRepository)
 	taskManager.Register(queueCache.Refresh, config.Metrics.RefreshInterval, "refresh_queue_cache")
 
-	redisEventRepository := repository.NewRedisEventRepository(eventsDb, config.EventRetention)
+	eventRepository, closeEventRepository := createEventRepository(config, eventsDb, taskManager)
+	notificationRepository := repository.NewRedisNotificationRepository(db)
 	var eventStore repository.EventStore
 
+	var notifier *notification.Notifier
+	if config.Notifications.Enabled {
+		notifier = notification.NewNotifier(notificationRepository, &config.Notifications)
+		deliverer := notification.NewDeliverer(notificationRepository, &config.Notifications)
+		taskManager.Register(deliverer.DeliverNotifications, config.Notifications.DeliveryInterval, "notification_delivery")
+	}
+
+	var archiver *archive.Archiver
+	if config.EventArchive.Enabled {
+		archiver = createArchiver(&config.EventArchive)
+		taskManager.Register(archiver.Flush, config.EventArchive.FlushInterval, "event_archive_flush")
+	}
+
 	// TODO: move this to task manager
 	stopSubscription := func() {}
 	if len(config.EventsKafka.Brokers) > 0 {
@@ -73,13 +107,39 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 		})
 
 		eventStore = repository.NewKafkaEventStore(writer)
-		eventProcessor := repository.NewKafkaEventRedisProcessor(reader, redisEventRepository)
+		eventProcessor := repository.NewKafkaEventRedisProcessor(reader, eventRepository)
 		jobStatusEventProcessor := repository.NewKafkaJobStatusProcessor(jobStatusReader, jobRepository)
 
 		//TODO: Remove this metric, and add one to track event delay
 		taskManager.Register(eventProcessor.ProcessEvents, 100*time.Millisecond, "kafka_redis_processor")
 		taskManager.Register(jobStatusEventProcessor.ProcessEvents, 100*time.Millisecond, "kafka_job_status_processor")
 
+		if notifier != nil {
+			notificationReader := kafka.NewReader(kafka.ReaderConfig{
+				Brokers:  config.EventsKafka.Brokers,
+				GroupID:  config.EventsKafka.NotificationConsumerGroupID,
+				Topic:    config.EventsKafka.Topic,
+				MaxWait:  500 * time.Millisecond,
+				MinBytes: 0,    // 10KB
+				MaxBytes: 10e6, // 10MB
+			})
+			notificationProcessor := repository.NewKafkaEventRedisProcessor(notificationReader, notifier)
+			taskManager.Register(notificationProcessor.ProcessEvents, 100*time.Millisecond, "kafka_notification_processor")
+		}
+
+		if archiver != nil {
+			archiveReader := kafka.NewReader(kafka.ReaderConfig{
+				Brokers:  config.EventsKafka.Brokers,
+				GroupID:  config.EventsKafka.ArchiveConsumerGroupID,
+				Topic:    config.EventsKafka.Topic,
+				MaxWait:  500 * time.Millisecond,
+				MinBytes: 0,    // 10KB
+				MaxBytes: 10e6, // 10MB
+			})
+			archiveProcessor := archive.NewKafkaArchiveProcessor(archiveReader, archiver)
+			taskManager.Register(archiveProcessor.ProcessEvents, 100*time.Millisecond, "kafka_archive_processor")
+		}
+
 	} else if len(config.EventsNats.Servers) > 0 {
 
 		conn, err := stan_util.DurableConnect(
@@ -91,10 +151,21 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 			panic(err)
 		}
 		eventStore = repository.NewNatsEventStore(conn, config.EventsNats.Subject)
-		eventProcessor := repository.NewNatsEventRedisProcessor(conn, redisEventRepository, config.EventsNats.Subject, config.EventsNats.QueueGroup)
+		eventProcessor := repository.NewNatsEventRedisProcessor(conn, eventRepository, config.EventsNats.Subject, config.EventsNats.QueueGroup)
 		eventProcessor.Start()
 		jobStatusProcessor := repository.NewNatsEventJobStatusProcessor(conn, jobRepository, config.EventsNats.Subject, config.EventsNats.JobStatusGroup)
 		jobStatusProcessor.Start()
+		if notifier != nil {
+			notificationProcessor := repository.NewNatsEventRedisProcessor(conn, notifier, config.EventsNats.Subject, config.EventsNats.NotificationGroup)
+			notificationProcessor.Start()
+		}
+		if archiver != nil {
+			// buffered messages must not be redelivered before the archiver flushes them
+			ackWait := 2*config.EventArchive.FlushInterval + time.Minute
+			archiveProcessor := archive.NewNatsArchiveProcessor(conn, archiver, config.EventsNats.Subject, config.EventsNats.ArchiveGroup,
+				ackWait, config.EventArchive.MaxBatchSize)
+			archiveProcessor.Start()
+		}
 
 		stopSubscription = func() {
 			err := conn.Close()
@@ -103,19 +174,31 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 			}
 		}
 
+	} else if notifier != nil {
+		warnArchiveUnavailable(archiver)
+		eventStore = repository.NewCompositeEventStore(eventRepository, notifier)
 	} else {
-		eventStore = redisEventRepository
+		warnArchiveUnavailable(archiver)
+		eventStore = eventRepository
 	}
 
 	permissions := authorization.NewPrincipalPermissionChecker(config.Auth.PermissionGroupMapping, config.Auth.PermissionScopeMapping, config.Auth.PermissionClaimMapping)
 
-	submitServer := server.NewSubmitServer(permissions, jobRepository, queueRepository, eventStore, schedulingInfoRepository, &config.QueueManagement)
-	usageServer := server.NewUsageServer(permissions, config.PriorityHalfTime, &config.Scheduling, usageRepository, queueRepository)
-	aggregatedQueueServer := server.NewAggregatedQueueServer(permissions, config.Scheduling, jobRepository, queueCache, queueRepository, usageRepository, eventStore, schedulingInfoRepository)
-	eventServer := server.NewEventServer(permissions, redisEventRepository, eventStore)
+	submitServer := server.NewSubmitServer(permissions, jobRepository, queueRepository, eventStore, eventRepository, schedulingInfoRepository, jobSetRepository, &config.QueueManagement, notification.NewUrlPolicy(&config.Notifications))
+	executorIdentity := server.NewExecutorIdentityChecker(config.ExecutorIdentity, jobRepository, auditSink)
+	usageServer := server.NewUsageServer(permissions, config.PriorityHalfTime, &config.Scheduling, usageRepository, queueRepository, executorIdentity)
+	aggregatedQueueServer := server.NewAggregatedQueueServer(permissions, config.Scheduling, jobRepository, queueCache, queueRepository, usageRepository, eventStore, schedulingInfoRepository, executorIdentity)
+	eventServer := server.NewEventServer(permissions, eventRepository, eventStore, queueRepository, executorIdentity)
+	notificationServer := server.NewNotificationServer(permissions, notificationRepository, queueRepository, notification.NewUrlPolicy(&config.Notifications))
+	auditServer := server.NewAuditServer(permissions, auditSink)
+	apiTokenServer := server.NewApiTokenServer(permissions, apiTokenRepository)
+	schedulingServer := server.NewSchedulingServer(permissions, config.Scheduling, jobRepository, queueRepository, usageRepository, schedulingInfoRepository)
 	leaseManager := scheduling.NewLeaseManager(jobRepository, queueRepository, eventStore, config.Scheduling.Lease.ExpireAfter)
 
+	jobSetFinalizer := jobset.NewFinalizer(queueRepository, jobRepository, jobSetRepository, eventRepository, eventStore)
+
 	taskManager.Register(leaseManager.ExpireLeases, config.Scheduling.Lease.ExpiryLoopInterval, "lease_expiry")
+	taskManager.Register(jobSetFinalizer.FinalizeJobSets, config.JobSets.FinalizationInterval, "job_set_finalization")
 
 	metrics.ExposeDataMetrics(queueRepository, jobRepository, usageRepository, schedulingInfoRepository, queueCache)
 
@@ -123,18 +206,130 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 	api.RegisterUsageServer(grpcServer, usageServer)
 	api.RegisterAggregatedQueueServer(grpcServer, aggregatedQueueServer)
 	api.RegisterEventServer(grpcServer, eventServer)
+	api.RegisterNotificationServer(grpcServer, notificationServer)
+	api.RegisterAuditServer(grpcServer, auditServer)
+	api.RegisterApiTokensServer(grpcServer, apiTokenServer)
+	api.RegisterSchedulingServer(grpcServer, schedulingServer)
 
 	grpc_prometheus.Register(grpcServer)
 
 	grpcCommon.Listen(config.GrpcPort, grpcServer, wg)
 
 	return func() {
-		stopSubscription()
 		taskManager.StopAll(time.Second * 2)
+		if archiver != nil {
+			// archived events are acknowledged through the subscriptions, so they have to be open
+			archiver.Flush()
+		}
+		stopSubscription()
+		closeEventRepository()
+		closeJobRepository()
 		grpcServer.GracefulStop()
+		closeAuditSink()
 	}, wg
 }
 
+func createJobRepository(config *configuration.ArmadaConfig, db redis.UniversalClient, taskManager *task.BackgroundTaskManager) (repository.JobRepository, func()) {
+	switch config.JobDeduplication.Scope {
+	case "", configuration.DeduplicationScopeQueue, configuration.DeduplicationScopeJobSet:
+	default:
+		panic(fmt.Errorf("unknown job deduplication scope %q, supported scopes are %s and %s",
+			config.JobDeduplication.Scope, configuration.DeduplicationScopeQueue, configuration.DeduplicationScopeJobSet))
+	}
+
+	switch config.JobsBackend {
+	case "", "redis":
+		return repository.NewRedisJobRepository(db, config.Scheduling.DefaultJobLimits, config.JobDeduplication), func() {}
+	case "postgres":
+		log.Info("Using Postgres for job storage")
+		jobsDb, err := database.OpenPostgres(config.JobsPostgres.Postgres)
+		if err != nil {
+			panic(err)
+		}
+		jobRepository := repository.NewPostgresJobRepository(goqu.New("postgres", jobsDb), config.Scheduling.DefaultJobLimits, config.JobDeduplication)
+		taskManager.Register(jobRepository.DeleteExpiredJobs, config.JobsPostgres.ExpiryCheckInterval, "postgres_job_expiry")
+
+		return jobRepository, func() {
+			if err := jobsDb.Close(); err != nil {
+				log.Errorf("failed to close postgres connection: %v", err)
+			}
+		}
+	default:
+		panic(fmt.Errorf("unknown jobs backend %q, supported backends are redis and postgres", config.JobsBackend))
+	}
+}
+
+func createEventRepository(config *configuration.ArmadaConfig, eventsDb redis.UniversalClient, taskManager *task.BackgroundTaskManager) (repository.EventRepository, func()) {
+	switch config.EventsBackend {
+	case "", "redis":
+		return repository.NewRedisEventRepository(eventsDb, config.EventRetention), func() {}
+	case "postgres":
+		log.Info("Using Postgres for event storage")
+		db, err := database.OpenPostgres(config.EventsPostgres.Postgres)
+		if err != nil {
+			panic(err)
+		}
+		goquDb := goqu.New("postgres", db)
+		eventRepository := repository.NewPostgresEventRepository(goquDb, config.EventRetention, config.EventsPostgres.PollInterval)
+
+		listener := pq.NewListener(database.CreateConnectionString(config.EventsPostgres.Postgres.Connection), time.Second, time.Minute,
+			func(event pq.ListenerEventType, err error) {
+				if err != nil {
+					log.Errorf("Postgres event listener error: %v", err)
+				}
+			})
+		err = eventRepository.Listen(listener)
+		if err != nil {
+			panic(err)
+		}
+		taskManager.Register(eventRepository.DeleteExpiredEvents, config.EventsPostgres.ExpiryCheckInterval, "postgres_event_expiry")
+
+		return eventRepository, func() {
+			if err := listener.Close(); err != nil {
+				log.Errorf("failed to close postgres listener: %v", err)
+			}
+			if err := db.Close(); err != nil {
+				log.Errorf("failed to close postgres connection: %v", err)
+			}
+		}
+	default:
+		panic(fmt.Errorf("unknown events backend %q, supported backends are redis and postgres", config.EventsBackend))
+	}
+}
+
+func createArchiver(config *configuration.EventArchiveConfig) *archive.Archiver {
+	if err := archive.ValidateFormat(config.Format); err != nil {
+		panic(err)
+	}
+	sink, err := archive.NewSink(config)
+	if err != nil {
+		panic(err)
+	}
+	return archive.NewArchiver(sink, config.Format, config.MaxBatchSize, config.MaxBufferedEvents)
+}
+
+func createAuditSink(config *configuration.AuditConfig) (audit.Sink, func()) {
+	if !config.Enabled {
+		return nil, func() {}
+	}
+	log.Infof("Audit log of api calls is stored in %s sink", config.Sink)
+	sink, closeSink, err := audit.NewSink(config)
+	if err != nil {
+		panic(err)
+	}
+	return sink, func() {
+		if err := closeSink(); err != nil {
+			log.Errorf("failed to close audit sink: %v", err)
+		}
+	}
+}
+
+func warnArchiveUnavailable(archiver *archive.Archiver) {
+	if archiver != nil {
+		log.Warn("Event archive is enabled, but events are archived only when Kafka or NATS is used for events")
+	}
+}
+
 func createRedisClient(config *redis.UniversalOptions) redis.UniversalClient {
 	return redis.NewUniversalClient(config)
 }
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/audit"
+	"github.com/G-Research/armada/internal/armada/permissions"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	grpcCommon "github.com/G-Research/armada/internal/common/grpc"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	defaultAuditLogLimit = 100
+	maxAuditLogLimit     = 10000
+)
+
+// AuditedMethods lists mutating methods of the public api together with extraction of their targets.
+// Methods used by executors are not audited, their calls are frequent and don't reflect actions of users.
+var AuditedMethods = map[string]grpcCommon.AuditTargetExtractor{
+	"/api.Submit/SubmitJobs": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		req := request.(*api.JobSubmitRequest)
+		target := grpcCommon.AuditTarget{Queue: req.Queue, JobSetId: req.JobSetId}
+		if resp, ok := response.(*api.JobSubmitResponse); ok {
+			for _, item := range resp.JobResponseItems {
+				if item.JobId != "" {
+					target.JobIds = append(target.JobIds, item.JobId)
+				}
+			}
+		}
+		return target
+	},
+	"/api.Submit/CancelJobs": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		req := request.(*api.JobCancelRequest)
+		target := grpcCommon.AuditTarget{Queue: req.Queue, JobSetId: req.JobSetId}
+		if resp, ok := response.(*api.CancellationResult); ok {
+			target.JobIds = resp.CancelledIds
+		} else if req.JobId != "" {
+			target.JobIds = []string{req.JobId}
+		}
+		return target
+	},
+	"/api.Submit/ReprioritizeJobs": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		req := request.(*api.JobReprioritizeRequest)
+		target := grpcCommon.AuditTarget{Queue: req.Queue, JobSetId: req.JobSetId, JobIds: req.JobIds}
+		if resp, ok := response.(*api.JobReprioritizeResponse); ok && len(req.JobIds) == 0 {
+			for jobId := range resp.ReprioritizationResults {
+				target.JobIds = append(target.JobIds, jobId)
+			}
+		}
+		return target
+	},
+	"/api.Submit/CloseJobSet": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		req := request.(*api.JobSetCloseRequest)
+		return grpcCommon.AuditTarget{Queue: req.Queue, JobSetId: req.JobSetId}
+	},
+	"/api.Submit/CreateQueue": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		return grpcCommon.AuditTarget{Queue: request.(*api.Queue).Name}
+	},
+	"/api.Submit/UpdateQueue": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		return grpcCommon.AuditTarget{Queue: request.(*api.Queue).Name}
+	},
+	"/api.Submit/DeleteQueue": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		return grpcCommon.AuditTarget{Queue: request.(*api.QueueDeleteRequest).Name}
+	},
+	"/api.Submit/SetQueueRoleBindings": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		return grpcCommon.AuditTarget{Queue: request.(*api.QueueRoleBindingsRequest).Name}
+	},
+	"/api.Notification/CreateSubscription": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		return grpcCommon.AuditTarget{Queue: request.(*api.NotificationSubscription).Queue}
+	},
+	"/api.Notification/DeleteSubscription": func(request interface{}, response interface{}) grpcCommon.AuditTarget {
+		return grpcCommon.AuditTarget{Queue: request.(*api.NotificationSubscriptionDeleteRequest).Queue}
+	},
+	"/api.ApiTokens/CreateApiToken": noAuditTarget,
+	"/api.ApiTokens/RevokeApiToken": noAuditTarget,
+}
+
+func noAuditTarget(request interface{}, response interface{}) grpcCommon.AuditTarget {
+	return grpcCommon.AuditTarget{}
+}
+
+type AuditServer struct {
+	permissions authorization.PermissionChecker
+	sink        audit.Sink
+}
+
+// NewAuditServer creates server querying the sink, sink is nil when audit is disabled
+func NewAuditServer(permissions authorization.PermissionChecker, sink audit.Sink) *AuditServer {
+	return &AuditServer{permissions: permissions, sink: sink}
+}
+
+func (s *AuditServer) GetAuditLog(ctx context.Context, request *api.AuditLogRequest) (*api.AuditLog, error) {
+	if e := checkPermission(s.permissions, ctx, permissions.ViewAuditLog); e != nil {
+		return nil, e
+	}
+
+	queryableSink, ok := s.sink.(audit.QueryableSink)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "Audit log is disabled or stored in sink which can't be queried")
+	}
+
+	if request.Limit <= 0 {
+		request.Limit = defaultAuditLogLimit
+	}
+	if request.Limit > maxAuditLogLimit {
+		request.Limit = maxAuditLogLimit
+	}
+
+	records, e := queryableSink.Query(request)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return &api.AuditLog{Records: records}, nil
+}
@@ -10,41 +10,54 @@ import (
 	"github.com/G-Research/armada/pkg/api"
 
 	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type EventServer struct {
-	permissions     authorization.PermissionChecker
-	eventRepository repository.EventRepository
-	eventStore      repository.EventStore
+	permissions      authorization.PermissionChecker
+	eventRepository  repository.EventRepository
+	eventStore       repository.EventStore
+	queueRepository  repository.QueueRepository
+	executorIdentity *ExecutorIdentityChecker
 }
 
 func NewEventServer(
 	permissions authorization.PermissionChecker,
 	eventRepository repository.EventRepository,
-	eventStore repository.EventStore) *EventServer {
+	eventStore repository.EventStore,
+	queueRepository repository.QueueRepository,
+	executorIdentity *ExecutorIdentityChecker) *EventServer {
 
 	return &EventServer{
-		permissions:     permissions,
-		eventRepository: eventRepository,
-		eventStore:      eventStore}
+		permissions:      permissions,
+		eventRepository:  eventRepository,
+		eventStore:       eventStore,
+		queueRepository:  queueRepository,
+		executorIdentity: executorIdentity}
 }
 
 func (s *EventServer) Report(ctx context.Context, message *api.EventMessage) (*types.Empty, error) {
-	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
-		return nil, e
-	}
-	return &types.Empty{}, s.eventStore.ReportEvents([]*api.EventMessage{message})
+	return s.reportEvents(ctx, []*api.EventMessage{message})
 }
 
 func (s *EventServer) ReportMultiple(ctx context.Context, message *api.EventList) (*types.Empty, error) {
+	return s.reportEvents(ctx, message.Events)
+}
+
+func (s *EventServer) reportEvents(ctx context.Context, messages []*api.EventMessage) (*types.Empty, error) {
 	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
-	return &types.Empty{}, s.eventStore.ReportEvents(message.Events)
+	messages, e := s.executorIdentity.filterEvents(ctx, messages)
+	if e != nil {
+		return nil, e
+	}
+	return &types.Empty{}, s.eventStore.ReportEvents(messages)
 }
 
 func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Event_GetJobSetEventsServer) error {
-	if e := checkPermission(s.permissions, stream.Context(), permissions.WatchAllEvents); e != nil {
+	if e := checkQueueWatchPermission(s.permissions, s.queueRepository, stream.Context(), request.Queue); e != nil {
 		return e
 	}
 
@@ -92,3 +105,74 @@ func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Eve
 		}
 	}
 }
+
+// GetJobSetState returns the current state of jobs in the job set together with the id of the last event included,
+// clients can continue watching the job set from this id instead of replaying the whole stream
+func (s *EventServer) GetJobSetState(ctx context.Context, request *api.JobSetStateRequest) (*api.JobSetState, error) {
+	if e := checkQueueWatchPermission(s.permissions, s.queueRepository, ctx, request.Queue); e != nil {
+		return nil, e
+	}
+
+	snapshot, e := s.eventRepository.GetJobSetSnapshot(request.Queue, request.JobSetId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if snapshot.LastMessageId == "" {
+		lastId, e := s.eventRepository.GetLastMessageId(request.Queue, request.JobSetId)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		if lastId != "0" {
+			// events were reported before snapshots were maintained
+			return nil, status.Errorf(codes.FailedPrecondition, "Snapshot of job set %s is not available, read the job set events instead", request.JobSetId)
+		}
+	}
+	return snapshot, nil
+}
+
+type eventStream interface {
+	Send(*api.EventStreamMessage) error
+	Context() context.Context
+}
+
+func (s *EventServer) WatchQueue(request *api.WatchQueueRequest, stream api.Event_WatchQueueServer) error {
+	return s.watchQueue(request.Queue, request.FromMessageId, &api.EventFilter{}, stream)
+}
+
+func (s *EventServer) WatchQueueFiltered(request *api.WatchQueueFilteredRequest, stream api.Event_WatchQueueFilteredServer) error {
+	filter, e := api.NewEventFilter(request.JobSetPatterns, request.EventTypes)
+	if e != nil {
+		return status.Errorf(codes.InvalidArgument, e.Error())
+	}
+	return s.watchQueue(request.Queue, request.FromMessageId, filter, stream)
+}
+
+func (s *EventServer) watchQueue(queue string, fromId string, filter *api.EventFilter, stream eventStream) error {
+	if e := checkQueueWatchPermission(s.permissions, s.queueRepository, stream.Context(), queue); e != nil {
+		return e
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		default:
+		}
+
+		messages, e := s.eventRepository.ReadQueueEvents(queue, fromId, 500, 5*time.Second)
+		if e != nil {
+			return e
+		}
+
+		for _, msg := range messages {
+			fromId = msg.Id
+			if !filter.Matches(msg.Message) {
+				continue
+			}
+			e = stream.Send(msg)
+			if e != nil {
+				return e
+			}
+		}
+	}
+}
@@ -76,6 +76,78 @@ func TestEventServer_EventsShouldBeRemovedAfterEventRetentionTime(t *testing.T)
 	})
 }
 
+func TestEventServer_WatchQueue(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue", JobSetId: "set1"})
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue", JobSetId: "set2"})
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "other-queue", JobSetId: "set1"})
+		reportEvent(t, s, &api.JobFailedEvent{Queue: "queue", JobSetId: "set1"})
+
+		stream := newQueueEventStreamMock(3)
+		e := s.WatchQueue(&api.WatchQueueRequest{Queue: "queue"}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 3, len(stream.sendMessages))
+
+		resumedStream := newQueueEventStreamMock(1)
+		e = s.WatchQueue(&api.WatchQueueRequest{Queue: "queue", FromMessageId: stream.sendMessages[1].Id}, resumedStream)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(resumedStream.sendMessages))
+		assert.Equal(t, stream.sendMessages[2].Id, resumedStream.sendMessages[0].Id)
+	})
+}
+
+func TestEventServer_WatchQueueFiltered(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue", JobSetId: "nightly-1"})
+		reportEvent(t, s, &api.JobFailedEvent{Queue: "queue", JobSetId: "other"})
+		reportEvent(t, s, &api.JobFailedEvent{Queue: "queue", JobSetId: "nightly-2"})
+
+		stream := newQueueEventStreamMock(1)
+		e := s.WatchQueueFiltered(&api.WatchQueueFilteredRequest{
+			Queue:          "queue",
+			JobSetPatterns: []string{"nightly-*"},
+			EventTypes:     []string{"JobFailedEvent"},
+		}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(stream.sendMessages))
+		assert.Equal(t, "nightly-2", stream.sendMessages[0].Message.GetFailed().JobSetId)
+	})
+}
+
+func TestEventServer_GetJobSetState(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue", JobSetId: "set", JobId: "job1"})
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue", JobSetId: "set", JobId: "job2"})
+		reportEvent(t, s, &api.JobLeasedEvent{Queue: "queue", JobSetId: "set", JobId: "job1", ClusterId: "cluster"})
+		reportEvent(t, s, &api.JobRunningEvent{Queue: "queue", JobSetId: "set", JobId: "job1", ClusterId: "cluster"})
+		reportEvent(t, s, &api.JobUtilisationEvent{Queue: "queue", JobSetId: "set", JobId: "job1", ClusterId: "cluster"})
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue", JobSetId: "other", JobId: "job3"})
+
+		state, e := s.GetJobSetState(context.Background(), &api.JobSetStateRequest{Queue: "queue", JobSetId: "set"})
+		assert.Nil(t, e)
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Queue: "queue", Id: "set", Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 5, len(stream.sendMessages))
+
+		assert.Equal(t, stream.sendMessages[4].Id, state.LastMessageId)
+		assert.Equal(t, []*api.JobState{
+			{JobId: "job1", Status: api.JobStatus_Running, ClusterId: "cluster", LastMessageId: stream.sendMessages[4].Id},
+			{JobId: "job2", Status: api.JobStatus_Submitted, LastMessageId: stream.sendMessages[1].Id},
+		}, state.Jobs)
+	})
+}
+
+func TestEventServer_GetJobSetState_EmptyJobSet(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		state, e := s.GetJobSetState(context.Background(), &api.JobSetStateRequest{Queue: "queue", JobSetId: "set"})
+		assert.Nil(t, e)
+		assert.Equal(t, "", state.LastMessageId)
+		assert.Empty(t, state.Jobs)
+	})
+}
+
 func reportEvent(t *testing.T, s *EventServer, event api.Event) {
 	msg, _ := api.Wrap(event)
 	_, e := s.Report(context.Background(), msg)
@@ -88,7 +160,12 @@ func withEventServer(eventRetention configuration.EventRetentionPolicy, action f
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 
 	repo := repository.NewRedisEventRepository(client, eventRetention)
-	server := NewEventServer(&FakePermissionChecker{}, repo, repo)
+	server := NewEventServer(
+		&FakePermissionChecker{},
+		repo,
+		repo,
+		repository.NewRedisQueueRepository(client),
+		NewExecutorIdentityChecker(configuration.ExecutorIdentityConfig{}, nil, nil))
 
 	client.FlushDB()
 
@@ -111,3 +188,29 @@ func (s *eventStreamMock) Context() context.Context {
 	return context.Background()
 
 }
+
+// Stream which is closed after receiving expected number of messages, or after timeout
+type queueEventStreamMock struct {
+	grpc.ServerStream
+	expectedMessages int
+	sendMessages     []*api.EventStreamMessage
+	ctx              context.Context
+	cancel           context.CancelFunc
+}
+
+func newQueueEventStreamMock(expectedMessages int) *queueEventStreamMock {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	return &queueEventStreamMock{expectedMessages: expectedMessages, ctx: ctx, cancel: cancel}
+}
+
+func (s *queueEventStreamMock) Send(m *api.EventStreamMessage) error {
+	s.sendMessages = append(s.sendMessages, m)
+	if len(s.sendMessages) >= s.expectedMessages {
+		s.cancel()
+	}
+	return nil
+}
+
+func (s *queueEventStreamMock) Context() context.Context {
+	return s.ctx
+}
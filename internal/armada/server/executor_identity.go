@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/audit"
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	grpcCommon "github.com/G-Research/armada/internal/common/grpc"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// ExecutorIdentityChecker binds executor principals to clusters and pools, so an executor can act only for its own
+// clusters and only for jobs leased to them. Rejections are logged and written to the audit sink.
+// All checks pass when the binding is disabled.
+type ExecutorIdentityChecker struct {
+	config        configuration.ExecutorIdentityConfig
+	jobRepository repository.JobRepository
+	auditSink     audit.Sink
+}
+
+// NewExecutorIdentityChecker creates checker, auditSink is nil when audit is disabled
+func NewExecutorIdentityChecker(
+	config configuration.ExecutorIdentityConfig,
+	jobRepository repository.JobRepository,
+	auditSink audit.Sink) *ExecutorIdentityChecker {
+
+	return &ExecutorIdentityChecker{config: config, jobRepository: jobRepository, auditSink: auditSink}
+}
+
+// checkCluster rejects calls on behalf of clusters the principal is not bound to
+func (c *ExecutorIdentityChecker) checkCluster(ctx context.Context, clusterId string) error {
+	if !c.config.Enabled {
+		return nil
+	}
+	principal := authorization.GetPrincipal(ctx).GetName()
+	binding, bound := c.config.Principals[principal]
+	if !bound || !util.ContainsString(binding.ClusterIds, clusterId) {
+		return c.reject(ctx, grpcCommon.AuditTarget{}, "Principal %s can't act for cluster %s", principal, clusterId)
+	}
+	return nil
+}
+
+// checkLease rejects leasing for clusters and pools the principal is not bound to
+func (c *ExecutorIdentityChecker) checkLease(ctx context.Context, clusterId string, pool string) error {
+	if e := c.checkCluster(ctx, clusterId); e != nil || !c.config.Enabled {
+		return e
+	}
+	principal := authorization.GetPrincipal(ctx).GetName()
+	pools := c.config.Principals[principal].Pools
+	if len(pools) > 0 && !util.ContainsString(pools, pool) {
+		return c.reject(ctx, grpcCommon.AuditTarget{}, "Principal %s can't lease jobs for pool %s", principal, pool)
+	}
+	return nil
+}
+
+// isLeasedToCluster checks that the job is leased to the cluster, jobs which are not are audited
+func (c *ExecutorIdentityChecker) isLeasedToCluster(ctx context.Context, jobId string, clusterId string) (bool, error) {
+	if !c.config.Enabled {
+		return true, nil
+	}
+	leasedClusters, e := c.jobRepository.GetLeasedClusterIds([]string{jobId})
+	if e != nil {
+		return false, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if leasedClusters[jobId] != clusterId {
+		c.reject(ctx, grpcCommon.AuditTarget{JobIds: []string{jobId}}, "Job %s is not leased to cluster %s", jobId, clusterId)
+		return false, nil
+	}
+	return true, nil
+}
+
+// filterLeasedJobIds returns ids of jobs leased to clusters the principal is bound to, other ids are audited and omitted
+func (c *ExecutorIdentityChecker) filterLeasedJobIds(ctx context.Context, jobIds []string) ([]string, error) {
+	if !c.config.Enabled {
+		return jobIds, nil
+	}
+	leasedClusters, e := c.jobRepository.GetLeasedClusterIds(jobIds)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	binding := c.config.Principals[authorization.GetPrincipal(ctx).GetName()]
+	allowed := []string{}
+	rejected := []string{}
+	for _, jobId := range jobIds {
+		clusterId, leased := leasedClusters[jobId]
+		if leased && util.ContainsString(binding.ClusterIds, clusterId) {
+			allowed = append(allowed, jobId)
+		} else {
+			rejected = append(rejected, jobId)
+		}
+	}
+	if len(rejected) > 0 {
+		c.reject(ctx, grpcCommon.AuditTarget{JobIds: rejected}, "Jobs are not leased to clusters of the principal")
+	}
+	return allowed, nil
+}
+
+// filterEvents rejects events of clusters the principal is not bound to, and omits events of jobs which are not leased
+// to the cluster of the event. Events of jobs which are not leased any more (finished or returned) are accepted only from
+// clusters the job was leased to before, events of jobs currently leased to another cluster or never leased are omitted.
+func (c *ExecutorIdentityChecker) filterEvents(ctx context.Context, messages []*api.EventMessage) ([]*api.EventMessage, error) {
+	if !c.config.Enabled {
+		return messages, nil
+	}
+
+	events := make([]api.Event, 0, len(messages))
+	clusterIds := make([]string, 0, len(messages))
+	jobIds := make([]string, 0, len(messages))
+	for _, message := range messages {
+		event, e := api.UnwrapEvent(message)
+		if e != nil {
+			return nil, status.Errorf(codes.InvalidArgument, e.Error())
+		}
+		clusterEvent, ok := event.(interface{ GetClusterId() string })
+		if !ok {
+			target := grpcCommon.AuditTarget{Queue: event.GetQueue(), JobSetId: event.GetJobSetId(), JobIds: []string{event.GetJobId()}}
+			return nil, c.reject(ctx, target, "Executors can't report %T", event)
+		}
+		if e := c.checkCluster(ctx, clusterEvent.GetClusterId()); e != nil {
+			return nil, e
+		}
+		events = append(events, event)
+		clusterIds = append(clusterIds, clusterEvent.GetClusterId())
+		jobIds = append(jobIds, event.GetJobId())
+	}
+
+	leasedClusters, e := c.jobRepository.GetLeasedClusterIds(jobIds)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	leaseHistory, e := c.jobRepository.GetLeaseHistory(jobIds)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	accepted := make([]*api.EventMessage, 0, len(messages))
+	for i, message := range messages {
+		jobId, clusterId := jobIds[i], clusterIds[i]
+		target := grpcCommon.AuditTarget{Queue: events[i].GetQueue(), JobSetId: events[i].GetJobSetId(), JobIds: []string{jobId}}
+		leasedCluster, leased := leasedClusters[jobId]
+		if leased && leasedCluster != clusterId {
+			c.reject(ctx, target, "Job %s is leased to cluster %s, not to %s", jobId, leasedCluster, clusterId)
+			continue
+		}
+		if !leased && !util.ContainsString(leaseHistory[jobId], clusterId) {
+			c.reject(ctx, target, "Job %s was not leased to cluster %s", jobId, clusterId)
+			continue
+		}
+		accepted = append(accepted, message)
+	}
+	return accepted, nil
+}
+
+func (c *ExecutorIdentityChecker) reject(ctx context.Context, target grpcCommon.AuditTarget, format string, args ...interface{}) error {
+	err := status.Errorf(codes.PermissionDenied, format, args...)
+	method, _ := grpc.Method(ctx)
+	principal := authorization.GetPrincipal(ctx).GetName()
+	log.Warnf("Rejected %s by executor %s: %s", method, principal, status.Convert(err).Message())
+	if c.auditSink != nil {
+		grpcCommon.WriteAuditRecord(c.auditSink, grpcCommon.NewAuditRecord(ctx, method, err, target))
+	}
+	return err
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var testExecutorIdentity = configuration.ExecutorIdentityConfig{
+	Enabled: true,
+	Principals: map[string]configuration.ExecutorBinding{
+		"executor-1": {ClusterIds: []string{"cluster-1"}, Pools: []string{"cpu"}},
+	},
+}
+
+type recordingAuditSink struct {
+	records []*api.AuditRecord
+}
+
+func (s *recordingAuditSink) Write(record *api.AuditRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func TestExecutorIdentityChecker_ChecksClusterAndPool(t *testing.T) {
+	sink := &recordingAuditSink{}
+	checker := NewExecutorIdentityChecker(testExecutorIdentity, newMockJobRepository(), sink)
+	ctx := executorContext("executor-1")
+
+	assert.Nil(t, checker.checkLease(ctx, "cluster-1", "cpu"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(checker.checkLease(ctx, "cluster-1", "gpu")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(checker.checkCluster(ctx, "cluster-2")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(checker.checkCluster(executorContext("executor-2"), "cluster-1")))
+
+	assert.Len(t, sink.records, 3)
+	assert.Equal(t, "executor-1", sink.records[0].Principal)
+	assert.Equal(t, codes.PermissionDenied.String(), sink.records[0].Code)
+}
+
+func TestExecutorIdentityChecker_DisabledAllowsEverything(t *testing.T) {
+	checker := NewExecutorIdentityChecker(configuration.ExecutorIdentityConfig{}, nil, nil)
+	ctx := executorContext("anyone")
+
+	assert.Nil(t, checker.checkLease(ctx, "cluster-1", "gpu"))
+	jobIds, e := checker.filterLeasedJobIds(ctx, []string{"job-1"})
+	assert.Nil(t, e)
+	assert.Equal(t, []string{"job-1"}, jobIds)
+}
+
+func TestExecutorIdentityChecker_FilterEvents(t *testing.T) {
+	jobRepository := newMockJobRepository()
+	jobRepository.leasedClusters["job-1"] = "cluster-1"
+	jobRepository.leasedClusters["job-2"] = "cluster-2"
+	jobRepository.leaseHistory["job-3"] = []string{"cluster-1"}
+	jobRepository.leaseHistory["job-4"] = []string{"cluster-2"}
+	sink := &recordingAuditSink{}
+	checker := NewExecutorIdentityChecker(testExecutorIdentity, jobRepository, sink)
+	ctx := executorContext("executor-1")
+
+	leasedToCluster := wrapEvent(&api.JobRunningEvent{JobId: "job-1", ClusterId: "cluster-1"})
+	leasedToOtherCluster := wrapEvent(&api.JobRunningEvent{JobId: "job-2", ClusterId: "cluster-1"})
+	leasedBefore := wrapEvent(&api.JobLeaseReturnedEvent{JobId: "job-3", ClusterId: "cluster-1"})
+	leasedBeforeToOtherCluster := wrapEvent(&api.JobSucceededEvent{JobId: "job-4", ClusterId: "cluster-1"})
+	neverLeased := wrapEvent(&api.JobFailedEvent{JobId: "job-5", ClusterId: "cluster-1"})
+
+	accepted, e := checker.filterEvents(ctx,
+		[]*api.EventMessage{leasedToCluster, leasedToOtherCluster, leasedBefore, leasedBeforeToOtherCluster, neverLeased})
+	assert.Nil(t, e)
+	assert.Equal(t, []*api.EventMessage{leasedToCluster, leasedBefore}, accepted)
+	assert.Len(t, sink.records, 3)
+	assert.Equal(t, []string{"job-2"}, sink.records[0].JobIds)
+	assert.Equal(t, []string{"job-4"}, sink.records[1].JobIds)
+	assert.Equal(t, []string{"job-5"}, sink.records[2].JobIds)
+
+	_, e = checker.filterEvents(ctx, []*api.EventMessage{wrapEvent(&api.JobRunningEvent{JobId: "job-2", ClusterId: "cluster-2"})})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+
+	_, e = checker.filterEvents(ctx, []*api.EventMessage{wrapEvent(&api.JobCancelledEvent{JobId: "job-1"})})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+}
+
+func TestAggregatedQueueServer_ReportDone_DeletesOnlyJobsLeasedToBoundClusters(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueServer := makeAggregatedQueueServerWithExecutorIdentity(5, testExecutorIdentity)
+	job1 := &api.Job{Id: "job-1"}
+	job2 := &api.Job{Id: "job-2"}
+	_, e := mockJobRepository.AddJobs([]*api.Job{job1, job2})
+	assert.Nil(t, e)
+	mockJobRepository.leasedClusters["job-1"] = "cluster-1"
+	mockJobRepository.leasedClusters["job-2"] = "cluster-2"
+
+	_, e = aggregatedQueueServer.ReportDone(executorContext("executor-1"), &api.IdList{Ids: []string{"job-1", "job-2"}})
+	assert.Nil(t, e)
+	assert.Equal(t, []*api.Job{job1}, mockJobRepository.deleteJobsArg)
+}
+
+func TestAggregatedQueueServer_ReturnLease_ChecksExecutorIdentity(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueServer := makeAggregatedQueueServerWithExecutorIdentity(5, testExecutorIdentity)
+	_, e := mockJobRepository.AddJobs([]*api.Job{{Id: "job-1"}})
+	assert.Nil(t, e)
+	mockJobRepository.leasedClusters["job-1"] = "cluster-2"
+
+	_, e = aggregatedQueueServer.ReturnLease(executorContext("executor-1"), &api.ReturnLeaseRequest{ClusterId: "cluster-2", JobId: "job-1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+
+	_, e = aggregatedQueueServer.ReturnLease(executorContext("executor-1"), &api.ReturnLeaseRequest{ClusterId: "cluster-1", JobId: "job-1"})
+	assert.Nil(t, e)
+	assert.Equal(t, 0, mockJobRepository.returnLeaseCalls)
+	assert.Equal(t, 0, mockJobRepository.jobRetries["job-1"])
+
+	mockJobRepository.leasedClusters["job-1"] = "cluster-1"
+	_, e = aggregatedQueueServer.ReturnLease(executorContext("executor-1"), &api.ReturnLeaseRequest{ClusterId: "cluster-1", JobId: "job-1"})
+	assert.Nil(t, e)
+	assert.Equal(t, 1, mockJobRepository.returnLeaseCalls)
+}
+
+func executorContext(principal string) context.Context {
+	return authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal(principal, []string{}))
+}
+
+func wrapEvent(event api.Event) *api.EventMessage {
+	message, _ := api.Wrap(event)
+	return message
+}
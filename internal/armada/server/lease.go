@@ -26,6 +26,7 @@ type AggregatedQueueServer struct {
 	usageRepository          repository.UsageRepository
 	eventStore               repository.EventStore
 	schedulingInfoRepository repository.SchedulingInfoRepository
+	executorIdentity         *ExecutorIdentityChecker
 }
 
 func NewAggregatedQueueServer(
@@ -37,6 +38,7 @@ func NewAggregatedQueueServer(
 	usageRepository repository.UsageRepository,
 	eventStore repository.EventStore,
 	schedulingInfoRepository repository.SchedulingInfoRepository,
+	executorIdentity *ExecutorIdentityChecker,
 ) *AggregatedQueueServer {
 	return &AggregatedQueueServer{
 		permissions:              permissions,
@@ -46,13 +48,20 @@ func NewAggregatedQueueServer(
 		queueRepository:          queueRepository,
 		usageRepository:          usageRepository,
 		eventStore:               eventStore,
-		schedulingInfoRepository: schedulingInfoRepository}
+		schedulingInfoRepository: schedulingInfoRepository,
+		executorIdentity:         executorIdentity}
 }
 
 func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.LeaseRequest) (*api.JobLease, error) {
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
+	if e := q.executorIdentity.checkLease(ctx, request.ClusterId, request.Pool); e != nil {
+		return nil, e
+	}
+	if e := q.executorIdentity.checkCluster(ctx, request.ClusterLeasedReport.ClusterId); e != nil {
+		return nil, e
+	}
 
 	var res common.ComputeResources = request.Resources
 	if res.AsFloat().IsLessThan(q.schedulingConfig.MinimumResourceToSchedule) {
@@ -131,6 +140,9 @@ func (q *AggregatedQueueServer) RenewLease(ctx context.Context, request *api.Ren
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
+	if e := q.executorIdentity.checkCluster(ctx, request.ClusterId); e != nil {
+		return nil, e
+	}
 	renewed, e := q.jobRepository.RenewLease(request.ClusterId, request.Ids)
 	return &api.IdList{renewed}, e
 }
@@ -139,6 +151,17 @@ func (q *AggregatedQueueServer) ReturnLease(ctx context.Context, request *api.Re
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
+	if e := q.executorIdentity.checkCluster(ctx, request.ClusterId); e != nil {
+		return nil, e
+	}
+	leased, e := q.executorIdentity.isLeasedToCluster(ctx, request.JobId, request.ClusterId)
+	if e != nil {
+		return nil, e
+	}
+	if !leased {
+		// Returning lease of a job not leased to the cluster has no effect and doesn't count as retry
+		return &types.Empty{}, nil
+	}
 
 	// Check how many times the same job has been retried already
 	retries, err := q.jobRepository.GetNumberOfRetryAttempts(request.JobId)
@@ -154,7 +177,7 @@ func (q *AggregatedQueueServer) ReturnLease(ctx context.Context, request *api.Re
 			return nil, err
 		}
 
-		_, err := q.ReportDone(ctx, &api.IdList{Ids: []string{request.JobId}})
+		_, err := q.reportDone([]string{request.JobId})
 		if err != nil {
 			return nil, err
 		}
@@ -179,7 +202,15 @@ func (q *AggregatedQueueServer) ReportDone(ctx context.Context, idList *api.IdLi
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
-	jobs, e := q.jobRepository.GetExistingJobsByIds(idList.Ids)
+	jobIds, e := q.executorIdentity.filterLeasedJobIds(ctx, idList.Ids)
+	if e != nil {
+		return nil, e
+	}
+	return q.reportDone(jobIds)
+}
+
+func (q *AggregatedQueueServer) reportDone(jobIds []string) (*api.IdList, error) {
+	jobs, e := q.jobRepository.GetExistingJobsByIds(jobIds)
 	if e != nil {
 		return nil, status.Errorf(codes.Internal, e.Error())
 	}
@@ -110,6 +110,13 @@ func TestAggregatedQueueServer_ReturningLeaseMoreThanMaxRetriesSendsJobFailedEve
 }
 
 func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobRepository, *fakeEventStore, *AggregatedQueueServer) {
+	return makeAggregatedQueueServerWithExecutorIdentity(maxRetries, configuration.ExecutorIdentityConfig{})
+}
+
+func makeAggregatedQueueServerWithExecutorIdentity(
+	maxRetries uint,
+	executorIdentity configuration.ExecutorIdentityConfig) (*mockJobRepository, *fakeEventStore, *AggregatedQueueServer) {
+
 	mockJobRepository := newMockJobRepository()
 	fakeEventStore := &fakeEventStore{}
 	fakeQueueRepository := &fakeQueueRepository{}
@@ -124,12 +131,15 @@ func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobReposito
 		fakeQueueRepository,
 		&fakeUsageRepository{},
 		fakeEventStore,
-		fakeSchedulingInfoRepository)
+		fakeSchedulingInfoRepository,
+		NewExecutorIdentityChecker(executorIdentity, mockJobRepository, nil))
 }
 
 type mockJobRepository struct {
-	jobs       map[string]*api.Job
-	jobRetries map[string]int
+	jobs           map[string]*api.Job
+	jobRetries     map[string]int
+	leasedClusters map[string]string
+	leaseHistory   map[string][]string
 
 	returnLeaseCalls int
 	deleteJobsCalls  int
@@ -143,6 +153,8 @@ func newMockJobRepository() *mockJobRepository {
 	return &mockJobRepository{
 		jobs:             make(map[string]*api.Job),
 		jobRetries:       make(map[string]int),
+		leasedClusters:   make(map[string]string),
+		leaseHistory:     make(map[string][]string),
 		returnLeaseCalls: 0,
 		deleteJobsCalls:  0,
 		returnLeaseArg1:  "",
@@ -214,6 +226,16 @@ func (repo *mockJobRepository) GetActiveJobIds(queue string, jobSetId string) ([
 	return []string{}, nil
 }
 
+func (repo *mockJobRepository) GetLeaseHistory(jobIds []string) (map[string][]string, error) {
+	history := map[string][]string{}
+	for _, jobId := range jobIds {
+		if clusterIds, leased := repo.leaseHistory[jobId]; leased {
+			history[jobId] = clusterIds
+		}
+	}
+	return history, nil
+}
+
 func (repo *mockJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
 	return []*api.JobSetInfo{}, nil
 }
@@ -263,6 +285,16 @@ func (repo *mockJobRepository) GetJobRunInfos(jobIds []string) (map[string]*repo
 	return map[string]*repository.RunInfo{}, nil
 }
 
+func (repo *mockJobRepository) GetLeasedClusterIds(jobIds []string) (map[string]string, error) {
+	leasedClusters := map[string]string{}
+	for _, jobId := range jobIds {
+		if clusterId, leased := repo.leasedClusters[jobId]; leased {
+			leasedClusters[jobId] = clusterId
+		}
+	}
+	return leasedClusters, nil
+}
+
 type fakeQueueRepository struct{}
 
 func (repo *fakeQueueRepository) GetAllQueues() ([]*api.Queue, error) {
@@ -277,6 +309,10 @@ func (repo *fakeQueueRepository) CreateQueue(queue *api.Queue) error {
 	return nil
 }
 
+func (repo *fakeQueueRepository) UpdateQueue(queue *api.Queue) error {
+	return nil
+}
+
 func (repo *fakeQueueRepository) DeleteQueue(name string) error {
 	return nil
 }
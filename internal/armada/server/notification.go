@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/notification"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const defaultDeadLettersLimit = 100
+
+type NotificationServer struct {
+	permissions            authorization.PermissionChecker
+	notificationRepository repository.NotificationRepository
+	queueRepository        repository.QueueRepository
+	urlPolicy              *notification.UrlPolicy
+}
+
+func NewNotificationServer(
+	permissions authorization.PermissionChecker,
+	notificationRepository repository.NotificationRepository,
+	queueRepository repository.QueueRepository,
+	urlPolicy *notification.UrlPolicy) *NotificationServer {
+
+	return &NotificationServer{
+		permissions:            permissions,
+		notificationRepository: notificationRepository,
+		queueRepository:        queueRepository,
+		urlPolicy:              urlPolicy}
+}
+
+// Users who can watch events of a queue can subscribe to notifications of its events
+func (s *NotificationServer) CreateSubscription(ctx context.Context, subscription *api.NotificationSubscription) (*api.NotificationSubscription, error) {
+	if e := checkQueueWatchPermission(s.permissions, s.queueRepository, ctx, subscription.Queue); e != nil {
+		return nil, e
+	}
+
+	if e := s.urlPolicy.CheckUrl(subscription.Url); e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+	if _, e := api.NewEventFilter(subscription.JobSetPatterns, subscription.EventTypes); e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+
+	subscription.Id = util.NewULID()
+	subscription.Owner = authorization.GetPrincipal(ctx).GetName()
+
+	e := s.notificationRepository.CreateSubscription(subscription)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return withoutSecret(subscription), nil
+}
+
+func (s *NotificationServer) GetSubscriptions(ctx context.Context, request *api.NotificationSubscriptionsRequest) (*api.NotificationSubscriptionList, error) {
+	if e := checkQueueWatchPermission(s.permissions, s.queueRepository, ctx, request.Queue); e != nil {
+		return nil, e
+	}
+
+	subscriptions, e := s.notificationRepository.GetSubscriptions(request.Queue)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	for i, subscription := range subscriptions {
+		subscriptions[i] = withoutSecret(subscription)
+	}
+	return &api.NotificationSubscriptionList{Subscriptions: subscriptions}, nil
+}
+
+// Subscriptions can be deleted by their owners and by admins of the queue
+func (s *NotificationServer) DeleteSubscription(ctx context.Context, request *api.NotificationSubscriptionDeleteRequest) (*types.Empty, error) {
+	if e := checkQueueWatchPermission(s.permissions, s.queueRepository, ctx, request.Queue); e != nil {
+		return nil, e
+	}
+
+	subscriptions, e := s.notificationRepository.GetSubscriptions(request.Queue)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	var subscription *api.NotificationSubscription
+	for _, existing := range subscriptions {
+		if existing.Id == request.Id {
+			subscription = existing
+		}
+	}
+	if subscription == nil {
+		return nil, status.Errorf(codes.NotFound, "Subscription %q of queue %q not found", request.Id, request.Queue)
+	}
+
+	if subscription.Owner != authorization.GetPrincipal(ctx).GetName() {
+		queue, e := s.queueRepository.GetQueue(request.Queue)
+		if e == repository.ErrQueueNotFound {
+			return nil, status.Errorf(codes.NotFound, "Queue %q not found", request.Queue)
+		} else if e != nil {
+			return nil, status.Errorf(codes.Unavailable, "Could not load queue %q: %s", request.Queue, e.Error())
+		}
+		if e := checkQueueAdminPermission(s.permissions, ctx, queue); e != nil {
+			return nil, e
+		}
+	}
+
+	deleted, e := s.notificationRepository.DeleteSubscription(request.Queue, request.Id)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "Subscription %q of queue %q not found", request.Id, request.Queue)
+	}
+	return &types.Empty{}, nil
+}
+
+func (s *NotificationServer) GetDeadLetters(ctx context.Context, request *api.NotificationDeadLettersRequest) (*api.NotificationDeadLetterList, error) {
+	if e := checkQueueWatchPermission(s.permissions, s.queueRepository, ctx, request.Queue); e != nil {
+		return nil, e
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultDeadLettersLimit
+	}
+	deadLetters, e := s.notificationRepository.GetDeadLetters(request.Queue, limit)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return &api.NotificationDeadLetterList{DeadLetters: deadLetters}, nil
+}
+
+func withoutSecret(subscription *api.NotificationSubscription) *api.NotificationSubscription {
+	result := *subscription
+	result.Secret = ""
+	return &result
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/notification"
+	"github.com/G-Research/armada/internal/armada/permissions"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/auth/permission"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestNotificationServer_CreateAndGetSubscriptions(t *testing.T) {
+	withNotificationServer(func(s *NotificationServer) {
+		created, e := s.CreateSubscription(context.Background(), &api.NotificationSubscription{
+			Queue:      "queue",
+			EventTypes: []string{"JobFailedEvent"},
+			Url:        "https://example.com/hook",
+			Secret:     "secret",
+		})
+		assert.NoError(t, e)
+		assert.NotEmpty(t, created.Id)
+		assert.Equal(t, "anonymous", created.Owner)
+		assert.Empty(t, created.Secret)
+
+		list, e := s.GetSubscriptions(context.Background(), &api.NotificationSubscriptionsRequest{Queue: "queue"})
+		assert.NoError(t, e)
+		assert.Equal(t, []*api.NotificationSubscription{created}, list.Subscriptions)
+
+		stored, e := s.notificationRepository.GetSubscriptions("queue")
+		assert.NoError(t, e)
+		assert.Equal(t, "secret", stored[0].Secret)
+	})
+}
+
+func TestNotificationServer_CreateSubscription_Invalid(t *testing.T) {
+	withNotificationServer(func(s *NotificationServer) {
+		_, e := s.CreateSubscription(context.Background(), &api.NotificationSubscription{Queue: "queue", Url: "not a url"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+
+		_, e = s.CreateSubscription(context.Background(), &api.NotificationSubscription{
+			Queue:      "queue",
+			Url:        "https://example.com/hook",
+			EventTypes: []string{"JobExplodedEvent"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+	})
+}
+
+func TestNotificationServer_DeleteSubscription(t *testing.T) {
+	withNotificationServer(func(s *NotificationServer) {
+		created, e := s.CreateSubscription(context.Background(), &api.NotificationSubscription{Queue: "queue", Url: "https://example.com/hook"})
+		assert.NoError(t, e)
+
+		_, e = s.DeleteSubscription(context.Background(), &api.NotificationSubscriptionDeleteRequest{Queue: "queue", Id: created.Id})
+		assert.NoError(t, e)
+
+		_, e = s.DeleteSubscription(context.Background(), &api.NotificationSubscriptionDeleteRequest{Queue: "queue", Id: created.Id})
+		assert.Equal(t, codes.NotFound, status.Code(e))
+	})
+}
+
+func TestNotificationServer_DeleteSubscription_OfOtherUserRequiresQueueAdmin(t *testing.T) {
+	withNotificationServer(func(s *NotificationServer) {
+		subscription := &api.NotificationSubscription{Id: "other-subscription", Queue: "queue", Url: "https://example.com/hook", Owner: "other-user"}
+		assert.NoError(t, s.notificationRepository.CreateSubscription(subscription))
+		request := &api.NotificationSubscriptionDeleteRequest{Queue: "queue", Id: subscription.Id}
+
+		s.permissions = watchAllEventsPermissionChecker{}
+		_, e := s.DeleteSubscription(context.Background(), request)
+		assert.Equal(t, codes.PermissionDenied, status.Code(e))
+
+		s.permissions = FakePermissionChecker{}
+		_, e = s.DeleteSubscription(context.Background(), request)
+		assert.NoError(t, e)
+	})
+}
+
+// watchAllEventsPermissionChecker grants only watch_all_events, its users can watch all queues but administer none
+type watchAllEventsPermissionChecker struct {
+	denyingPermissionChecker
+}
+
+func (watchAllEventsPermissionChecker) UserHasPermission(ctx context.Context, perm permission.Permission) bool {
+	return perm == permissions.WatchAllEvents
+}
+
+func withNotificationServer(action func(s *NotificationServer)) {
+	db, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: db.Addr()})
+	queueRepository := repository.NewRedisQueueRepository(redisClient)
+	err = queueRepository.CreateQueue(&api.Queue{Name: "queue"})
+	if err != nil {
+		panic(err)
+	}
+
+	action(NewNotificationServer(&FakePermissionChecker{}, repository.NewRedisNotificationRepository(redisClient), queueRepository,
+		notification.NewUrlPolicy(&configuration.NotificationConfig{AllowedHosts: []string{"example.com"}})))
+}
@@ -6,13 +6,88 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/G-Research/armada/internal/armada/permissions"
+	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/common/auth/permission"
+	"github.com/G-Research/armada/pkg/api"
 )
 
+// Roles on a queue granting the same rights as the basic permission grants on owned queues
+var queueRolesByPermission = map[permission.Permission]api.QueueRole{
+	permissions.WatchEvents:      api.QueueRole_Viewer,
+	permissions.SubmitJobs:       api.QueueRole_Submitter,
+	permissions.CancelJobs:       api.QueueRole_Operator,
+	permissions.ReprioritizeJobs: api.QueueRole_Operator,
+}
+
 func checkPermission(p authorization.PermissionChecker, ctx context.Context, permission permission.Permission) error {
 	if !p.UserHasPermission(ctx, permission) {
 		return status.Errorf(codes.PermissionDenied, "User have no permission: %s", permission)
 	}
 	return nil
 }
+
+// Users can watch events of queues they own with watch_events permission, of queues they are viewers of,
+// and of any queue with watch_all_events
+func checkQueueWatchPermission(p authorization.PermissionChecker, queueRepository repository.QueueRepository, ctx context.Context, queueName string) error {
+	if p.UserHasPermission(ctx, permissions.WatchAllEvents) {
+		return nil
+	}
+
+	queue, e := queueRepository.GetQueue(queueName)
+	if e == repository.ErrQueueNotFound {
+		return status.Errorf(codes.NotFound, "Queue %q not found", queueName)
+	} else if e != nil {
+		return status.Errorf(codes.Unavailable, "Could not load queue %q: %s", queueName, e.Error())
+	}
+
+	if !canWatchQueue(p, ctx, queue) {
+		return status.Errorf(codes.PermissionDenied,
+			"User can't watch queue %q: it requires owning the queue with permission %s, viewer role of the queue or permission %s",
+			queueName, permissions.WatchEvents, permissions.WatchAllEvents)
+	}
+	return nil
+}
+
+func canWatchQueue(p authorization.PermissionChecker, ctx context.Context, queue *api.Queue) bool {
+	if p.UserHasPermission(ctx, permissions.WatchAllEvents) {
+		return true
+	}
+	if granted, _ := hasQueueRole(ctx, queue, api.QueueRole_Viewer); granted && p.UserScopesAllow(ctx, permissions.WatchEvents) {
+		return true
+	}
+	owned, _ := p.UserOwns(ctx, queue)
+	return owned && p.UserHasPermission(ctx, permissions.WatchEvents)
+}
+
+// Queues can be changed by users allowed to create queues and by admins of the queue
+func checkQueueAdminPermission(p authorization.PermissionChecker, ctx context.Context, queue *api.Queue) error {
+	if granted, _ := hasQueueRole(ctx, queue, api.QueueRole_Admin); granted && p.UserScopesAllow(ctx, permissions.CreateQueue) {
+		return nil
+	}
+	return checkPermission(p, ctx, permissions.CreateQueue)
+}
+
+// hasQueueRole checks if the user is granted the role, or a higher one, by role bindings of the queue.
+// Returned groups are the groups the role is granted through, they are empty if it is granted to the user directly.
+func hasQueueRole(ctx context.Context, queue *api.Queue, role api.QueueRole) (granted bool, groups []string) {
+	principal := authorization.GetPrincipal(ctx)
+	groups = []string{}
+	for _, binding := range queue.RoleBindings {
+		if binding.Role < role {
+			continue
+		}
+		for _, user := range binding.Users {
+			if user == principal.GetName() {
+				return true, []string{}
+			}
+		}
+		for _, group := range binding.Groups {
+			if principal.IsInGroup(group) {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return len(groups) > 0, groups
+}
@@ -2,9 +2,17 @@ package server
 
 import (
 	"context"
+	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/permissions"
+	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/common/auth/permission"
+	"github.com/G-Research/armada/pkg/api"
 )
 
 type FakePermissionChecker struct{}
@@ -16,3 +24,113 @@ func (c FakePermissionChecker) UserOwns(ctx context.Context, obj authorization.O
 func (FakePermissionChecker) UserHasPermission(ctx context.Context, perm permission.Permission) bool {
 	return true
 }
+
+func (FakePermissionChecker) UserScopesAllow(ctx context.Context, perm permission.Permission) bool {
+	return true
+}
+
+type denyingPermissionChecker struct{}
+
+func (denyingPermissionChecker) UserOwns(ctx context.Context, obj authorization.Owned) (owned bool, ownershipGroups []string) {
+	return false, []string{}
+}
+
+func (denyingPermissionChecker) UserHasPermission(ctx context.Context, perm permission.Permission) bool {
+	return false
+}
+
+// roles granted on queues are not denied
+func (denyingPermissionChecker) UserScopesAllow(ctx context.Context, perm permission.Permission) bool {
+	return true
+}
+
+type inMemoryQueueRepository struct {
+	queues map[string]*api.Queue
+}
+
+func (r *inMemoryQueueRepository) GetAllQueues() ([]*api.Queue, error) {
+	queues := []*api.Queue{}
+	for _, queue := range r.queues {
+		queues = append(queues, queue)
+	}
+	return queues, nil
+}
+
+func (r *inMemoryQueueRepository) GetQueue(name string) (*api.Queue, error) {
+	queue, exists := r.queues[name]
+	if !exists {
+		return nil, repository.ErrQueueNotFound
+	}
+	return queue, nil
+}
+
+func (r *inMemoryQueueRepository) CreateQueue(queue *api.Queue) error {
+	if _, exists := r.queues[queue.Name]; exists {
+		return repository.ErrQueueAlreadyExists
+	}
+	r.queues[queue.Name] = queue
+	return nil
+}
+
+func (r *inMemoryQueueRepository) UpdateQueue(queue *api.Queue) error {
+	current, exists := r.queues[queue.Name]
+	if !exists {
+		return repository.ErrQueueNotFound
+	}
+	if current.Version != queue.Version {
+		return repository.ErrQueueVersionConflict
+	}
+	queue.Version++
+	r.queues[queue.Name] = queue
+	return nil
+}
+
+func (r *inMemoryQueueRepository) DeleteQueue(name string) error {
+	delete(r.queues, name)
+	return nil
+}
+
+var roleBindingsTestQueue = &api.Queue{
+	Name: "queue1",
+	RoleBindings: []*api.QueueRoleBinding{
+		{Role: api.QueueRole_Viewer, Groups: []string{"everyone"}},
+		{Role: api.QueueRole_Operator, Users: []string{"alice"}, Groups: []string{"teamA"}},
+	},
+}
+
+func TestHasQueueRole(t *testing.T) {
+	alice := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{}))
+	teamAMember := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("bob", []string{"teamA"}))
+	anyone := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("carol", []string{}))
+
+	granted, groups := hasQueueRole(alice, roleBindingsTestQueue, api.QueueRole_Operator)
+	assert.True(t, granted)
+	assert.Empty(t, groups)
+
+	granted, groups = hasQueueRole(teamAMember, roleBindingsTestQueue, api.QueueRole_Submitter)
+	assert.True(t, granted)
+	assert.Equal(t, []string{"teamA"}, groups)
+
+	granted, _ = hasQueueRole(teamAMember, roleBindingsTestQueue, api.QueueRole_Admin)
+	assert.False(t, granted)
+
+	granted, _ = hasQueueRole(anyone, roleBindingsTestQueue, api.QueueRole_Viewer)
+	assert.True(t, granted)
+
+	granted, _ = hasQueueRole(anyone, roleBindingsTestQueue, api.QueueRole_Submitter)
+	assert.False(t, granted)
+}
+
+func TestCheckQueueWatchPermission_GrantedByRole(t *testing.T) {
+	queueRepository := &inMemoryQueueRepository{queues: map[string]*api.Queue{
+		"queue1": roleBindingsTestQueue,
+		"queue2": {Name: "queue2"},
+	}}
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("carol", []string{}))
+
+	assert.NoError(t, checkQueueWatchPermission(denyingPermissionChecker{}, queueRepository, ctx, "queue1"))
+	denied := status.Convert(checkQueueWatchPermission(denyingPermissionChecker{}, queueRepository, ctx, "queue2"))
+	assert.Equal(t, codes.PermissionDenied, denied.Code())
+	assert.Contains(t, denied.Message(), string(permissions.WatchEvents))
+	assert.Equal(t, codes.NotFound, status.Code(checkQueueWatchPermission(denyingPermissionChecker{}, queueRepository, ctx, "queue3")))
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type SchedulingServer struct {
+	permissions              authorization.PermissionChecker
+	schedulingConfig         configuration.SchedulingConfig
+	jobRepository            repository.JobRepository
+	queueRepository          repository.QueueRepository
+	usageRepository          repository.UsageRepository
+	schedulingInfoRepository repository.SchedulingInfoRepository
+}
+
+func NewSchedulingServer(
+	permissions authorization.PermissionChecker,
+	schedulingConfig configuration.SchedulingConfig,
+	jobRepository repository.JobRepository,
+	queueRepository repository.QueueRepository,
+	usageRepository repository.UsageRepository,
+	schedulingInfoRepository repository.SchedulingInfoRepository,
+) *SchedulingServer {
+	return &SchedulingServer{
+		permissions:              permissions,
+		schedulingConfig:         schedulingConfig,
+		jobRepository:            jobRepository,
+		queueRepository:          queueRepository,
+		usageRepository:          usageRepository,
+		schedulingInfoRepository: schedulingInfoRepository}
+}
+
+// ExplainJob evaluates the job against current reports of clusters instead of cached non matching jobs of the queue,
+// so the explanation reflects node types reported since the last refresh of the cache
+func (server *SchedulingServer) ExplainJob(ctx context.Context, request *api.JobExplainRequest) (*api.JobExplanation, error) {
+	jobs, e := server.jobRepository.GetExistingJobsByIds([]string{request.JobId})
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	notFound := status.Errorf(codes.NotFound, "Job %q not found", request.JobId)
+	if len(jobs) == 0 {
+		return nil, notFound
+	}
+	job := jobs[0]
+
+	// users who can't watch the queue get the same error as for a missing job, so they can't probe which jobs exist
+	if e := checkQueueWatchPermission(server.permissions, server.queueRepository, ctx, job.Queue); e != nil {
+		if code := status.Code(e); code == codes.PermissionDenied || code == codes.NotFound {
+			return nil, notFound
+		}
+		return nil, e
+	}
+
+	explanation := &api.JobExplanation{
+		JobId:    job.Id,
+		Queue:    job.Queue,
+		JobSetId: job.JobSetId,
+	}
+
+	leasedClusters, e := server.jobRepository.GetLeasedClusterIds([]string{job.Id})
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if clusterId, leased := leasedClusters[job.Id]; leased {
+		explanation.LeasedClusterId = clusterId
+		explanation.Reasons = []string{fmt.Sprintf("job is leased to cluster %s, it is not waiting to be scheduled", clusterId)}
+		return explanation, nil
+	}
+
+	schedulingInfo, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	activeSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(schedulingInfo)
+	schedulablePools := map[string]bool{}
+	for _, info := range activeSchedulingInfo {
+		clusterExplanation := scheduling.ExplainClusterScheduling(job, info)
+		if clusterExplanation.Schedulable {
+			schedulablePools[info.Pool] = true
+		}
+		explanation.Clusters = append(explanation.Clusters, clusterExplanation)
+	}
+	sort.Slice(explanation.Clusters, func(i, j int) bool {
+		return explanation.Clusters[i].ClusterId < explanation.Clusters[j].ClusterId
+	})
+
+	if len(activeSchedulingInfo) == 0 {
+		explanation.Reasons = append(explanation.Reasons, "no cluster reported its nodes recently")
+		return explanation, nil
+	}
+	if len(schedulablePools) == 0 {
+		explanation.Reasons = append(explanation.Reasons, "job does not fit any active cluster")
+		return explanation, nil
+	}
+
+	pools, e := server.explainQueueLimits(job, schedulablePools)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	explanation.Pools = pools
+	return explanation, nil
+}
+
+func (server *SchedulingServer) explainQueueLimits(job *api.Job, pools map[string]bool) ([]*api.PoolExplanation, error) {
+	queue, e := server.queueRepository.GetQueue(job.Queue)
+	if e != nil {
+		return nil, e
+	}
+	queues, e := server.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, e
+	}
+	activeQueues, e := server.jobRepository.FilterActiveQueues(queues)
+	if e != nil {
+		return nil, e
+	}
+	usageReports, e := server.usageRepository.GetClusterUsageReports()
+	if e != nil {
+		return nil, e
+	}
+	clusterLeasedJobReports, e := server.usageRepository.GetClusterLeasedReports()
+	if e != nil {
+		return nil, e
+	}
+
+	result := []*api.PoolExplanation{}
+	for pool, activePoolClusterReports := range scheduling.GroupByPool(scheduling.FilterActiveClusters(usageReports)) {
+		if !pools[pool] {
+			continue
+		}
+		activePoolClusterIds := scheduling.GetClusterReportIds(activePoolClusterReports)
+		clusterPriorities, e := server.usageRepository.GetClusterPriorities(activePoolClusterIds)
+		if e != nil {
+			return nil, e
+		}
+		poolLeasedJobReports := scheduling.FilterClusterLeasedReports(activePoolClusterIds, clusterLeasedJobReports)
+		result = append(result, scheduling.ExplainQueueLimits(
+			&server.schedulingConfig,
+			job,
+			queue,
+			pool,
+			activeQueues,
+			activePoolClusterReports,
+			poolLeasedJobReports,
+			clusterPriorities))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Pool < result[j].Pool
+	})
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type staticSchedulingInfoRepository struct {
+	reports map[string]*api.ClusterSchedulingInfoReport
+}
+
+func (repo *staticSchedulingInfoRepository) GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error) {
+	return repo.reports, nil
+}
+
+func (repo *staticSchedulingInfoRepository) UpdateClusterSchedulingInfo(report *api.ClusterSchedulingInfoReport) error {
+	repo.reports[report.ClusterId] = report
+	return nil
+}
+
+func TestSchedulingServer_ExplainJob(t *testing.T) {
+	jobRepository := newMockJobRepository()
+	_, e := jobRepository.AddJobs([]*api.Job{
+		{Id: "job-1", Queue: "queue1", JobSetId: "set1", PodSpec: &v1.PodSpec{NodeSelector: map[string]string{"armada/region": "eu"}}},
+		{Id: "job-2", Queue: "queue1", JobSetId: "set1", PodSpec: &v1.PodSpec{}},
+	})
+	assert.Nil(t, e)
+	schedulingInfoRepository := &staticSchedulingInfoRepository{reports: map[string]*api.ClusterSchedulingInfoReport{}}
+	server := NewSchedulingServer(&FakePermissionChecker{}, configuration.SchedulingConfig{}, jobRepository,
+		&fakeQueueRepository{}, &fakeUsageRepository{}, schedulingInfoRepository)
+
+	_, e = server.ExplainJob(context.Background(), &api.JobExplainRequest{JobId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(e))
+
+	explanation, e := server.ExplainJob(context.Background(), &api.JobExplainRequest{JobId: "job-1"})
+	assert.Nil(t, e)
+	assert.Equal(t, []string{"no cluster reported its nodes recently"}, explanation.Reasons)
+
+	schedulingInfoRepository.reports["cluster-2"] = &api.ClusterSchedulingInfoReport{
+		ClusterId: "cluster-2", Pool: "cpu", ReportTime: time.Now(), NodeTypes: []*api.NodeType{{Labels: map[string]string{"armada/region": "us"}}},
+	}
+	schedulingInfoRepository.reports["cluster-1"] = &api.ClusterSchedulingInfoReport{
+		ClusterId: "cluster-1", Pool: "cpu", ReportTime: time.Now(), NodeTypes: []*api.NodeType{{}},
+	}
+
+	explanation, e = server.ExplainJob(context.Background(), &api.JobExplainRequest{JobId: "job-1"})
+	assert.Nil(t, e)
+	assert.Equal(t, "queue1", explanation.Queue)
+	assert.Equal(t, "set1", explanation.JobSetId)
+	assert.Equal(t, []string{"job does not fit any active cluster"}, explanation.Reasons)
+	assert.Len(t, explanation.Clusters, 2)
+	assert.Equal(t, "cluster-1", explanation.Clusters[0].ClusterId)
+	assert.Equal(t, []string{"node selector armada/region=eu does not match: node type has no label armada/region"}, explanation.Clusters[0].NodeTypes[0].Reasons)
+	assert.Equal(t, []string{"node selector armada/region=eu does not match: node type has label armada/region=us"}, explanation.Clusters[1].NodeTypes[0].Reasons)
+
+	explanation, e = server.ExplainJob(context.Background(), &api.JobExplainRequest{JobId: "job-2"})
+	assert.Nil(t, e)
+	assert.Empty(t, explanation.Reasons)
+	assert.True(t, explanation.Clusters[0].Schedulable)
+
+	jobRepository.leasedClusters["job-2"] = "cluster-1"
+	explanation, e = server.ExplainJob(context.Background(), &api.JobExplainRequest{JobId: "job-2"})
+	assert.Nil(t, e)
+	assert.Equal(t, "cluster-1", explanation.LeasedClusterId)
+	assert.Empty(t, explanation.Clusters)
+}
+
+func TestSchedulingServer_ExplainJob_HidesJobsOfQueuesUserCannotWatch(t *testing.T) {
+	jobRepository := newMockJobRepository()
+	_, e := jobRepository.AddJobs([]*api.Job{{Id: "job-1", Queue: "queue1", JobSetId: "set1", PodSpec: &v1.PodSpec{}}})
+	assert.Nil(t, e)
+	server := NewSchedulingServer(&denyingPermissionChecker{}, configuration.SchedulingConfig{}, jobRepository,
+		&fakeQueueRepository{}, &fakeUsageRepository{}, &staticSchedulingInfoRepository{reports: map[string]*api.ClusterSchedulingInfoReport{}})
+
+	_, e = server.ExplainJob(context.Background(), &api.JobExplainRequest{JobId: "job-1"})
+	assert.Equal(t, status.Errorf(codes.NotFound, "Job %q not found", "job-1"), e)
+
+	_, e = server.ExplainJob(context.Background(), &api.JobExplainRequest{JobId: "job-2"})
+	assert.Equal(t, status.Errorf(codes.NotFound, "Job %q not found", "job-2"), e)
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/gogo/protobuf/types"
 	log "github.com/sirupsen/logrus"
@@ -10,11 +11,13 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/notification"
 	"github.com/G-Research/armada/internal/armada/permissions"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/armada/scheduling"
 	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/common/auth/permission"
+	"github.com/G-Research/armada/internal/common/validation"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -23,8 +26,11 @@ type SubmitServer struct {
 	jobRepository            repository.JobRepository
 	queueRepository          repository.QueueRepository
 	eventStore               repository.EventStore
+	eventRepository          repository.EventRepository
 	schedulingInfoRepository repository.SchedulingInfoRepository
+	jobSetRepository         repository.JobSetRepository
 	queueManagementConfig    *configuration.QueueManagementConfig
+	notificationUrlPolicy    *notification.UrlPolicy
 }
 
 func NewSubmitServer(
@@ -32,20 +38,26 @@ func NewSubmitServer(
 	jobRepository repository.JobRepository,
 	queueRepository repository.QueueRepository,
 	eventStore repository.EventStore,
+	eventRepository repository.EventRepository,
 	schedulingInfoRepository repository.SchedulingInfoRepository,
-	queueManagementConfig *configuration.QueueManagementConfig) *SubmitServer {
+	jobSetRepository repository.JobSetRepository,
+	queueManagementConfig *configuration.QueueManagementConfig,
+	notificationUrlPolicy *notification.UrlPolicy) *SubmitServer {
 
 	return &SubmitServer{
 		permissions:              permissions,
 		jobRepository:            jobRepository,
 		queueRepository:          queueRepository,
 		eventStore:               eventStore,
+		eventRepository:          eventRepository,
 		schedulingInfoRepository: schedulingInfoRepository,
-		queueManagementConfig:    queueManagementConfig}
+		jobSetRepository:         jobSetRepository,
+		queueManagementConfig:    queueManagementConfig,
+		notificationUrlPolicy:    notificationUrlPolicy}
 }
 
 func (server *SubmitServer) GetQueueInfo(ctx context.Context, req *api.QueueInfoRequest) (*api.QueueInfo, error) {
-	if e := checkPermission(server.permissions, ctx, permissions.WatchAllEvents); e != nil {
+	if e := checkQueueWatchPermission(server.permissions, server.queueRepository, ctx, req.Name); e != nil {
 		return nil, e
 	}
 	jobSets, e := server.jobRepository.GetQueueActiveJobSets(req.Name)
@@ -71,14 +83,127 @@ func (server *SubmitServer) CreateQueue(ctx context.Context, queue *api.Queue) (
 	if queue.PriorityFactor < 1.0 {
 		return nil, status.Errorf(codes.InvalidArgument, "Minimum queue priority factor is 1.")
 	}
+	if e := validation.ValidateQueueRoleBindings(queue.RoleBindings); e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
 
 	e := server.queueRepository.CreateQueue(queue)
-	if e != nil {
+	if e == repository.ErrQueueAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "Queue %q already exists", queue.Name)
+	} else if e != nil {
 		return nil, status.Errorf(codes.Aborted, e.Error())
 	}
 	return &types.Empty{}, nil
 }
 
+// UpdateQueue replaces the queue, version of the queue has to match the current one, so concurrent changes are not lost.
+// Admins of the queue can change its owners and role bindings, priority factor and resource limits can be changed
+// only by users allowed to create queues.
+func (server *SubmitServer) UpdateQueue(ctx context.Context, queue *api.Queue) (*api.Queue, error) {
+	current, e := server.getQueue(queue.Name)
+	if e != nil {
+		return nil, e
+	}
+	if e := checkQueueAdminPermission(server.permissions, ctx, current); e != nil {
+		return nil, e
+	}
+	if queueSchedulingChanged(current, queue) {
+		if e := checkPermission(server.permissions, ctx, permissions.CreateQueue); e != nil {
+			return nil, e
+		}
+	}
+
+	if queue.PriorityFactor < 1.0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Minimum queue priority factor is 1.")
+	}
+	if e := validation.ValidateQueueRoleBindings(queue.RoleBindings); e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+
+	e = server.updateQueue(queue)
+	if e != nil {
+		return nil, e
+	}
+	return queue, nil
+}
+
+// GetQueues returns all queues the user can watch
+func (server *SubmitServer) GetQueues(ctx context.Context, _ *types.Empty) (*api.QueueList, error) {
+	queues, e := server.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	visible := []*api.Queue{}
+	for _, queue := range queues {
+		if canWatchQueue(server.permissions, ctx, queue) {
+			visible = append(visible, queue)
+		}
+	}
+	sort.Slice(visible, func(i, j int) bool {
+		return visible[i].Name < visible[j].Name
+	})
+	return &api.QueueList{Queues: visible}, nil
+}
+
+// SetQueueRoleBindings replaces role bindings of the queue, admins of the queue and users allowed to create queues can change them
+func (server *SubmitServer) SetQueueRoleBindings(ctx context.Context, request *api.QueueRoleBindingsRequest) (*types.Empty, error) {
+	queue, e := server.getQueue(request.Name)
+	if e != nil {
+		return nil, e
+	}
+	if e := checkQueueAdminPermission(server.permissions, ctx, queue); e != nil {
+		return nil, e
+	}
+
+	if e := validation.ValidateQueueRoleBindings(request.RoleBindings); e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+
+	queue.RoleBindings = request.RoleBindings
+	e = server.updateQueue(queue)
+	if e != nil {
+		return nil, e
+	}
+	return &types.Empty{}, nil
+}
+
+// queueSchedulingChanged returns true when the update changes share of resources the queue gets
+func queueSchedulingChanged(current *api.Queue, updated *api.Queue) bool {
+	if current.PriorityFactor != updated.PriorityFactor || len(current.ResourceLimits) != len(updated.ResourceLimits) {
+		return true
+	}
+	for resourceName, limit := range current.ResourceLimits {
+		updatedLimit, exists := updated.ResourceLimits[resourceName]
+		if !exists || updatedLimit != limit {
+			return true
+		}
+	}
+	return false
+}
+
+func (server *SubmitServer) getQueue(name string) (*api.Queue, error) {
+	queue, e := server.queueRepository.GetQueue(name)
+	if e == repository.ErrQueueNotFound {
+		return nil, status.Errorf(codes.NotFound, "Queue %q not found", name)
+	} else if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load queue %q: %s", name, e.Error())
+	}
+	return queue, nil
+}
+
+func (server *SubmitServer) updateQueue(queue *api.Queue) error {
+	e := server.queueRepository.UpdateQueue(queue)
+	if e == repository.ErrQueueNotFound {
+		return status.Errorf(codes.NotFound, "Queue %q not found", queue.Name)
+	} else if e == repository.ErrQueueVersionConflict {
+		return status.Errorf(codes.Aborted, "Queue %q was changed since version %d, read the queue again and retry", queue.Name, queue.Version)
+	} else if e != nil {
+		return status.Errorf(codes.Unavailable, e.Error())
+	}
+	return nil
+}
+
 func (server *SubmitServer) DeleteQueue(ctx context.Context, request *api.QueueDeleteRequest) (*types.Empty, error) {
 	if e := checkPermission(server.permissions, ctx, permissions.DeleteQueue); e != nil {
 		return nil, e
@@ -101,11 +226,20 @@ func (server *SubmitServer) DeleteQueue(ctx context.Context, request *api.QueueD
 }
 
 func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
-	e, ownershipGroups := server.checkQueuePermission(ctx, req.Queue, true, permissions.SubmitJobs, permissions.SubmitAnyJobs)
+	// dry run must not have side effects, queue is not created automatically for it
+	e, ownershipGroups := server.checkQueuePermission(ctx, req.Queue, !req.DryRun, permissions.SubmitJobs, permissions.SubmitAnyJobs)
 	if e != nil {
 		return nil, e
 	}
 
+	jobSetState, e := server.jobSetRepository.GetJobSetState(req.Queue, req.JobSetId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if jobSetState != repository.JobSetOpen {
+		return nil, status.Errorf(codes.FailedPrecondition, "Job set %s is closed", req.JobSetId)
+	}
+
 	principal := authorization.GetPrincipal(ctx)
 
 	jobs, e := server.jobRepository.CreateJobs(req, principal.GetName(), ownershipGroups)
@@ -113,6 +247,15 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 		return nil, status.Errorf(codes.InvalidArgument, e.Error())
 	}
 
+	e = server.validateNotifyUrls(jobs)
+	if e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+
+	if req.DryRun {
+		return server.dryRunJobs(jobs)
+	}
+
 	e = server.validateJobsCanBeScheduled(jobs)
 	if e != nil {
 		return nil, status.Errorf(codes.InvalidArgument, e.Error())
@@ -135,7 +278,7 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 	createdJobs := []*api.Job{}
 	doubleSubmits := []*repository.SubmitJobResult{}
 	for i, submissionResult := range submissionResults {
-		jobResponse := &api.JobSubmitResponseItem{JobId: submissionResult.JobId}
+		jobResponse := &api.JobSubmitResponseItem{JobId: submissionResult.JobId, Duplicate: submissionResult.DuplicateDetected}
 		if submissionResult.Error != nil {
 			jobResponse.Error = submissionResult.Error.Error()
 		}
@@ -159,18 +302,111 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 	if e != nil {
 		return result, status.Errorf(codes.Internal, e.Error())
 	}
+
+	if req.ReturnDuplicateStatus && len(doubleSubmits) > 0 {
+		statuses, e := server.getOriginalJobStatuses(doubleSubmits)
+		if e != nil {
+			return result, status.Errorf(codes.Unavailable, e.Error())
+		}
+		for _, jobResponse := range result.JobResponseItems {
+			if jobResponse.Duplicate {
+				jobResponse.OriginalJobStatus = statuses[jobResponse.JobId]
+			}
+		}
+	}
+	return result, nil
+}
+
+func (server *SubmitServer) validateNotifyUrls(jobs []*api.Job) error {
+	for i, job := range jobs {
+		if notifyUrl, exists := job.Annotations[api.NotifyUrlAnnotation]; exists {
+			if e := server.notificationUrlPolicy.CheckUrl(notifyUrl); e != nil {
+				return fmt.Errorf("job with index %v has invalid notify url: %v", i, e)
+			}
+		}
+	}
+	return nil
+}
+
+// getOriginalJobStatuses returns statuses of jobs which were submitted earlier with client ids of the duplicates
+func (server *SubmitServer) getOriginalJobStatuses(duplicates []*repository.SubmitJobResult) (map[string]api.JobStatus, error) {
+	originalJobIds := make([]string, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		originalJobIds = append(originalJobIds, duplicate.JobId)
+	}
+	originalJobs, e := server.jobRepository.GetExistingJobsByIds(originalJobIds)
+	if e != nil {
+		return nil, e
+	}
+
+	statuses := map[string]api.JobStatus{}
+	jobSets := map[string]map[string]bool{}
+	for _, job := range originalJobs {
+		// submitted event is reported before the job is stored, snapshot of the job set can lag behind if events are processed asynchronously
+		statuses[job.Id] = api.JobStatus_Submitted
+		if jobSets[job.Queue] == nil {
+			jobSets[job.Queue] = map[string]bool{}
+		}
+		jobSets[job.Queue][job.JobSetId] = true
+	}
+
+	for queue, jobSetIds := range jobSets {
+		for jobSetId := range jobSetIds {
+			snapshot, e := server.eventRepository.GetJobSetSnapshot(queue, jobSetId)
+			if e != nil {
+				return nil, e
+			}
+			for _, jobState := range snapshot.Jobs {
+				if _, isOriginal := statuses[jobState.JobId]; isOriginal && jobState.Status != api.JobStatus_UnknownStatus {
+					statuses[jobState.JobId] = jobState.Status
+				}
+			}
+		}
+	}
+	return statuses, nil
+}
+
+func (server *SubmitServer) CloseJobSet(ctx context.Context, request *api.JobSetCloseRequest) (*types.Empty, error) {
+	if e, _ := server.checkQueuePermission(ctx, request.Queue, false, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+		return nil, e
+	}
+
+	closed, e := server.jobSetRepository.CloseJobSet(request.Queue, request.JobSetId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if !closed {
+		return nil, status.Errorf(codes.FailedPrecondition, "Job set %s is already closed", request.JobSetId)
+	}
+	return &types.Empty{}, nil
+}
+
+// dryRunJobs reports for every validated job whether it can be scheduled, nothing is stored and no events are reported
+func (server *SubmitServer) dryRunJobs(jobs []*api.Job) (*api.JobSubmitResponse, error) {
+	schedulable, e := server.getSchedulableJobs(jobs)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	result := &api.JobSubmitResponse{JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(jobs))}
+	for i := range jobs {
+		jobResponse := &api.JobSubmitResponseItem{}
+		if !schedulable[i] {
+			jobResponse.Error = "job is not schedulable on any cluster"
+		}
+		result.JobResponseItems = append(result.JobResponseItems, jobResponse)
+	}
 	return result, nil
 }
 
 func (server *SubmitServer) validateJobsCanBeScheduled(jobs []*api.Job) error {
-	allClusterSchedulingInfo, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	schedulable, e := server.getSchedulableJobs(jobs)
 	if e != nil {
 		return e
 	}
 
-	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
-	for i, job := range jobs {
-		if !scheduling.MatchSchedulingRequirementsOnAnyCluster(job, activeClusterSchedulingInfo) {
+	for i := range jobs {
+		if !schedulable[i] {
 			return fmt.Errorf("job with index %d is not schedulable on any cluster", i)
 		}
 	}
@@ -178,6 +414,21 @@ func (server *SubmitServer) validateJobsCanBeScheduled(jobs []*api.Job) error {
 	return nil
 }
 
+// getSchedulableJobs checks every job matches scheduling requirements of some active cluster
+func (server *SubmitServer) getSchedulableJobs(jobs []*api.Job) ([]bool, error) {
+	allClusterSchedulingInfo, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, e
+	}
+
+	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
+	schedulable := make([]bool, 0, len(jobs))
+	for _, job := range jobs {
+		schedulable = append(schedulable, scheduling.MatchSchedulingRequirementsOnAnyCluster(job, activeClusterSchedulingInfo))
+	}
+	return schedulable, nil
+}
+
 func (server *SubmitServer) CancelJobs(ctx context.Context, request *api.JobCancelRequest) (*api.CancellationResult, error) {
 	if request.JobId != "" {
 		jobs, e := server.jobRepository.GetExistingJobsByIds([]string{request.JobId})
@@ -326,7 +577,8 @@ func (server *SubmitServer) checkQueuePermission(
 				PriorityFactor: server.queueManagementConfig.DefaultPriorityFactor,
 			}
 			e := server.queueRepository.CreateQueue(queue)
-			if e != nil {
+			// queue created concurrently by another submission can be used as well
+			if e != nil && e != repository.ErrQueueAlreadyExists {
 				return status.Errorf(codes.Aborted, e.Error()), []string{}
 			}
 			return nil, []string{}
@@ -337,6 +589,12 @@ func (server *SubmitServer) checkQueuePermission(
 		return status.Errorf(codes.Unavailable, "Could not load queue %q: %s", queueName, e.Error()), []string{}
 	}
 
+	if role, exists := queueRolesByPermission[basicPermission]; exists {
+		if granted, groups := hasQueueRole(ctx, queue, role); granted && server.permissions.UserScopesAllow(ctx, basicPermission) {
+			return nil, groups
+		}
+	}
+
 	permissionToCheck := basicPermission
 	owned, groups := server.permissions.UserOwns(ctx, queue)
 	if !owned {
@@ -8,13 +8,18 @@ import (
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/notification"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
@@ -31,6 +36,25 @@ func TestSubmitServer_SubmitJob(t *testing.T) {
 	})
 }
 
+func TestSubmitServer_SubmitJob_RejectsClosedJobSet(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 1)
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		_, err = s.CloseJobSet(context.Background(), &api.JobSetCloseRequest{Queue: jobRequest.Queue, JobSetId: jobSetId})
+		assert.Empty(t, err)
+
+		_, err = s.CloseJobSet(context.Background(), &api.JobSetCloseRequest{Queue: jobRequest.Queue, JobSetId: jobSetId})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
 func TestSubmitServer_SubmitJob_WhenPodCannotBeScheduled(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
 		jobSetId := util.NewULID()
@@ -53,6 +77,44 @@ func TestSubmitServer_SubmitJob_WhenPodCannotBeScheduled(t *testing.T) {
 	})
 }
 
+func TestSubmitServer_SubmitJobs_DryRunDoesNotSubmitJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 2)
+		jobRequest.DryRun = true
+		jobRequest.JobRequestItems[1].PodSpecs[0].NodeSelector = map[string]string{"missing": "label"}
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+		assert.Equal(t, 2, len(response.JobResponseItems))
+		assert.Equal(t, &api.JobSubmitResponseItem{}, response.JobResponseItems[0])
+		assert.Equal(t, "job is not schedulable on any cluster", response.JobResponseItems[1].Error)
+
+		messages, err := readJobEvents(events, jobSetId)
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+
+		activeJobIds, err := s.jobRepository.GetActiveJobIds(jobRequest.Queue, jobSetId)
+		assert.NoError(t, err)
+		assert.Empty(t, activeJobIds)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_DryRunDoesNotCreateQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.AutoCreateQueues = true
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.Queue = "dry-run-queue"
+		jobRequest.DryRun = true
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.queueRepository.GetQueue("dry-run-queue")
+		assert.Equal(t, repository.ErrQueueNotFound, err)
+	})
+}
+
 func TestSubmitServer_SubmitJob_AddsExpectedEventsInCorrectOrder(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
 		jobSetId := util.NewULID()
@@ -140,6 +202,99 @@ func TestSubmitServer_SubmitJobs_HandlesDoubleSubmit(t *testing.T) {
 	})
 }
 
+func TestSubmitServer_SubmitJobs_ReturnsStatusOfOriginalJob(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 1)
+
+		result, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+		assert.False(t, result.JobResponseItems[0].Duplicate)
+
+		jobRequest.ReturnDuplicateStatus = true
+		result2, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		assert.Equal(t, result.JobResponseItems[0].JobId, result2.JobResponseItems[0].JobId)
+		assert.True(t, result2.JobResponseItems[0].Duplicate)
+		assert.Equal(t, api.JobStatus_Queued, result2.JobResponseItems[0].OriginalJobStatus)
+	})
+}
+
+func TestSubmitServer_UpdateQueue(t *testing.T) {
+	adminBindings := []*api.QueueRoleBinding{{Role: api.QueueRole_Admin, Users: []string{"alice"}}}
+	queueRepository := &inMemoryQueueRepository{queues: map[string]*api.Queue{
+		"queue1": {Name: "queue1", PriorityFactor: 1, Version: 1, RoleBindings: adminBindings},
+	}}
+	s := &SubmitServer{permissions: denyingPermissionChecker{}, queueRepository: queueRepository}
+	alice := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{}))
+	bob := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("bob", []string{}))
+
+	update := &api.Queue{Name: "queue1", PriorityFactor: 1, Version: 1, RoleBindings: adminBindings, GroupOwners: []string{"teamA"}}
+	_, e := s.UpdateQueue(bob, update)
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+
+	updated, e := s.UpdateQueue(alice, update)
+	assert.NoError(t, e)
+	assert.Equal(t, uint64(2), updated.Version)
+
+	_, e = s.UpdateQueue(alice, &api.Queue{Name: "queue1", PriorityFactor: 1, Version: 1})
+	assert.Equal(t, codes.Aborted, status.Code(e))
+	assert.Equal(t, []string{"teamA"}, queueRepository.queues["queue1"].GroupOwners)
+}
+
+func TestSubmitServer_UpdateQueue_RequiresCreateQueuePermissionToChangeScheduling(t *testing.T) {
+	adminBindings := []*api.QueueRoleBinding{{Role: api.QueueRole_Admin, Users: []string{"alice"}}}
+	queueRepository := &inMemoryQueueRepository{queues: map[string]*api.Queue{
+		"queue1": {Name: "queue1", PriorityFactor: 1, Version: 1, RoleBindings: adminBindings},
+	}}
+	s := &SubmitServer{permissions: denyingPermissionChecker{}, queueRepository: queueRepository}
+	alice := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{}))
+
+	_, e := s.UpdateQueue(alice, &api.Queue{Name: "queue1", PriorityFactor: 2, Version: 1, RoleBindings: adminBindings})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+
+	limits := map[string]float64{"cpu": 0.5}
+	_, e = s.UpdateQueue(alice, &api.Queue{Name: "queue1", PriorityFactor: 1, Version: 1, RoleBindings: adminBindings, ResourceLimits: limits})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+
+	s.permissions = &FakePermissionChecker{}
+	updated, e := s.UpdateQueue(alice, &api.Queue{Name: "queue1", PriorityFactor: 2, Version: 1, RoleBindings: adminBindings, ResourceLimits: limits})
+	assert.NoError(t, e)
+	assert.Equal(t, float64(2), updated.PriorityFactor)
+}
+
+func TestSubmitServer_CreateQueue_RejectsExistingQueue(t *testing.T) {
+	queueRepository := &inMemoryQueueRepository{queues: map[string]*api.Queue{
+		"queue1": {Name: "queue1", PriorityFactor: 1, Version: 1},
+	}}
+	s := &SubmitServer{permissions: &FakePermissionChecker{}, queueRepository: queueRepository}
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{}))
+
+	_, e := s.CreateQueue(ctx, &api.Queue{Name: "queue1", PriorityFactor: 2})
+	assert.Equal(t, codes.AlreadyExists, status.Code(e))
+	assert.Equal(t, float64(1), queueRepository.queues["queue1"].PriorityFactor)
+}
+
+func TestSubmitServer_GetQueues_ReturnsQueuesUserCanWatch(t *testing.T) {
+	queueRepository := &inMemoryQueueRepository{queues: map[string]*api.Queue{
+		"queue2": {Name: "queue2", RoleBindings: []*api.QueueRoleBinding{{Role: api.QueueRole_Viewer, Groups: []string{"teamA"}}}},
+		"queue1": {Name: "queue1", RoleBindings: []*api.QueueRoleBinding{{Role: api.QueueRole_Submitter, Users: []string{"alice"}}}},
+		"queue3": {Name: "queue3"},
+	}}
+	s := &SubmitServer{permissions: denyingPermissionChecker{}, queueRepository: queueRepository}
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{"teamA"}))
+
+	result, e := s.GetQueues(ctx, &types.Empty{})
+	assert.NoError(t, e)
+	assert.Equal(t, []*api.Queue{queueRepository.queues["queue1"], queueRepository.queues["queue2"]}, result.Queues)
+
+	s.permissions = &FakePermissionChecker{}
+	result, e = s.GetQueues(ctx, &types.Empty{})
+	assert.NoError(t, e)
+	assert.Equal(t, 3, len(result.Queues))
+}
+
 func TestSubmitServer_ReprioritizeJobs(t *testing.T) {
 	t.Run("job that doesn't exist", func(t *testing.T) {
 		withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
@@ -394,11 +549,11 @@ func withSubmitServerAndRepos(action func(s *SubmitServer, jobRepo repository.Jo
 	// using real redis instance as miniredis does not support streams
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 
-	jobRepo := repository.NewRedisJobRepository(client, nil)
+	jobRepo := repository.NewRedisJobRepository(client, nil, configuration.JobDeduplicationConfig{Scope: configuration.DeduplicationScopeQueue, Window: time.Hour})
 	queueRepo := repository.NewRedisQueueRepository(client)
 	eventRepo := repository.NewRedisEventRepository(client, configuration.EventRetentionPolicy{ExpiryEnabled: false})
 	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(client)
-	server := NewSubmitServer(&FakePermissionChecker{}, jobRepo, queueRepo, eventRepo, schedulingInfoRepository, &configuration.QueueManagementConfig{DefaultPriorityFactor: 1})
+	server := NewSubmitServer(&FakePermissionChecker{}, jobRepo, queueRepo, eventRepo, eventRepo, schedulingInfoRepository, repository.NewRedisJobSetRepository(client), &configuration.QueueManagementConfig{DefaultPriorityFactor: 1}, notification.NewUrlPolicy(&configuration.NotificationConfig{}))
 
 	err := queueRepo.CreateQueue(&api.Queue{Name: "test"})
 	if err != nil {
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/permissions"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type ApiTokenServer struct {
+	permissions        authorization.PermissionChecker
+	apiTokenRepository repository.ApiTokenRepository
+}
+
+func NewApiTokenServer(permissions authorization.PermissionChecker, apiTokenRepository repository.ApiTokenRepository) *ApiTokenServer {
+	return &ApiTokenServer{permissions: permissions, apiTokenRepository: apiTokenRepository}
+}
+
+func (s *ApiTokenServer) CreateApiToken(ctx context.Context, request *api.ApiTokenCreateRequest) (*api.ApiTokenCreateResponse, error) {
+	if e := checkPermission(s.permissions, ctx, permissions.ManageApiTokens); e != nil {
+		return nil, e
+	}
+
+	if request.Principal == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Principal of the api token must be specified")
+	}
+	now := time.Now()
+	if request.Expires != nil && !request.Expires.After(now) {
+		return nil, status.Errorf(codes.InvalidArgument, "Expiration of the api token must be in the future")
+	}
+
+	id := util.NewULID()
+	bearer, secretHash, e := authorization.NewApiToken(id)
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+
+	token := &api.ApiToken{
+		Id:         id,
+		Name:       request.Name,
+		Principal:  request.Principal,
+		Groups:     request.Groups,
+		Scopes:     request.Scopes,
+		Created:    now,
+		Expires:    request.Expires,
+		CreatedBy:  authorization.GetPrincipal(ctx).GetName(),
+		SecretHash: secretHash,
+	}
+	e = s.apiTokenRepository.CreateApiToken(token)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	token.SecretHash = ""
+	return &api.ApiTokenCreateResponse{Token: token, SecretToken: bearer}, nil
+}
+
+func (s *ApiTokenServer) GetApiTokens(ctx context.Context, _ *types.Empty) (*api.ApiTokenList, error) {
+	if e := checkPermission(s.permissions, ctx, permissions.ManageApiTokens); e != nil {
+		return nil, e
+	}
+
+	tokens, e := s.apiTokenRepository.GetApiTokens()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	for _, token := range tokens {
+		token.SecretHash = ""
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].Created.Before(tokens[j].Created)
+	})
+	return &api.ApiTokenList{Tokens: tokens}, nil
+}
+
+func (s *ApiTokenServer) RevokeApiToken(ctx context.Context, request *api.ApiTokenRevokeRequest) (*types.Empty, error) {
+	if e := checkPermission(s.permissions, ctx, permissions.ManageApiTokens); e != nil {
+		return nil, e
+	}
+
+	deleted, e := s.apiTokenRepository.DeleteApiToken(request.Id)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "Api token %s does not exist", request.Id)
+	}
+	return &types.Empty{}, nil
+}
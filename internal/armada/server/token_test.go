@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestApiTokenServer_CreatedTokenAuthenticatesUntilRevoked(t *testing.T) {
+	withApiTokenServer(func(s *ApiTokenServer, authService *authorization.ApiTokenAuthService) {
+		ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("admin", []string{}))
+
+		created, e := s.CreateApiToken(ctx, &api.ApiTokenCreateRequest{
+			Name:      "ci",
+			Principal: "ci-pipeline",
+			Groups:    []string{"teamA"},
+			Scopes:    []string{"submit"},
+		})
+		assert.Nil(t, e)
+		assert.Equal(t, "admin", created.Token.CreatedBy)
+		assert.Empty(t, created.Token.SecretHash)
+
+		principal, e := authService.Authenticate(withBearer(created.SecretToken))
+		assert.Nil(t, e)
+		assert.Equal(t, "ci-pipeline", principal.GetName())
+		assert.True(t, principal.IsInGroup("teamA"))
+		assert.True(t, principal.HasScope("submit"))
+
+		tokens, e := s.GetApiTokens(ctx, &types.Empty{})
+		assert.Nil(t, e)
+		assert.Len(t, tokens.Tokens, 1)
+		assert.Equal(t, created.Token.Id, tokens.Tokens[0].Id)
+		assert.Empty(t, tokens.Tokens[0].SecretHash)
+
+		_, e = s.RevokeApiToken(ctx, &api.ApiTokenRevokeRequest{Id: created.Token.Id})
+		assert.Nil(t, e)
+
+		_, e = authService.Authenticate(withBearer(created.SecretToken))
+		assert.Equal(t, codes.Unauthenticated, status.Code(e))
+
+		_, e = s.RevokeApiToken(ctx, &api.ApiTokenRevokeRequest{Id: created.Token.Id})
+		assert.Equal(t, codes.NotFound, status.Code(e))
+	})
+}
+
+func TestApiTokenServer_CreateApiToken_RejectsInvalidRequests(t *testing.T) {
+	withApiTokenServer(func(s *ApiTokenServer, _ *authorization.ApiTokenAuthService) {
+		ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("admin", []string{}))
+
+		_, e := s.CreateApiToken(ctx, &api.ApiTokenCreateRequest{Name: "ci"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+
+		expired := time.Now().Add(-time.Minute)
+		_, e = s.CreateApiToken(ctx, &api.ApiTokenCreateRequest{Name: "ci", Principal: "ci-pipeline", Expires: &expired})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+	})
+}
+
+func TestApiTokenServer_RequiresPermission(t *testing.T) {
+	s := NewApiTokenServer(denyingPermissionChecker{}, nil)
+
+	_, e := s.CreateApiToken(context.Background(), &api.ApiTokenCreateRequest{Principal: "ci-pipeline"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+	_, e = s.GetApiTokens(context.Background(), &types.Empty{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+	_, e = s.RevokeApiToken(context.Background(), &api.ApiTokenRevokeRequest{Id: "id"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+}
+
+func withBearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+token))
+}
+
+func withApiTokenServer(action func(s *ApiTokenServer, authService *authorization.ApiTokenAuthService)) {
+	db, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: db.Addr()})
+
+	repo := repository.NewRedisApiTokenRepository(redisClient)
+	action(NewApiTokenServer(&FakePermissionChecker{}, repo), authorization.NewApiTokenAuthService(repo))
+}
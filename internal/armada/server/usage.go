@@ -20,6 +20,7 @@ type UsageServer struct {
 	schedulingConfig *configuration.SchedulingConfig
 	usageRepository  repository.UsageRepository
 	queueRepository  repository.QueueRepository
+	executorIdentity *ExecutorIdentityChecker
 }
 
 func NewUsageServer(
@@ -27,20 +28,25 @@ func NewUsageServer(
 	priorityHalfTime time.Duration,
 	schedulingConfig *configuration.SchedulingConfig,
 	usageRepository repository.UsageRepository,
-	queueRepository repository.QueueRepository) *UsageServer {
+	queueRepository repository.QueueRepository,
+	executorIdentity *ExecutorIdentityChecker) *UsageServer {
 
 	return &UsageServer{
 		permissions:      permissions,
 		priorityHalfTime: priorityHalfTime,
 		schedulingConfig: schedulingConfig,
 		usageRepository:  usageRepository,
-		queueRepository:  queueRepository}
+		queueRepository:  queueRepository,
+		executorIdentity: executorIdentity}
 }
 
 func (s *UsageServer) ReportUsage(ctx context.Context, report *api.ClusterUsageReport) (*types.Empty, error) {
 	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
+	if e := s.executorIdentity.checkCluster(ctx, report.ClusterId); e != nil {
+		return nil, e
+	}
 
 	queues, err := s.queueRepository.GetAllQueues()
 	if err != nil {
@@ -91,7 +91,8 @@ func withUsageServer(schedulingConfig *configuration.SchedulingConfig, action fu
 
 	repo := repository.NewRedisUsageRepository(redisClient)
 	queueRepo := repository.NewRedisQueueRepository(redisClient)
-	server := NewUsageServer(&FakePermissionChecker{}, time.Minute, schedulingConfig, repo, queueRepo)
+	server := NewUsageServer(&FakePermissionChecker{}, time.Minute, schedulingConfig, repo, queueRepo,
+		NewExecutorIdentityChecker(configuration.ExecutorIdentityConfig{}, nil, nil))
 
 	action(server)
 }
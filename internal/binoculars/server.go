@@ -26,7 +26,12 @@ func StartUp(config *configuration.BinocularsConfig) (func(), *sync.WaitGroup) {
 		os.Exit(-1)
 	}
 
-	grpcServer := grpcCommon.CreateGrpcServer(auth.ConfigureAuth(config.Auth))
+	serverCredentials, err := auth.ConfigureServerCredentials(config.Auth)
+	if err != nil {
+		log.Errorf("Failed to load server certificates because %s", err)
+		os.Exit(-1)
+	}
+	grpcServer := grpcCommon.CreateGrpcServer(auth.ConfigureAuth(config.Auth, nil), serverCredentials)
 
 	binocularsServer := server.NewBinocularsServer(kubernetesClientProvider)
 	binoculars.RegisterBinocularsServer(grpcServer, binocularsServer)
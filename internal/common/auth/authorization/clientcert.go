@@ -0,0 +1,85 @@
+package authorization
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/common/auth/configuration"
+)
+
+const (
+	PrincipalFromCommonName = "commonName"
+	PrincipalFromDNSName    = "dnsName"
+	PrincipalFromURI        = "uri"
+	PrincipalFromEmail      = "email"
+)
+
+var invalidClientCertificate = status.Errorf(codes.Unauthenticated, "client certificate does not identify a principal")
+
+// ClientCertAuthService authenticates clients by certificates verified during TLS handshake
+type ClientCertAuthService struct {
+	config *configuration.ClientCertAuthenticationConfig
+}
+
+func NewClientCertAuthService(config *configuration.ClientCertAuthenticationConfig) (*ClientCertAuthService, error) {
+	switch config.PrincipalFrom {
+	case "", PrincipalFromCommonName, PrincipalFromDNSName, PrincipalFromURI, PrincipalFromEmail:
+		return &ClientCertAuthService{config: config}, nil
+	default:
+		return nil, fmt.Errorf("unknown certificate principal source %q, supported values are %s, %s, %s and %s",
+			config.PrincipalFrom, PrincipalFromCommonName, PrincipalFromDNSName, PrincipalFromURI, PrincipalFromEmail)
+	}
+}
+
+func (authService *ClientCertAuthService) Authenticate(ctx context.Context) (Principal, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, missingCredentials
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, missingCredentials
+	}
+
+	certificate := tlsInfo.State.VerifiedChains[0][0]
+	name := authService.principalName(certificate)
+	if name == "" {
+		return nil, invalidClientCertificate
+	}
+
+	groups := append([]string{}, authService.config.Groups[name]...)
+	if authService.config.GroupsFromOrganizationalUnit {
+		groups = append(groups, certificate.Subject.OrganizationalUnit...)
+	}
+	return NewStaticPrincipal(name, groups), nil
+}
+
+func (authService *ClientCertAuthService) principalName(certificate *x509.Certificate) string {
+	switch authService.config.PrincipalFrom {
+	case PrincipalFromDNSName:
+		return singleName(certificate.DNSNames)
+	case PrincipalFromEmail:
+		return singleName(certificate.EmailAddresses)
+	case PrincipalFromURI:
+		if len(certificate.URIs) != 1 {
+			return ""
+		}
+		return certificate.URIs[0].String()
+	default:
+		return certificate.Subject.CommonName
+	}
+}
+
+// Certificates with multiple names of the type are ambiguous and don't identify a principal
+func singleName(names []string) string {
+	if len(names) != 1 {
+		return ""
+	}
+	return names[0]
+}
@@ -0,0 +1,87 @@
+package authorization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/common/auth/configuration"
+)
+
+func TestClientCertAuthService_UsesCommonNameAndConfiguredGroups(t *testing.T) {
+	authService := createClientCertAuthService(t, &configuration.ClientCertAuthenticationConfig{
+		Groups: map[string][]string{"executor-cluster1": {"armada-executor"}},
+	})
+
+	principal, e := authService.Authenticate(withClientCertificate(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "executor-cluster1", OrganizationalUnit: []string{"infra"}},
+	}))
+	assert.Nil(t, e)
+	assert.Equal(t, "executor-cluster1", principal.GetName())
+	assert.True(t, principal.IsInGroup("armada-executor"))
+	assert.False(t, principal.IsInGroup("infra"))
+}
+
+func TestClientCertAuthService_UsesSubjectAlternativeNames(t *testing.T) {
+	spiffeId, _ := url.Parse("spiffe://example.com/executor/cluster1")
+	certificate := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "common-name", OrganizationalUnit: []string{"infra"}},
+		DNSNames:       []string{"cluster1.example.com"},
+		URIs:           []*url.URL{spiffeId},
+		EmailAddresses: []string{"executor@example.com", "infra@example.com"},
+	}
+
+	dnsAuthService := createClientCertAuthService(t, &configuration.ClientCertAuthenticationConfig{
+		PrincipalFrom:                PrincipalFromDNSName,
+		GroupsFromOrganizationalUnit: true,
+	})
+	principal, e := dnsAuthService.Authenticate(withClientCertificate(certificate))
+	assert.Nil(t, e)
+	assert.Equal(t, "cluster1.example.com", principal.GetName())
+	assert.True(t, principal.IsInGroup("infra"))
+
+	uriAuthService := createClientCertAuthService(t, &configuration.ClientCertAuthenticationConfig{PrincipalFrom: PrincipalFromURI})
+	principal, e = uriAuthService.Authenticate(withClientCertificate(certificate))
+	assert.Nil(t, e)
+	assert.Equal(t, "spiffe://example.com/executor/cluster1", principal.GetName())
+
+	emailAuthService := createClientCertAuthService(t, &configuration.ClientCertAuthenticationConfig{PrincipalFrom: PrincipalFromEmail})
+	_, e = emailAuthService.Authenticate(withClientCertificate(certificate))
+	assert.Equal(t, codes.Unauthenticated, status.Code(e))
+}
+
+func TestClientCertAuthService_MissingCertificate(t *testing.T) {
+	authService := createClientCertAuthService(t, &configuration.ClientCertAuthenticationConfig{})
+
+	_, e := authService.Authenticate(context.Background())
+	assert.Equal(t, missingCredentials, e)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}})
+	_, e = authService.Authenticate(ctx)
+	assert.Equal(t, missingCredentials, e)
+}
+
+func TestNewClientCertAuthService_RejectsUnknownPrincipalSource(t *testing.T) {
+	_, e := NewClientCertAuthService(&configuration.ClientCertAuthenticationConfig{PrincipalFrom: "serialNumber"})
+	assert.NotNil(t, e)
+}
+
+func createClientCertAuthService(t *testing.T, config *configuration.ClientCertAuthenticationConfig) *ClientCertAuthService {
+	authService, e := NewClientCertAuthService(config)
+	assert.Nil(t, e)
+	return authService
+}
+
+func withClientCertificate(certificate *x509.Certificate) context.Context {
+	tlsInfo := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{certificate}}}}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: tlsInfo})
+}
@@ -87,6 +87,11 @@ func GetPrincipal(ctx context.Context) Principal {
 	return p
 }
 
+// IsAnonymous returns true for the principal of requests authenticated by AnonymousAuthService or not authenticated at all
+func IsAnonymous(principal Principal) bool {
+	return principal == anonymousPrincipal
+}
+
 func WithPrincipal(ctx context.Context, principal Principal) context.Context {
 	return context.WithValue(ctx, principalKey, principal)
 }
@@ -14,6 +14,9 @@ type Owned interface {
 type PermissionChecker interface {
 	UserHasPermission(ctx context.Context, perm permission.Permission) bool
 	UserOwns(ctx context.Context, obj Owned) (owned bool, ownershipGroups []string)
+	// UserScopesAllow returns false when the user is restricted to scopes of an api token which don't grant the permission,
+	// rights granted to the user outside of permissions, e.g. by roles on queues, have to be checked with it
+	UserScopesAllow(ctx context.Context, perm permission.Permission) bool
 }
 
 type PrincipalPermissionChecker struct {
@@ -33,11 +36,26 @@ func NewPrincipalPermissionChecker(
 		permissionClaimMap: permissionClaimMap}
 }
 
+// UserHasPermission checks if the permission is granted to any of the scopes, groups or claims of the user.
+// Principals of api tokens with scopes have only permissions granted both to their scopes and their groups or claims.
 func (checker *PrincipalPermissionChecker) UserHasPermission(ctx context.Context, perm permission.Permission) bool {
 	principal := GetPrincipal(ctx)
-	return hasPermission(perm, checker.permissionScopeMap, func(scope string) bool { return principal.HasScope(scope) }) ||
-		hasPermission(perm, checker.permissionGroupMap, func(group string) bool { return principal.IsInGroup(group) }) ||
+	grantedToScopes := hasPermission(perm, checker.permissionScopeMap, func(scope string) bool { return principal.HasScope(scope) })
+	grantedToIdentity := hasPermission(perm, checker.permissionGroupMap, func(group string) bool { return principal.IsInGroup(group) }) ||
 		hasPermission(perm, checker.permissionClaimMap, func(claim string) bool { return principal.HasClaim(claim) })
+
+	if isRestrictedToScopes(principal) {
+		return grantedToScopes && grantedToIdentity
+	}
+	return grantedToScopes || grantedToIdentity
+}
+
+func (checker *PrincipalPermissionChecker) UserScopesAllow(ctx context.Context, perm permission.Permission) bool {
+	principal := GetPrincipal(ctx)
+	if !isRestrictedToScopes(principal) {
+		return true
+	}
+	return hasPermission(perm, checker.permissionScopeMap, func(scope string) bool { return principal.HasScope(scope) })
 }
 
 func (checker *PrincipalPermissionChecker) UserOwns(ctx context.Context, obj Owned) (owned bool, ownershipGoups []string) {
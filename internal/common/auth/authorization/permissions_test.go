@@ -26,3 +26,26 @@ func TestPrincipalPermissionChecker_UserHavePermission(t *testing.T) {
 	assert.True(t, checker.UserHasPermission(WithPrincipal(context.Background(), submitter), TestSubmitPermission))
 	assert.False(t, checker.UserHasPermission(WithPrincipal(context.Background(), otherUser), TestSubmitPermission))
 }
+
+func TestPrincipalPermissionChecker_ScopesRestrictApiTokenPermissions(t *testing.T) {
+	const TestWatchPermission permission.Permission = "TestWatchPermission"
+	checker := NewPrincipalPermissionChecker(
+		map[permission.Permission][]string{TestSubmitPermission: {"submitterGroup"}, TestWatchPermission: {"submitterGroup"}},
+		map[permission.Permission][]string{TestSubmitPermission: {"submit"}, TestWatchPermission: {"watch"}},
+		map[permission.Permission][]string{})
+
+	scopedToken := WithPrincipal(context.Background(),
+		&apiTokenPrincipal{NewStaticPrincipalWithScopesAndClaims("ci", []string{"submitterGroup"}, []string{"watch"}, []string{})})
+	assert.True(t, checker.UserHasPermission(scopedToken, TestWatchPermission))
+	assert.False(t, checker.UserHasPermission(scopedToken, TestSubmitPermission))
+	assert.True(t, checker.UserScopesAllow(scopedToken, TestWatchPermission))
+	assert.False(t, checker.UserScopesAllow(scopedToken, TestSubmitPermission))
+
+	scopeWithoutGroup := WithPrincipal(context.Background(),
+		&apiTokenPrincipal{NewStaticPrincipalWithScopesAndClaims("ci", []string{}, []string{"submit"}, []string{})})
+	assert.False(t, checker.UserHasPermission(scopeWithoutGroup, TestSubmitPermission))
+
+	user := WithPrincipal(context.Background(), NewStaticPrincipalWithScopesAndClaims("me", []string{}, []string{"submit"}, []string{}))
+	assert.True(t, checker.UserHasPermission(user, TestSubmitPermission))
+	assert.True(t, checker.UserScopesAllow(user, TestWatchPermission))
+}
@@ -0,0 +1,99 @@
+package authorization
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Api tokens are bearer tokens in form armada_{id}_{secret}, the prefix distinguishes them from Open Id tokens
+const ApiTokenPrefix = "armada_"
+
+const apiTokenSecretBytes = 32
+
+var invalidApiToken = status.Errorf(codes.Unauthenticated, "invalid or expired api token")
+
+type ApiTokenStore interface {
+	// GetApiToken returns nil when the token does not exist
+	GetApiToken(id string) (*api.ApiToken, error)
+}
+
+type ApiTokenAuthService struct {
+	store ApiTokenStore
+}
+
+func NewApiTokenAuthService(store ApiTokenStore) *ApiTokenAuthService {
+	return &ApiTokenAuthService{store: store}
+}
+
+func (authService *ApiTokenAuthService) Authenticate(ctx context.Context) (Principal, error) {
+	bearer, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil || !strings.HasPrefix(bearer, ApiTokenPrefix) {
+		return nil, missingCredentials
+	}
+
+	id, secret, ok := parseApiToken(bearer)
+	if !ok {
+		return nil, invalidApiToken
+	}
+	token, err := authService.store.GetApiToken(id)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not load api token: %v", err)
+	}
+	if token == nil || token.Expires != nil && !time.Now().Before(*token.Expires) {
+		return nil, invalidApiToken
+	}
+	if subtle.ConstantTimeCompare([]byte(HashApiTokenSecret(secret)), []byte(token.SecretHash)) != 1 {
+		return nil, invalidApiToken
+	}
+	principal := NewStaticPrincipalWithScopesAndClaims(token.Principal, token.Groups, token.Scopes, []string{})
+	if len(token.Scopes) == 0 {
+		return principal, nil
+	}
+	return &apiTokenPrincipal{principal}, nil
+}
+
+// apiTokenPrincipal is the principal of api token with scopes, scopes restrict permissions granted to groups of the token
+type apiTokenPrincipal struct {
+	*StaticPrincipal
+}
+
+func isRestrictedToScopes(principal Principal) bool {
+	_, restricted := principal.(*apiTokenPrincipal)
+	return restricted
+}
+
+// NewApiToken generates bearer token with random secret for the token id, only hash of the secret should be stored
+func NewApiToken(id string) (bearer string, secretHash string, err error) {
+	secretBytes := make([]byte, apiTokenSecretBytes)
+	_, err = rand.Read(secretBytes)
+	if err != nil {
+		return "", "", err
+	}
+	secret := hex.EncodeToString(secretBytes)
+	return ApiTokenPrefix + id + "_" + secret, HashApiTokenSecret(secret), nil
+}
+
+// Secrets are random, so a fast hash is sufficient
+func HashApiTokenSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func parseApiToken(bearer string) (id string, secret string, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(bearer, ApiTokenPrefix), "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
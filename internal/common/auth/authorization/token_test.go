@@ -0,0 +1,83 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type fakeApiTokenStore map[string]*api.ApiToken
+
+func (s fakeApiTokenStore) GetApiToken(id string) (*api.ApiToken, error) {
+	return s[id], nil
+}
+
+func TestApiTokenAuthService(t *testing.T) {
+	bearer, secretHash, e := NewApiToken("token1")
+	assert.Nil(t, e)
+
+	service := NewApiTokenAuthService(fakeApiTokenStore{
+		"token1": {Id: "token1", Principal: "ci", Groups: []string{"pipelines"}, Scopes: []string{"armada/submit"}, SecretHash: secretHash},
+	})
+
+	principal, e := service.Authenticate(bearerContext(bearer))
+	assert.Nil(t, e)
+	assert.Equal(t, "ci", principal.GetName())
+	assert.True(t, principal.IsInGroup("pipelines"))
+	assert.True(t, principal.HasScope("armada/submit"))
+	assert.True(t, isRestrictedToScopes(principal))
+
+	_, e = service.Authenticate(bearerContext(bearer + "0"))
+	assert.Equal(t, invalidApiToken, e)
+
+	_, e = service.Authenticate(bearerContext(ApiTokenPrefix + "token2_secret"))
+	assert.Equal(t, invalidApiToken, e)
+}
+
+func TestApiTokenAuthService_TokenWithoutScopesIsNotRestricted(t *testing.T) {
+	bearer, secretHash, e := NewApiToken("token1")
+	assert.Nil(t, e)
+
+	service := NewApiTokenAuthService(fakeApiTokenStore{
+		"token1": {Id: "token1", Principal: "ci", Groups: []string{"pipelines"}, SecretHash: secretHash},
+	})
+
+	principal, e := service.Authenticate(bearerContext(bearer))
+	assert.Nil(t, e)
+	assert.False(t, isRestrictedToScopes(principal))
+}
+
+func TestApiTokenAuthService_RejectsExpiredToken(t *testing.T) {
+	bearer, secretHash, e := NewApiToken("token1")
+	assert.Nil(t, e)
+
+	expired := time.Now().Add(-time.Minute)
+	service := NewApiTokenAuthService(fakeApiTokenStore{
+		"token1": {Id: "token1", Principal: "ci", SecretHash: secretHash, Expires: &expired},
+	})
+
+	_, e = service.Authenticate(bearerContext(bearer))
+	assert.Equal(t, invalidApiToken, e)
+}
+
+func TestApiTokenAuthService_IgnoresOtherCredentials(t *testing.T) {
+	service := NewApiTokenAuthService(fakeApiTokenStore{})
+
+	_, e := service.Authenticate(bearerContext("eyJhbGciOiJSUzI1NiJ9.e30.signature"))
+	assert.Equal(t, missingCredentials, e)
+
+	_, e = service.Authenticate(metadata.NewIncomingContext(context.Background(), basicPassword("root", "toor")))
+	assert.Equal(t, missingCredentials, e)
+
+	_, e = service.Authenticate(context.Background())
+	assert.Equal(t, missingCredentials, e)
+}
+
+func bearerContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+token))
+}
@@ -8,10 +8,12 @@ import (
 
 type AuthConfig struct {
 	AnonymousAuth bool
+	ApiTokenAuth  bool // Accept api tokens issued by armada server, supported only by armada server
 
-	BasicAuth  BasicAuthenticationConfig
-	OpenIdAuth OpenIdAuthenticationConfig
-	Kerberos   KerberosAuthenticationConfig
+	BasicAuth      BasicAuthenticationConfig
+	OpenIdAuth     OpenIdAuthenticationConfig
+	Kerberos       KerberosAuthenticationConfig
+	ClientCertAuth ClientCertAuthenticationConfig
 
 	PermissionGroupMapping map[permission.Permission][]string
 	PermissionScopeMapping map[permission.Permission][]string
@@ -38,6 +40,26 @@ type BasicAuthenticationConfig struct {
 	Users map[string]UserInfo
 }
 
+// ClientCertAuthenticationConfig enables mutual TLS, grpc server serves the certificate and verifies client certificates
+// signed by the client CA. Clients without certificate can still authenticate with other methods.
+type ClientCertAuthenticationConfig struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+
+	// Part of the certificate used as principal name: commonName (default), dnsName, uri or email.
+	// Subject alternative names are used only when the certificate has exactly one name of the type.
+	PrincipalFrom string
+	// Organizational units of certificate subject are added to groups of the principal
+	GroupsFromOrganizationalUnit bool
+	// Groups assigned to principals, map of principal name -> groups
+	Groups map[string][]string
+}
+
+func (c ClientCertAuthenticationConfig) Enabled() bool {
+	return c.ClientCAFile != ""
+}
+
 type KerberosAuthenticationConfig struct {
 	KeytabLocation  string
 	PrincipalName   string
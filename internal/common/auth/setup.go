@@ -2,16 +2,40 @@ package auth
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc/credentials"
 
 	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/common/auth/authorization/groups"
 	"github.com/G-Research/armada/internal/common/auth/configuration"
 )
 
-func ConfigureAuth(config configuration.AuthConfig) []authorization.AuthService {
+// ConfigureAuth creates auth services enabled in config, apiTokens is required only when api token authentication is enabled
+func ConfigureAuth(config configuration.AuthConfig, apiTokens authorization.ApiTokenStore) []authorization.AuthService {
 	authServices := []authorization.AuthService{}
 
+	// Client certificates are verified by TLS handshake, so they are checked first
+	if config.ClientCertAuth.Enabled() {
+		clientCertAuthService, err := authorization.NewClientCertAuthService(&config.ClientCertAuth)
+		if err != nil {
+			panic(err)
+		}
+		authServices = append(authServices, clientCertAuthService)
+	}
+
+	// Api tokens are bearer tokens, they have to be checked before Open Id tokens
+	if config.ApiTokenAuth {
+		if apiTokens == nil {
+			panic(errors.New("Api token authentication is not supported by this component"))
+		}
+		authServices = append(authServices, authorization.NewApiTokenAuthService(apiTokens))
+	}
+
 	if len(config.BasicAuth.Users) > 0 {
 		authServices = append(authServices,
 			authorization.NewBasicAuthService(config.BasicAuth.Users))
@@ -49,3 +73,38 @@ func ConfigureAuth(config configuration.AuthConfig) []authorization.AuthService
 
 	return authServices
 }
+
+// ConfigureServerCredentials creates TLS credentials verifying client certificates, returns nil when client certificate
+// authentication is disabled and server should not terminate TLS itself
+func ConfigureServerCredentials(config configuration.AuthConfig) (credentials.TransportCredentials, error) {
+	if !config.ClientCertAuth.Enabled() {
+		return nil, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(config.ClientCertAuth.CertFile, config.ClientCertAuth.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	clientCAs, err := loadCertPool(config.ClientCertAuth.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    clientCAs,
+		// Clients without certificate authenticate with other methods
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}), nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
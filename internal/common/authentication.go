@@ -23,3 +23,18 @@ func (c *LoginCredentials) GetRequestMetadata(context.Context, ...string) (map[s
 func (c *LoginCredentials) RequireTransportSecurity() bool {
 	return false
 }
+
+// TokenCredentials authenticate requests with a static bearer token, e.g. an api token issued by armada server
+type TokenCredentials struct {
+	Token string
+}
+
+func (c *TokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "bearer " + c.Token,
+	}, nil
+}
+
+func (c *TokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
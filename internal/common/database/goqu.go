@@ -0,0 +1,10 @@
+package database
+
+import "github.com/doug-martin/goqu/v9"
+
+// GoquDatabase is satisfied by both goqu.Database and goqu.TxDatabase
+type GoquDatabase interface {
+	From(cols ...interface{}) *goqu.SelectDataset
+	Select(cols ...interface{}) *goqu.SelectDataset
+	Insert(table interface{}) *goqu.InsertDataset
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"bytes"
+	"database/sql"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type migration struct {
+	id   int
+	name string
+	sql  string
+}
+
+// UpdateDatabase applies migrations from the file system which are newer than the version stored in versionSequence,
+// migration files are named {id}_{name}.sql
+func UpdateDatabase(db *sql.DB, vfs http.FileSystem, versionSequence string) error {
+	log.Info("Updating database...")
+	version, err := readVersion(db, versionSequence)
+	log.Infof("Current version %v", version)
+
+	if err != nil {
+		return err
+	}
+
+	migrations, err := getMigrations(vfs)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.id > version {
+			log.Infof("Migration %v", m.name)
+
+			_, err := db.Exec(m.sql)
+			if err != nil {
+				return err
+			}
+
+			version = m.id
+			err = setVersion(db, versionSequence, version)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	log.Info("Database updated.")
+	return nil
+}
+
+func readVersion(db *sql.DB, versionSequence string) (int, error) {
+	result, err := db.Query(
+		`CREATE SEQUENCE IF NOT EXISTS ` + versionSequence + ` START WITH 0 MINVALUE 0;
+		SELECT last_value FROM ` + versionSequence)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int
+	result.Next()
+	err = result.Scan(&version)
+
+	return version, err
+}
+
+func setVersion(db *sql.DB, versionSequence string, version int) error {
+	_, err := db.Exec(`SELECT setval($1::regclass, $2)`, versionSequence, version)
+	return err
+}
+
+func getMigrations(vfs http.FileSystem) ([]migration, error) {
+	dir, err := vfs.Open("/")
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := dir.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Name() < files[j].Name() })
+
+	migrations := []migration{}
+	for _, f := range files {
+		file, err := vfs.Open("/" + f.Name())
+		if err != nil {
+			return nil, err
+		}
+		buf := new(bytes.Buffer)
+		_, err = buf.ReadFrom(file)
+		if err != nil {
+			return nil, err
+		}
+		id, err := strconv.Atoi(strings.Split(f.Name(), "_")[0])
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration{
+			id:   id,
+			name: f.Name(),
+			sql:  buf.String(),
+		})
+	}
+	return migrations, nil
+}
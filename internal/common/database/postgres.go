@@ -1,16 +1,22 @@
-package postgres
+package database
 
 import (
 	"database/sql"
 	"strings"
+	"time"
 
 	_ "github.com/lib/pq"
-
-	"github.com/G-Research/armada/internal/lookout/configuration"
 )
 
-func Open(config configuration.PostgresConfig) (*sql.DB, error) {
-	db, err := sql.Open("postgres", createConnectionString(config.Connection))
+type PostgresConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	Connection      map[string]string
+}
+
+func OpenPostgres(config PostgresConfig) (*sql.DB, error) {
+	db, err := sql.Open("postgres", CreateConnectionString(config.Connection))
 	if err != nil {
 		return nil, err
 	}
@@ -22,7 +28,7 @@ func Open(config configuration.PostgresConfig) (*sql.DB, error) {
 	return db, nil
 }
 
-func createConnectionString(values map[string]string) string {
+func CreateConnectionString(values map[string]string) string {
 	// https://www.postgresql.org/docs/10/libpq-connect.html#id-1.7.3.8.3.5
 	result := ""
 	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
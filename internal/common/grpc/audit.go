@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// AuditSink stores records of audited calls
+type AuditSink interface {
+	Write(record *api.AuditRecord) error
+}
+
+// AuditTarget describes what the audited call changed
+type AuditTarget struct {
+	Queue    string
+	JobSetId string
+	JobIds   []string
+}
+
+// AuditTargetExtractor extracts target of the call from its request and response, response is nil when the call failed
+type AuditTargetExtractor func(request interface{}, response interface{}) AuditTarget
+
+// AuditUnaryServerInterceptor records calls of methods in auditedMethods (keyed by full method name) to the sink.
+// It has to be chained after authentication, so the principal is known.
+func AuditUnaryServerInterceptor(sink AuditSink, auditedMethods map[string]AuditTargetExtractor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		extractTarget, audited := auditedMethods[info.FullMethod]
+		if !audited {
+			return handler(ctx, request)
+		}
+
+		response, err := handler(ctx, request)
+
+		var target AuditTarget
+		if err != nil {
+			target = extractTarget(request, nil)
+		} else {
+			target = extractTarget(request, response)
+		}
+		WriteAuditRecord(sink, NewAuditRecord(ctx, info.FullMethod, err, target))
+		return response, err
+	}
+}
+
+// NewAuditRecord creates record of the call by principal authenticated in the context, err is the outcome of the call
+func NewAuditRecord(ctx context.Context, method string, err error, target AuditTarget) *api.AuditRecord {
+	principal := authorization.GetPrincipal(ctx)
+	groups := principal.GetGroupNames()
+	sort.Strings(groups)
+
+	callStatus := status.Convert(err)
+	return &api.AuditRecord{
+		Time:      time.Now().UTC(),
+		Principal: principal.GetName(),
+		Groups:    groups,
+		Method:    method,
+		Queue:     target.Queue,
+		JobSetId:  target.JobSetId,
+		JobIds:    target.JobIds,
+		Code:      callStatus.Code().String(),
+		Error:     callStatus.Message(),
+	}
+}
+
+// WriteAuditRecord writes the record to the sink, failures are only logged so they don't affect the audited call
+func WriteAuditRecord(sink AuditSink, record *api.AuditRecord) {
+	if e := sink.Write(record); e != nil {
+		log.Errorf("Failed to write audit record of %s by %s: %v", record.Method, record.Principal, e)
+	}
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type fakeAuditSink struct {
+	records []*api.AuditRecord
+}
+
+func (s *fakeAuditSink) Write(record *api.AuditRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+var testAuditedMethods = map[string]AuditTargetExtractor{
+	"/api.Submit/CancelJobs": func(request interface{}, response interface{}) AuditTarget {
+		target := AuditTarget{Queue: request.(*api.JobCancelRequest).Queue}
+		if response != nil {
+			target.JobIds = response.(*api.CancellationResult).CancelledIds
+		}
+		return target
+	},
+}
+
+func TestAuditUnaryServerInterceptor_RecordsCallOfAuditedMethod(t *testing.T) {
+	sink := &fakeAuditSink{}
+	interceptor := AuditUnaryServerInterceptor(sink, testAuditedMethods)
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{"teamA"}))
+
+	_, e := interceptor(ctx, &api.JobCancelRequest{Queue: "queue1"}, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/CancelJobs"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &api.CancellationResult{CancelledIds: []string{"job1"}}, nil
+		})
+	assert.Nil(t, e)
+
+	assert.Equal(t, 1, len(sink.records))
+	record := sink.records[0]
+	assert.Equal(t, "alice", record.Principal)
+	assert.Equal(t, []string{authorization.EveryoneGroup, "teamA"}, record.Groups)
+	assert.Equal(t, "/api.Submit/CancelJobs", record.Method)
+	assert.Equal(t, "queue1", record.Queue)
+	assert.Equal(t, []string{"job1"}, record.JobIds)
+	assert.Equal(t, "OK", record.Code)
+	assert.Equal(t, "", record.Error)
+}
+
+func TestAuditUnaryServerInterceptor_RecordsFailedCall(t *testing.T) {
+	sink := &fakeAuditSink{}
+	interceptor := AuditUnaryServerInterceptor(sink, testAuditedMethods)
+
+	_, e := interceptor(context.Background(), &api.JobCancelRequest{Queue: "queue1"}, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/CancelJobs"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Errorf(codes.PermissionDenied, "no permission")
+		})
+	assert.Equal(t, codes.PermissionDenied, status.Code(e))
+
+	assert.Equal(t, 1, len(sink.records))
+	assert.Equal(t, "anonymous", sink.records[0].Principal)
+	assert.Equal(t, "PermissionDenied", sink.records[0].Code)
+	assert.Equal(t, "no permission", sink.records[0].Error)
+}
+
+func TestAuditUnaryServerInterceptor_IgnoresOtherMethods(t *testing.T) {
+	sink := &fakeAuditSink{}
+	interceptor := AuditUnaryServerInterceptor(sink, testAuditedMethods)
+
+	_, e := interceptor(context.Background(), &api.QueueInfoRequest{Name: "queue1"}, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/GetQueueInfo"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &api.QueueInfo{}, nil
+		})
+	assert.Nil(t, e)
+	assert.Empty(t, sink.records)
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"path"
@@ -11,6 +12,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/jcmturner/gokrb5/v8/spnego"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/G-Research/armada/internal/common"
 	protoutil "github.com/G-Research/armada/internal/common/grpc/protoutils"
@@ -20,11 +22,12 @@ import (
 func ServeGateway(
 	port uint16,
 	grpcPort uint16,
+	grpcTls bool,
 	corsAllowedOrigins []string,
 	spec string,
 	handlers ...func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error) (shutdown func()) {
 
-	mux, shutdownGateway := CreateGatewayHandler(grpcPort, "/", corsAllowedOrigins, spec, handlers...)
+	mux, shutdownGateway := CreateGatewayHandler(grpcPort, grpcTls, "/", corsAllowedOrigins, spec, handlers...)
 	cancel := common.ServeHttp(port, mux)
 
 	return func() {
@@ -33,8 +36,10 @@ func ServeGateway(
 	}
 }
 
+// CreateGatewayHandler creates http handler proxying requests to local grpc server, grpcTls has to be set when the grpc server terminates TLS
 func CreateGatewayHandler(
 	grpcPort uint16,
+	grpcTls bool,
 	apiBasePath string,
 	corsAllowedOrigins []string,
 	spec string,
@@ -57,7 +62,7 @@ func CreateGatewayHandler(
 			return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
 		}))
 
-	conn, err := grpc.DialContext(connectionCtx, grpcAddress, grpc.WithInsecure())
+	conn, err := grpc.DialContext(connectionCtx, grpcAddress, LocalTransportCredentials(grpcTls))
 	if err != nil {
 		panic(err)
 	}
@@ -78,6 +83,16 @@ func CreateGatewayHandler(
 	}
 }
 
+// LocalTransportCredentials returns credentials for connections to grpc server running in the same process,
+// grpcTls has to be set when the grpc server terminates TLS
+func LocalTransportCredentials(grpcTls bool) grpc.DialOption {
+	if !grpcTls {
+		return grpc.WithInsecure()
+	}
+	// Connections go over loopback, while server certificate is issued for the external name of the server
+	return grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true}))
+}
+
 func allowCORS(h http.Handler, corsAllowedOrigins []string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if origin := r.Header.Get("Origin"); origin != "" && util.ContainsString(corsAllowedOrigins, origin) {
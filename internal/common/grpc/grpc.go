@@ -16,13 +16,19 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 
 	"github.com/G-Research/armada/internal/common/auth/authorization"
 )
 
-func CreateGrpcServer(authServices []authorization.AuthService) *grpc.Server {
+// CreateGrpcServer creates server with common interceptors, additionalUnaryInterceptors are chained after authentication.
+// Server terminates TLS only when serverCredentials are provided.
+func CreateGrpcServer(
+	authServices []authorization.AuthService,
+	serverCredentials credentials.TransportCredentials,
+	additionalUnaryInterceptors ...grpc.UnaryServerInterceptor) *grpc.Server {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{}
 	streamInterceptors := []grpc.StreamServerInterceptor{}
 
@@ -38,6 +44,7 @@ func CreateGrpcServer(authServices []authorization.AuthService) *grpc.Server {
 	authFunction := authorization.CreateMiddlewareAuthFunction(authServices)
 	unaryInterceptors = append(unaryInterceptors, grpc_auth.UnaryServerInterceptor(authFunction))
 	streamInterceptors = append(streamInterceptors, grpc_auth.StreamServerInterceptor(authFunction))
+	unaryInterceptors = append(unaryInterceptors, additionalUnaryInterceptors...)
 
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	unaryInterceptors = append(unaryInterceptors, grpc_prometheus.UnaryServerInterceptor)
@@ -47,12 +54,17 @@ func CreateGrpcServer(authServices []authorization.AuthService) *grpc.Server {
 	unaryInterceptors = append(unaryInterceptors, grpc_recovery.UnaryServerInterceptor(recovery))
 	streamInterceptors = append(streamInterceptors, grpc_recovery.StreamServerInterceptor(recovery))
 
-	return grpc.NewServer(
+	serverOptions := []grpc.ServerOption{
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle: 5 * time.Minute,
 		}),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
+	}
+	if serverCredentials != nil {
+		serverOptions = append(serverOptions, grpc.Creds(serverCredentials))
+	}
+	return grpc.NewServer(serverOptions...)
 }
 
 func Listen(port uint16, grpcServer *grpc.Server, wg *sync.WaitGroup) {
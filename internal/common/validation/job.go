@@ -2,14 +2,37 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/G-Research/armada/pkg/api"
 )
 
 func ValidateJobSubmitRequestItem(request *api.JobSubmitRequestItem) error {
+	if e := validateNotifyUrl(request); e != nil {
+		return e
+	}
 	return validateIngressConfigs(request)
 }
 
+func validateNotifyUrl(item *api.JobSubmitRequestItem) error {
+	notifyUrl, exists := item.Annotations[api.NotifyUrlAnnotation]
+	if !exists {
+		return nil
+	}
+	return ValidateNotificationUrl(notifyUrl)
+}
+
+func ValidateNotificationUrl(notificationUrl string) error {
+	parsed, e := url.Parse(notificationUrl)
+	if e != nil {
+		return fmt.Errorf("invalid notification url %q: %v", notificationUrl, e)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid notification url %q, only absolute http and https urls are supported", notificationUrl)
+	}
+	return nil
+}
+
 func validateIngressConfigs(item *api.JobSubmitRequestItem) error {
 	existingPortSet := make(map[uint32]int)
 
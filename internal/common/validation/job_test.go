@@ -56,3 +56,16 @@ func Test_ValidateJobSubmitRequestItem_WithPortRepeatedInSeperateConfig(t *testi
 	}
 	assert.Error(t, ValidateJobSubmitRequestItem(validIngressConfig))
 }
+
+func Test_ValidateJobSubmitRequestItem_WithNotifyUrl(t *testing.T) {
+	item := &api.JobSubmitRequestItem{
+		Annotations: map[string]string{api.NotifyUrlAnnotation: "https://example.com/hook"},
+	}
+	assert.NoError(t, ValidateJobSubmitRequestItem(item))
+
+	item.Annotations[api.NotifyUrlAnnotation] = "example.com/hook"
+	assert.Error(t, ValidateJobSubmitRequestItem(item))
+
+	item.Annotations[api.NotifyUrlAnnotation] = "ftp://example.com/hook"
+	assert.Error(t, ValidateJobSubmitRequestItem(item))
+}
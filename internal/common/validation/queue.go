@@ -0,0 +1,19 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func ValidateQueueRoleBindings(bindings []*api.QueueRoleBinding) error {
+	for i, binding := range bindings {
+		if _, known := api.QueueRole_name[int32(binding.Role)]; !known || binding.Role == api.QueueRole_NoRole {
+			return fmt.Errorf("role binding with index %d has invalid role %d", i, binding.Role)
+		}
+		if len(binding.Users) == 0 && len(binding.Groups) == 0 {
+			return fmt.Errorf("role binding with index %d grants role %s to no users or groups", i, binding.Role)
+		}
+	}
+	return nil
+}
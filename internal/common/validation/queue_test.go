@@ -0,0 +1,25 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_ValidateQueueRoleBindings(t *testing.T) {
+	assert.NoError(t, ValidateQueueRoleBindings([]*api.QueueRoleBinding{
+		{Role: api.QueueRole_Viewer, Groups: []string{"everyone"}},
+		{Role: api.QueueRole_Admin, Users: []string{"alice"}},
+	}))
+}
+
+func Test_ValidateQueueRoleBindings_WithUnknownRole(t *testing.T) {
+	assert.Error(t, ValidateQueueRoleBindings([]*api.QueueRoleBinding{{Role: api.QueueRole_NoRole, Users: []string{"alice"}}}))
+	assert.Error(t, ValidateQueueRoleBindings([]*api.QueueRoleBinding{{Role: api.QueueRole(42), Users: []string{"alice"}}}))
+}
+
+func Test_ValidateQueueRoleBindings_WithoutSubjects(t *testing.T) {
+	assert.Error(t, ValidateQueueRoleBindings([]*api.QueueRoleBinding{{Role: api.QueueRole_Operator}}))
+}
@@ -3,23 +3,28 @@ package lookout
 import (
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	_ "github.com/lib/pq"
+	"github.com/segmentio/kafka-go"
 	log "github.com/sirupsen/logrus"
 
-	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/auth"
+	"github.com/G-Research/armada/internal/common/database"
 	"github.com/G-Research/armada/internal/common/grpc"
 	stanUtil "github.com/G-Research/armada/internal/common/stan-util"
+	"github.com/G-Research/armada/internal/common/task"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/internal/lookout/configuration"
 	"github.com/G-Research/armada/internal/lookout/events"
 	"github.com/G-Research/armada/internal/lookout/metrics"
-	"github.com/G-Research/armada/internal/lookout/postgres"
 	"github.com/G-Research/armada/internal/lookout/repository"
 	"github.com/G-Research/armada/internal/lookout/server"
+	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/api/lookout"
+	"github.com/G-Research/armada/pkg/client"
 )
 
 type LogRusLogger struct{}
@@ -33,9 +38,13 @@ func StartUp(config configuration.LookoutConfiguration) (func(), *sync.WaitGroup
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 
-	grpcServer := grpc.CreateGrpcServer([]authorization.AuthService{&authorization.AnonymousAuthService{}})
+	serverCredentials, err := auth.ConfigureServerCredentials(config.Auth)
+	if err != nil {
+		panic(err)
+	}
+	grpcServer := grpc.CreateGrpcServer(auth.ConfigureAuth(config.Auth, nil), serverCredentials)
 
-	db, err := postgres.Open(config.Postgres)
+	db, err := database.OpenPostgres(config.Postgres)
 	if err != nil {
 		panic(err)
 	}
@@ -45,23 +54,21 @@ func StartUp(config configuration.LookoutConfiguration) (func(), *sync.WaitGroup
 
 	jobStore := repository.NewSQLJobStore(goquDb, config.UIConfig.UserAnnotationPrefix)
 	jobRepository := repository.NewSQLJobRepository(goquDb, &repository.DefaultClock{})
+	savedViewRepository := repository.NewSQLSavedViewRepository(goquDb, &repository.DefaultClock{})
 
-	conn, err := stanUtil.DurableConnect(
-		config.Nats.ClusterID,
-		"armada-server-"+util.NewULID(),
-		strings.Join(config.Nats.Servers, ","),
-	)
-
-	if err != nil {
-		panic(err)
+	var stopEventProcessing func()
+	if len(config.Kafka.Brokers) > 0 {
+		stopEventProcessing = startKafkaEventProcessing(config, jobStore)
+	} else {
+		stopEventProcessing = startNatsEventProcessing(config, jobStore)
 	}
-	eventProcessor := events.NewEventProcessor(conn, jobStore, config.Nats.Subject, config.Nats.QueueGroup)
-	eventProcessor.Start()
 
 	dbMetricsProvider := metrics.NewLookoutSqlDbMetricsProvider(db, config.Postgres)
 	metrics.ExposeLookoutMetrics(dbMetricsProvider)
 
-	lookoutServer := server.NewLookoutServer(jobRepository)
+	submitClient, closeSubmitClient := createSubmitClient(config.ArmadaApi)
+
+	lookoutServer := server.NewLookoutServer(jobRepository, savedViewRepository, submitClient)
 	lookout.RegisterLookoutServer(grpcServer, lookoutServer)
 
 	grpc_prometheus.Register(grpcServer)
@@ -69,11 +76,9 @@ func StartUp(config configuration.LookoutConfiguration) (func(), *sync.WaitGroup
 	grpc.Listen(config.GrpcPort, grpcServer, wg)
 
 	stop := func() {
-		err := conn.Close()
-		if err != nil {
-			log.Errorf("failed to close nats connection: %v", err)
-		}
-		err = db.Close()
+		stopEventProcessing()
+		closeSubmitClient()
+		err := db.Close()
 		if err != nil {
 			log.Errorf("failed to close db connection: %v", err)
 		}
@@ -82,3 +87,82 @@ func StartUp(config configuration.LookoutConfiguration) (func(), *sync.WaitGroup
 
 	return stop, wg
 }
+
+func createSubmitClient(config configuration.ArmadaApiConfig) (api.SubmitClient, func()) {
+	if config.Url == "" {
+		return nil, func() {}
+	}
+
+	conn, err := client.CreateApiConnection(&client.ApiConnectionDetails{
+		ArmadaUrl:  config.Url,
+		ForceTls:   config.Tls,
+		ForceNoTls: !config.Tls,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return api.NewSubmitClient(conn), func() {
+		err := conn.Close()
+		if err != nil {
+			log.Errorf("failed to close armada api connection: %v", err)
+		}
+	}
+}
+
+func startNatsEventProcessing(config configuration.LookoutConfiguration, jobStore repository.BatchJobRecorder) func() {
+	conn, err := stanUtil.DurableConnect(
+		config.Nats.ClusterID,
+		"armada-server-"+util.NewULID(),
+		strings.Join(config.Nats.Servers, ","),
+	)
+
+	if err != nil {
+		panic(err)
+	}
+	eventProcessor := events.NewEventProcessor(
+		conn,
+		jobStore,
+		config.Nats.Subject,
+		config.Nats.QueueGroup,
+		config.EventProcessing.BatchSize,
+		config.EventProcessing.BatchTimeout)
+	eventProcessor.Start()
+
+	return func() {
+		err := conn.Close()
+		if err != nil {
+			log.Errorf("failed to close nats connection: %v", err)
+		}
+	}
+}
+
+func startKafkaEventProcessing(config configuration.LookoutConfiguration, jobStore repository.BatchJobRecorder) func() {
+	log.Infof("Using Kafka for events (%+v)", config.Kafka)
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  config.Kafka.Brokers,
+		GroupID:  config.Kafka.ConsumerGroupID,
+		Topic:    config.Kafka.Topic,
+		MaxWait:  500 * time.Millisecond,
+		MinBytes: 0,    // return available messages without waiting for more data
+		MaxBytes: 10e6, // 10MB
+	})
+
+	eventProcessor := events.NewKafkaEventProcessor(
+		reader,
+		jobStore,
+		config.EventProcessing.BatchSize,
+		config.EventProcessing.BatchTimeout,
+		config.Kafka.MaxRetries)
+
+	taskManager := task.NewBackgroundTaskManager(metrics.MetricPrefix)
+	taskManager.Register(eventProcessor.ProcessEvents, 100*time.Millisecond, "kafka_event_processor")
+
+	return func() {
+		taskManager.StopAll(time.Second * 2)
+		err := reader.Close()
+		if err != nil {
+			log.Errorf("failed to close kafka reader: %v", err)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package configuration
 
-import "time"
+import (
+	"time"
+
+	authConfiguration "github.com/G-Research/armada/internal/common/auth/configuration"
+	"github.com/G-Research/armada/internal/common/database"
+)
 
 type NatsConfig struct {
 	Servers    []string
@@ -9,17 +14,33 @@ type NatsConfig struct {
 	QueueGroup string
 }
 
+type KafkaConfig struct {
+	Brokers         []string
+	Topic           string
+	ConsumerGroupID string
+	// Number of times a message which failed to be recorded is retried before it is skipped, 0 retries it until it is recorded
+	MaxRetries int
+}
+
+type EventProcessingConfig struct {
+	// Maximum number of events recorded in a single database transaction
+	BatchSize int
+	// Maximum time to wait for a batch to fill up before recording it
+	BatchTimeout time.Duration
+}
+
 type LookoutUIConfig struct {
 	ArmadaApiBaseUrl         string
 	UserAnnotationPrefix     string
 	BinocularsBaseUrlPattern string
 }
 
-type PostgresConfig struct {
-	MaxOpenConns    int
-	MaxIdleConns    int
-	ConnMaxLifetime time.Duration
-	Connection      map[string]string
+type ArmadaApiConfig struct {
+	// Armada gRPC API used to resubmit jobs. Lookout has no credentials of its own, jobs are submitted with
+	// the authorization header of the Lookout user, so Armada checks permissions of the user.
+	Url string
+	// Connect to Armada API over TLS, it has to be set when Armada server terminates TLS
+	Tls bool
 }
 
 type LookoutConfiguration struct {
@@ -27,8 +48,12 @@ type LookoutConfiguration struct {
 	GrpcPort    uint16
 	MetricsPort uint16
 
-	UIConfig LookoutUIConfig
+	Auth      authConfiguration.AuthConfig
+	UIConfig  LookoutUIConfig
+	ArmadaApi ArmadaApiConfig
 
-	Nats     NatsConfig
-	Postgres PostgresConfig
+	Nats            NatsConfig
+	Kafka           KafkaConfig
+	EventProcessing EventProcessingConfig
+	Postgres        database.PostgresConfig
 }
@@ -0,0 +1,167 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type KafkaEventProcessor struct {
+	reader       *kafka.Reader
+	recorder     repository.BatchJobRecorder
+	batchSize    int
+	batchTimeout time.Duration
+	maxRetries   int
+
+	// messages which were fetched but could not be recorded yet, these are retried before fetching new messages
+	pending []kafka.Message
+	// number of failed attempts to record each pending message
+	attempts map[messageOffset]int
+}
+
+type messageOffset struct {
+	partition int
+	offset    int64
+}
+
+func NewKafkaEventProcessor(
+	reader *kafka.Reader,
+	recorder repository.BatchJobRecorder,
+	batchSize int,
+	batchTimeout time.Duration,
+	maxRetries int) *KafkaEventProcessor {
+
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &KafkaEventProcessor{
+		reader:       reader,
+		recorder:     recorder,
+		batchSize:    batchSize,
+		batchTimeout: batchTimeout,
+		maxRetries:   maxRetries,
+		attempts:     map[messageOffset]int{},
+	}
+}
+
+func (p *KafkaEventProcessor) ProcessEvents() {
+	bg := context.Background()
+
+	messages := p.pending
+	if len(messages) == 0 {
+		messages = p.readMessagesBatch(bg)
+	}
+	if len(messages) == 0 {
+		return
+	}
+
+	toRecord := make([]kafka.Message, 0, len(messages))
+	events := make([]api.Event, 0, len(messages))
+	for _, msg := range messages {
+		event, err := unmarshalKafkaEvent(msg)
+		if err != nil {
+			// message can never be processed, skip it
+			log.Errorf("Error while unmarshaling kafka message: %v", err)
+			continue
+		}
+		toRecord = append(toRecord, msg)
+		events = append(events, event)
+	}
+
+	recorded := recordEvents(p.recorder, events)
+
+	failed := []kafka.Message{}
+	for i, msg := range toRecord {
+		if !recorded[i] {
+			failed = append(failed, msg)
+		}
+	}
+	p.pending = p.retryableMessages(failed)
+
+	committable := committableMessages(messages, p.pending)
+	if len(committable) == 0 {
+		return
+	}
+	err := p.reader.CommitMessages(bg, committable...)
+	if err != nil {
+		log.Errorf("Unable to commit messages: %v", err)
+	}
+}
+
+func (p *KafkaEventProcessor) readMessagesBatch(ctx context.Context) []kafka.Message {
+	// Small timeout prevents blocking for long time,
+	// Kafka Reader buffers messages internally, this loop just groups them into batches
+	timeout, cancel := context.WithTimeout(ctx, p.batchTimeout)
+	defer cancel()
+
+	messages := []kafka.Message{}
+	for len(messages) < p.batchSize {
+		msg, err := p.reader.FetchMessage(timeout)
+		if err != nil {
+			if err != context.DeadlineExceeded {
+				log.Errorf("Error while reading kafka message: %v", err)
+			}
+			break
+		}
+		messages = append(messages, msg)
+	}
+	return messages
+}
+
+// retryableMessages returns failed messages which should be retried. Messages which failed more than maxRetries times
+// are logged and skipped, so a single message which can never be recorded does not block the rest of the topic.
+// Zero maxRetries retries messages until they are recorded, so no events are lost when it is not configured.
+func (p *KafkaEventProcessor) retryableMessages(failed []kafka.Message) []kafka.Message {
+	attempts := map[messageOffset]int{}
+	retryable := []kafka.Message{}
+	for _, msg := range failed {
+		key := messageOffset{partition: msg.Partition, offset: msg.Offset}
+		attempts[key] = p.attempts[key] + 1
+		if p.maxRetries > 0 && attempts[key] > p.maxRetries {
+			log.Errorf("Skipping kafka message (partition: %d, offset: %d) after %d failed attempts to record it",
+				msg.Partition, msg.Offset, attempts[key])
+			delete(attempts, key)
+			continue
+		}
+		retryable = append(retryable, msg)
+	}
+	p.attempts = attempts
+	return retryable
+}
+
+func unmarshalKafkaEvent(msg kafka.Message) (api.Event, error) {
+	eventMessage := &api.EventMessage{}
+	err := proto.Unmarshal(msg.Value, eventMessage)
+	if err != nil {
+		return nil, err
+	}
+	return api.UnwrapEvent(eventMessage)
+}
+
+// committableMessages returns messages which can be committed without committing any of the failed messages.
+// Kafka commits offsets per partition, committing a message implicitly commits all earlier messages in its partition,
+// so only messages preceding the first failure in each partition are safe to commit.
+func committableMessages(messages []kafka.Message, failed []kafka.Message) []kafka.Message {
+	firstFailedOffset := map[int]int64{}
+	for _, msg := range failed {
+		offset, exists := firstFailedOffset[msg.Partition]
+		if !exists || msg.Offset < offset {
+			firstFailedOffset[msg.Partition] = msg.Offset
+		}
+	}
+
+	committable := []kafka.Message{}
+	for _, msg := range messages {
+		offset, exists := firstFailedOffset[msg.Partition]
+		if !exists || msg.Offset < offset {
+			committable = append(committable, msg)
+		}
+	}
+	return committable
+}
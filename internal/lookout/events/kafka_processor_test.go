@@ -0,0 +1,60 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommittableMessages_AllMessagesWhenNothingFailed(t *testing.T) {
+	messages := []kafka.Message{
+		{Partition: 0, Offset: 1},
+		{Partition: 0, Offset: 2},
+		{Partition: 1, Offset: 1},
+	}
+
+	assert.Equal(t, messages, committableMessages(messages, []kafka.Message{}))
+}
+
+func TestCommittableMessages_StopsAtFirstFailureInPartition(t *testing.T) {
+	messages := []kafka.Message{
+		{Partition: 0, Offset: 1},
+		{Partition: 0, Offset: 2},
+		{Partition: 0, Offset: 3},
+		{Partition: 1, Offset: 1},
+		{Partition: 1, Offset: 2},
+	}
+	failed := []kafka.Message{
+		{Partition: 0, Offset: 3},
+		{Partition: 0, Offset: 2},
+	}
+
+	expected := []kafka.Message{
+		{Partition: 0, Offset: 1},
+		{Partition: 1, Offset: 1},
+		{Partition: 1, Offset: 2},
+	}
+	assert.Equal(t, expected, committableMessages(messages, failed))
+}
+
+func TestRetryableMessages_SkipsMessagesAfterMaxRetries(t *testing.T) {
+	processor := NewKafkaEventProcessor(nil, nil, 1, 0, 2)
+	first := kafka.Message{Partition: 0, Offset: 1}
+	second := kafka.Message{Partition: 0, Offset: 2}
+
+	assert.Equal(t, []kafka.Message{first}, processor.retryableMessages([]kafka.Message{first}))
+	assert.Equal(t, []kafka.Message{first, second}, processor.retryableMessages([]kafka.Message{first, second}))
+	assert.Equal(t, []kafka.Message{second}, processor.retryableMessages([]kafka.Message{first, second}))
+	assert.Equal(t, []kafka.Message{}, processor.retryableMessages([]kafka.Message{second}))
+	assert.Empty(t, processor.attempts)
+}
+
+func TestRetryableMessages_RetriesForeverWithoutMaxRetries(t *testing.T) {
+	processor := NewKafkaEventProcessor(nil, nil, 1, 0, 0)
+	message := kafka.Message{Partition: 0, Offset: 1}
+
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, []kafka.Message{message}, processor.retryableMessages([]kafka.Message{message}))
+	}
+}
@@ -1,31 +1,63 @@
 package events
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 	"github.com/nats-io/stan.go"
 	stanPb "github.com/nats-io/stan.go/pb"
 	log "github.com/sirupsen/logrus"
 
 	stanUtil "github.com/G-Research/armada/internal/common/stan-util"
+	"github.com/G-Research/armada/internal/lookout/metrics"
 	"github.com/G-Research/armada/internal/lookout/repository"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 type EventProcessor struct {
-	connection *stanUtil.DurableConnection
-	subject    string
-	group      string
-	recorder   repository.JobRecorder
+	connection   *stanUtil.DurableConnection
+	subject      string
+	group        string
+	recorder     repository.BatchJobRecorder
+	batchSize    int
+	batchTimeout time.Duration
+	messages     chan *stan.Msg
 }
 
-func NewEventProcessor(connection *stanUtil.DurableConnection, repository repository.JobRecorder, subject string, group string) *EventProcessor {
-	return &EventProcessor{connection: connection, recorder: repository, subject: subject, group: group}
+func NewEventProcessor(
+	connection *stanUtil.DurableConnection,
+	repository repository.BatchJobRecorder,
+	subject string,
+	group string,
+	batchSize int,
+	batchTimeout time.Duration) *EventProcessor {
+
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &EventProcessor{
+		connection:   connection,
+		recorder:     repository,
+		subject:      subject,
+		group:        group,
+		batchSize:    batchSize,
+		batchTimeout: batchTimeout,
+		messages:     make(chan *stan.Msg, batchSize),
+	}
 }
 
 func (p *EventProcessor) Start() {
+	go p.processBatches()
+
+	maxInflight := stan.DefaultMaxInflight
+	if p.batchSize > maxInflight {
+		maxInflight = p.batchSize
+	}
+
 	err := p.connection.QueueSubscribe(p.subject, p.group,
 		p.handleMessage,
 		stan.SetManualAckMode(),
+		stan.MaxInflight(maxInflight),
 		stan.StartAt(stanPb.StartPosition_LastReceived),
 		stan.DurableName(p.group))
 
@@ -35,51 +67,139 @@ func (p *EventProcessor) Start() {
 }
 
 func (p *EventProcessor) handleMessage(msg *stan.Msg) {
-	// TODO: batching???
-	eventMessage := &api.EventMessage{}
-	err := proto.Unmarshal(msg.Data, eventMessage)
-	if err != nil {
-		log.Errorf("Error while unmarshaling nats message: %v", err)
-	} else {
+	p.messages <- msg
+}
+
+func (p *EventProcessor) processBatches() {
+	for {
+		p.processBatch(p.nextBatch())
+	}
+}
+
+// nextBatch blocks until a message is available, then collects messages until
+// either the batch is full or batchTimeout has passed since the first message arrived
+func (p *EventProcessor) nextBatch() []*stan.Msg {
+	batch := []*stan.Msg{<-p.messages}
+	timeout := time.After(p.batchTimeout)
+	for len(batch) < p.batchSize {
+		select {
+		case msg := <-p.messages:
+			batch = append(batch, msg)
+		case <-timeout:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (p *EventProcessor) processBatch(batch []*stan.Msg) {
+	messages := make([]*stan.Msg, 0, len(batch))
+	events := make([]api.Event, 0, len(batch))
+
+	for _, msg := range batch {
+		eventMessage := &api.EventMessage{}
+		err := proto.Unmarshal(msg.Data, eventMessage)
+		if err != nil {
+			log.Errorf("Error while unmarshaling nats message: %v", err)
+			ack(msg)
+			continue
+		}
 		event, err := api.UnwrapEvent(eventMessage)
 		if err != nil {
 			log.Errorf("Error while unwrapping event message: %v", err)
-			return
+			continue
 		}
-		err = p.processEvent(event)
-		if err != nil {
-			log.Errorf("Error while reporting event from nats: %v (event: %v)", err, eventMessage)
-			return
+		messages = append(messages, msg)
+		events = append(events, event)
+	}
+
+	recorded := recordEvents(p.recorder, events)
+	for i, msg := range messages {
+		if recorded[i] {
+			ack(msg)
 		}
 	}
-	err = msg.Ack()
+}
+
+func ack(msg *stan.Msg) {
+	err := msg.Ack()
 	if err != nil {
 		log.Errorf("Error while ack nats message: %v", err)
 	}
 }
 
-func (p *EventProcessor) processEvent(event api.Event) error {
+// recordEvents records all events in a single batch. If the batch fails, events are recorded one by one,
+// so a single bad event does not prevent the rest from being stored.
+// Returns whether each event was successfully recorded.
+func recordEvents(recorder repository.BatchJobRecorder, events []api.Event) []bool {
+	recorded := make([]bool, len(events))
+	if len(events) == 0 {
+		return recorded
+	}
+
+	err := recorder.RecordBatch(func(batchRecorder repository.JobRecorder) error {
+		for _, event := range events {
+			if err := processEvent(batchRecorder, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err == nil {
+		for i := range recorded {
+			recorded[i] = true
+		}
+	} else {
+		log.Warnf("Error while recording batch of %d events, recording events one by one: %v", len(events), err)
+		for i, event := range events {
+			err := processEvent(recorder, event)
+			if err != nil {
+				log.Errorf("Error while recording event: %v (event: %v)", err, event)
+				continue
+			}
+			recorded[i] = true
+		}
+	}
+
+	recordIngestionLag(events, recorded)
+	return recorded
+}
+
+func recordIngestionLag(events []api.Event, recorded []bool) {
+	var latest time.Time
+	for i, event := range events {
+		if recorded[i] && event.GetCreated().After(latest) {
+			latest = event.GetCreated()
+		}
+	}
+	if !latest.IsZero() {
+		metrics.RecordEventIngestionLag(time.Since(latest))
+	}
+}
+
+func processEvent(recorder repository.JobRecorder, event api.Event) error {
 	switch typed := event.(type) {
 	case *api.JobSubmittedEvent:
-		return p.recorder.RecordJob(&typed.Job)
+		return recorder.RecordJob(&typed.Job)
 
 	case *api.JobQueuedEvent:
 		// this event just attest saving job to redis
 
 	case *api.JobDuplicateFoundEvent:
-		return p.recorder.RecordJobDuplicate(typed)
+		return recorder.RecordJobDuplicate(typed)
 
 	case *api.JobPendingEvent:
-		return p.recorder.RecordJobPending(typed)
+		return recorder.RecordJobPending(typed)
 
 	case *api.JobRunningEvent:
-		return p.recorder.RecordJobRunning(typed)
+		return recorder.RecordJobRunning(typed)
 
 	case *api.JobSucceededEvent:
-		return p.recorder.RecordJobSucceeded(typed)
+		return recorder.RecordJobSucceeded(typed)
 
 	case *api.JobFailedEvent:
-		return p.recorder.RecordJobFailed(typed)
+		return recorder.RecordJobFailed(typed)
 
 	case *api.JobLeasedEvent:
 	case *api.JobLeaseReturnedEvent:
@@ -87,23 +207,24 @@ func (p *EventProcessor) processEvent(event api.Event) error {
 		// TODO record leasing as messages?
 
 	case *api.JobUnableToScheduleEvent:
-		return p.recorder.RecordJobUnableToSchedule(typed)
+		return recorder.RecordJobUnableToSchedule(typed)
 
 	case *api.JobReprioritizedEvent:
-		return p.recorder.RecordJobReprioritized(typed)
+		return recorder.RecordJobReprioritized(typed)
 
 	case *api.JobCancellingEvent: // noop
 	case *api.JobCancelledEvent:
 		// job marked for cancellation
-		return p.recorder.MarkCancelled(typed)
+		return recorder.MarkCancelled(typed)
 
 	case *api.JobTerminatedEvent:
-		return p.recorder.RecordJobTerminated(typed)
+		return recorder.RecordJobTerminated(typed)
 
 	case *api.JobUtilisationEvent:
-		// TODO
+		return recorder.RecordJobUtilisation(typed)
 
 	case *api.JobIngressInfoEvent: // noop
+	case *api.JobSetCompletedEvent: // noop
 	}
 
 	return nil
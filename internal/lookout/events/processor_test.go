@@ -0,0 +1,73 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestRecordEvents_RecordsAllEventsInOneBatch(t *testing.T) {
+	recorder := &fakeRecorder{}
+	events := []api.Event{
+		&api.JobSubmittedEvent{JobId: "a", Job: api.Job{Id: "a"}, Created: time.Now()},
+		&api.JobPendingEvent{JobId: "a", Created: time.Now()},
+	}
+
+	recorded := recordEvents(recorder, events)
+
+	assert.Equal(t, []bool{true, true}, recorded)
+	assert.Equal(t, 1, recorder.batches)
+	assert.Equal(t, []string{"a", "a"}, recorder.recordedJobIds)
+}
+
+func TestRecordEvents_FallsBackToSingleEventsWhenBatchFails(t *testing.T) {
+	recorder := &fakeRecorder{failingJobId: "b"}
+	events := []api.Event{
+		&api.JobPendingEvent{JobId: "a", Created: time.Now()},
+		&api.JobPendingEvent{JobId: "b", Created: time.Now()},
+		&api.JobPendingEvent{JobId: "c", Created: time.Now()},
+	}
+
+	recorded := recordEvents(recorder, events)
+
+	assert.Equal(t, []bool{true, false, true}, recorded)
+	assert.Equal(t, []string{"a", "c"}, recorder.recordedJobIds)
+}
+
+type fakeRecorder struct {
+	repository.JobRecorder
+	failingJobId   string
+	batches        int
+	recordedJobIds []string
+}
+
+func (r *fakeRecorder) RecordBatch(record func(recorder repository.JobRecorder) error) error {
+	r.batches++
+	batch := &fakeRecorder{failingJobId: r.failingJobId}
+	err := record(batch)
+	if err == nil {
+		r.recordedJobIds = append(r.recordedJobIds, batch.recordedJobIds...)
+	}
+	return err
+}
+
+func (r *fakeRecorder) RecordJob(job *api.Job) error {
+	return r.record(job.Id)
+}
+
+func (r *fakeRecorder) RecordJobPending(event *api.JobPendingEvent) error {
+	return r.record(event.JobId)
+}
+
+func (r *fakeRecorder) record(jobId string) error {
+	if jobId == r.failingJobId {
+		return errors.New("failed")
+	}
+	r.recordedJobIds = append(r.recordedJobIds, jobId)
+	return nil
+}
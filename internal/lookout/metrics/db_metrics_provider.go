@@ -3,7 +3,7 @@ package metrics
 import (
 	"database/sql"
 
-	"github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/common/database"
 )
 
 type LookoutDbMetricsProvider interface {
@@ -13,10 +13,10 @@ type LookoutDbMetricsProvider interface {
 
 type LookoutSqlDbMetricsProvider struct {
 	db             *sql.DB
-	postgresConfig configuration.PostgresConfig
+	postgresConfig database.PostgresConfig
 }
 
-func NewLookoutSqlDbMetricsProvider(db *sql.DB, postgresConfig configuration.PostgresConfig) *LookoutSqlDbMetricsProvider {
+func NewLookoutSqlDbMetricsProvider(db *sql.DB, postgresConfig database.PostgresConfig) *LookoutSqlDbMetricsProvider {
 	return &LookoutSqlDbMetricsProvider{
 		db:             db,
 		postgresConfig: postgresConfig,
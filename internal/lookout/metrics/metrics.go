@@ -1,7 +1,10 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
 const MetricPrefix = "lookout_"
@@ -20,6 +23,15 @@ var dbOpenConnectionsUtilizationDesc = prometheus.NewDesc(
 	nil,
 )
 
+var eventIngestionLag = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: MetricPrefix + "event_ingestion_lag_seconds",
+	Help: "Time between creation of the most recent recorded event and it being stored in database",
+})
+
+func RecordEventIngestionLag(lag time.Duration) {
+	eventIngestionLag.Set(lag.Seconds())
+}
+
 type LookoutCollector interface {
 	Describe(desc chan<- *prometheus.Desc)
 	Collect(metrics chan<- prometheus.Metric)
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+const (
+	cpuResource    = "cpu"
+	memoryResource = "memory"
+	gpuResource    = "nvidia.com/gpu"
+
+	bytesInGiB = 1024 * 1024 * 1024
+)
+
+type consumptionRow struct {
+	Queue  string          `db:"queue"`
+	JobSet string          `db:"jobset"`
+	Owner  sql.NullString  `db:"owner"`
+	Runs   sql.NullInt64   `db:"runs"`
+	Cpu    sql.NullFloat64 `db:"cpu_hours"`
+	Memory sql.NullFloat64 `db:"memory_hours"`
+	Gpu    sql.NullFloat64 `db:"gpu_hours"`
+}
+
+type consumptionKey struct {
+	queue  string
+	jobSet string
+	owner  string
+}
+
+func (r *SQLJobRepository) GetResourceConsumption(ctx context.Context, opts *lookout.GetResourceConsumptionRequest) ([]*lookout.ResourceConsumption, error) {
+	from, to := r.consumptionPeriod(opts)
+
+	requestedRows := make([]*consumptionRow, 0)
+	err := r.createRequestedResourcesDataset(opts, from, to).Prepared(true).ScanStructsContext(ctx, &requestedRows)
+	if err != nil {
+		return nil, err
+	}
+
+	usedRows := make([]*consumptionRow, 0)
+	err = r.createUsedResourcesDataset(opts, from, to).Prepared(true).ScanStructsContext(ctx, &usedRows)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeConsumptionRows(requestedRows, usedRows), nil
+}
+
+func (r *SQLJobRepository) consumptionPeriod(opts *lookout.GetResourceConsumptionRequest) (time.Time, time.Time) {
+	from := time.Unix(0, 0)
+	if opts.From != nil {
+		from = *opts.From
+	}
+	to := r.clock.Now()
+	if opts.To != nil && opts.To.Before(to) {
+		to = *opts.To
+	}
+	return ToUTC(from), ToUTC(to)
+}
+
+// Requested resources are counted for the time each run was running within the period
+func (r *SQLJobRepository) createRequestedResourcesDataset(opts *lookout.GetResourceConsumptionRequest, from, to time.Time) *goqu.SelectDataset {
+	runHours := "GREATEST(EXTRACT(EPOCH FROM (LEAST(COALESCE(job_run.finished, ?), ?) - GREATEST(job_run.started, ?))), 0) / 3600"
+
+	return r.goquDb.
+		From(jobRunTable).
+		InnerJoin(jobTable, goqu.On(jobRun_jobId.Eq(job_jobId))).
+		InnerJoin(jobPodRequestTable, goqu.On(
+			jobRun_jobId.Eq(podRequest_jobId),
+			jobRun_podNumber.Eq(podRequest_podNumber))).
+		Select(
+			job_queue,
+			job_jobset,
+			job_owner,
+			goqu.COUNT(goqu.DISTINCT(jobRun_runId)).As("runs"),
+			goqu.L("SUM(job_pod_request.cpu * "+runHours+")", to, to, from).As("cpu_hours"),
+			goqu.L("SUM(job_pod_request.memory * "+runHours+")", to, to, from).As("memory_hours"),
+			goqu.L("SUM(job_pod_request.gpu * "+runHours+")", to, to, from).As("gpu_hours")).
+		Where(goqu.And(append(
+			createConsumptionFilters(opts),
+			jobRun_started.IsNotNull(),
+			jobRun_started.Lt(to),
+			goqu.Or(
+				jobRun_finished.IsNull(),
+				jobRun_finished.Gt(from)))...)).
+		GroupBy(job_queue, job_jobset, job_owner)
+}
+
+// Each utilisation record holds maximum usage over the period since the previous record of the same run,
+// or since the run started for the first record
+func (r *SQLJobRepository) createUsedResourcesDataset(opts *lookout.GetResourceConsumptionRequest, from, to time.Time) *goqu.SelectDataset {
+	utilisationDs := r.goquDb.
+		From(jobRunUtilisationTable).
+		InnerJoin(jobRunTable, goqu.On(utilisation_runId.Eq(jobRun_runId))).
+		Select(
+			jobRun_jobId,
+			utilisation_created,
+			utilisation_cpu,
+			utilisation_memory,
+			utilisation_gpu,
+			goqu.L("COALESCE("+
+				"LAG(job_run_utilisation.created) OVER (PARTITION BY job_run_utilisation.run_id ORDER BY job_run_utilisation.created), "+
+				"job_run.started, "+
+				"job_run_utilisation.created)").As("period_start")).
+		As("utilisation")
+
+	periodHours := "GREATEST(EXTRACT(EPOCH FROM (LEAST(utilisation.created, ?) - GREATEST(utilisation.period_start, ?))), 0) / 3600"
+
+	return r.goquDb.
+		From(utilisationDs).
+		InnerJoin(jobTable, goqu.On(goqu.I("utilisation.job_id").Eq(job_jobId))).
+		Select(
+			job_queue,
+			job_jobset,
+			job_owner,
+			goqu.L("SUM(utilisation.cpu * "+periodHours+")", to, from).As("cpu_hours"),
+			goqu.L("SUM(utilisation.memory * "+periodHours+")", to, from).As("memory_hours"),
+			goqu.L("SUM(utilisation.gpu * "+periodHours+")", to, from).As("gpu_hours")).
+		Where(goqu.And(append(
+			createConsumptionFilters(opts),
+			goqu.I("utilisation.created").Gt(from),
+			goqu.I("utilisation.period_start").Lt(to))...)).
+		GroupBy(job_queue, job_jobset, job_owner)
+}
+
+func createConsumptionFilters(opts *lookout.GetResourceConsumptionRequest) []goqu.Expression {
+	var filters []goqu.Expression
+
+	if opts.Queue != "" {
+		filters = append(filters, job_queue.Eq(opts.Queue))
+	}
+
+	if len(opts.JobSetIds) > 0 {
+		filters = append(filters, job_jobset.In(opts.JobSetIds))
+	}
+
+	if opts.Owner != "" {
+		filters = append(filters, job_owner.Eq(opts.Owner))
+	}
+
+	return filters
+}
+
+func mergeConsumptionRows(requestedRows []*consumptionRow, usedRows []*consumptionRow) []*lookout.ResourceConsumption {
+	consumptionByKey := map[consumptionKey]*lookout.ResourceConsumption{}
+	getConsumption := func(row *consumptionRow) *lookout.ResourceConsumption {
+		key := consumptionKey{queue: row.Queue, jobSet: row.JobSet, owner: ParseNullString(row.Owner)}
+		consumption, exists := consumptionByKey[key]
+		if !exists {
+			consumption = &lookout.ResourceConsumption{
+				Queue:  key.queue,
+				JobSet: key.jobSet,
+				Owner:  key.owner,
+			}
+			consumptionByKey[key] = consumption
+		}
+		return consumption
+	}
+
+	for _, row := range requestedRows {
+		consumption := getConsumption(row)
+		consumption.Runs = uint32(ParseNullInt(row.Runs))
+		consumption.CpuHoursRequested = ParseNullFloat(row.Cpu)
+		consumption.MemoryGibHoursRequested = ParseNullFloat(row.Memory) / bytesInGiB
+		consumption.GpuHoursRequested = ParseNullFloat(row.Gpu)
+	}
+
+	for _, row := range usedRows {
+		consumption := getConsumption(row)
+		consumption.CpuHoursUsed = ParseNullFloat(row.Cpu)
+		consumption.MemoryGibHoursUsed = ParseNullFloat(row.Memory) / bytesInGiB
+		consumption.GpuDutyCycleHoursUsed = ParseNullFloat(row.Gpu)
+	}
+
+	result := make([]*lookout.ResourceConsumption, 0, len(consumptionByKey))
+	for _, consumption := range consumptionByKey {
+		result = append(result, consumption)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Queue != result[j].Queue {
+			return result[i].Queue < result[j].Queue
+		}
+		if result[i].JobSet != result[j].JobSet {
+			return result[i].JobSet < result[j].JobSet
+		}
+		return result[i].Owner < result[j].Owner
+	})
+	return result
+}
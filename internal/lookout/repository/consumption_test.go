@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestGetResourceConsumption_ReturnsRequestedAndUsedResources(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+
+		job := createJobWithResources(t, jobStore, queue, "job-set", "2", "1Gi")
+		runJob(t, jobStore, job, k8sId1, someTime, someTime.Add(2*time.Hour))
+
+		recordUtilisation(t, jobStore, job, k8sId1, someTime.Add(time.Hour), "1", "512Mi")
+		recordUtilisation(t, jobStore, job, k8sId1, someTime.Add(2*time.Hour), "2", "1Gi")
+
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		consumption, err := jobRepo.GetResourceConsumption(ctx, &lookout.GetResourceConsumptionRequest{Queue: queue})
+		require.NoError(t, err)
+		require.Len(t, consumption, 1)
+
+		assert.Equal(t, queue, consumption[0].Queue)
+		assert.Equal(t, "job-set", consumption[0].JobSet)
+		assert.Equal(t, "user", consumption[0].Owner)
+		assert.Equal(t, uint32(1), consumption[0].Runs)
+		assert.InDelta(t, 4, consumption[0].CpuHoursRequested, 0.001)
+		assert.InDelta(t, 2, consumption[0].MemoryGibHoursRequested, 0.001)
+		assert.InDelta(t, 3, consumption[0].CpuHoursUsed, 0.001)
+		assert.InDelta(t, 1.5, consumption[0].MemoryGibHoursUsed, 0.001)
+	})
+}
+
+func TestGetResourceConsumption_ClipsRunsToPeriod(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+
+		job := createJobWithResources(t, jobStore, queue, "job-set", "1", "1Gi")
+		runJob(t, jobStore, job, k8sId1, someTime, someTime.Add(4*time.Hour))
+
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		from := someTime.Add(time.Hour)
+		to := someTime.Add(2 * time.Hour)
+		consumption, err := jobRepo.GetResourceConsumption(ctx, &lookout.GetResourceConsumptionRequest{
+			Queue: queue,
+			From:  &from,
+			To:    &to,
+		})
+		require.NoError(t, err)
+		require.Len(t, consumption, 1)
+		assert.InDelta(t, 1, consumption[0].CpuHoursRequested, 0.001)
+		assert.InDelta(t, 1, consumption[0].MemoryGibHoursRequested, 0.001)
+	})
+}
+
+func TestGetResourceConsumption_GroupsByJobSet(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+
+		job1 := createJobWithResources(t, jobStore, queue, "job-set-1", "1", "1Gi")
+		runJob(t, jobStore, job1, k8sId1, someTime, someTime.Add(time.Hour))
+
+		job2 := createJobWithResources(t, jobStore, queue, "job-set-2", "1", "1Gi")
+		runJob(t, jobStore, job2, k8sId2, someTime, someTime.Add(time.Hour))
+
+		job3 := createJobWithResources(t, jobStore, queue2, "job-set-1", "1", "1Gi")
+		runJob(t, jobStore, job3, k8sId3, someTime, someTime.Add(time.Hour))
+
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		consumption, err := jobRepo.GetResourceConsumption(ctx, &lookout.GetResourceConsumptionRequest{
+			Queue:     queue,
+			JobSetIds: []string{"job-set-2"},
+		})
+		require.NoError(t, err)
+		require.Len(t, consumption, 1)
+		assert.Equal(t, "job-set-2", consumption[0].JobSet)
+
+		consumption, err = jobRepo.GetResourceConsumption(ctx, &lookout.GetResourceConsumptionRequest{})
+		require.NoError(t, err)
+		require.Len(t, consumption, 3)
+		assert.Equal(t, queue, consumption[0].Queue)
+		assert.Equal(t, "job-set-1", consumption[0].JobSet)
+		assert.Equal(t, queue, consumption[1].Queue)
+		assert.Equal(t, "job-set-2", consumption[1].JobSet)
+		assert.Equal(t, queue2, consumption[2].Queue)
+	})
+}
+
+func createJobWithResources(t *testing.T, jobStore JobRecorder, queue string, jobSetId string, cpu string, memory string) *api.Job {
+	job := &api.Job{
+		Id:       util.NewULID(),
+		JobSetId: jobSetId,
+		Queue:    queue,
+		Owner:    "user",
+		Priority: 10,
+		PodSpec: &v1.PodSpec{
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse(cpu),
+						v1.ResourceMemory: resource.MustParse(memory),
+					},
+				},
+			}},
+		},
+		Created: someTime,
+	}
+	assert.NoError(t, jobStore.RecordJob(job))
+	return job
+}
+
+func runJob(t *testing.T, jobStore JobRecorder, job *api.Job, k8sId string, started time.Time, finished time.Time) {
+	assert.NoError(t, jobStore.RecordJobRunning(&api.JobRunningEvent{
+		JobId:        job.Id,
+		JobSetId:     job.JobSetId,
+		Queue:        job.Queue,
+		Created:      started,
+		ClusterId:    cluster,
+		KubernetesId: k8sId,
+		NodeName:     node,
+	}))
+	assert.NoError(t, jobStore.RecordJobSucceeded(&api.JobSucceededEvent{
+		JobId:        job.Id,
+		JobSetId:     job.JobSetId,
+		Queue:        job.Queue,
+		Created:      finished,
+		ClusterId:    cluster,
+		KubernetesId: k8sId,
+		NodeName:     node,
+	}))
+}
+
+func recordUtilisation(t *testing.T, jobStore JobRecorder, job *api.Job, k8sId string, created time.Time, cpu string, memory string) {
+	assert.NoError(t, jobStore.RecordJobUtilisation(&api.JobUtilisationEvent{
+		JobId:        job.Id,
+		JobSetId:     job.JobSetId,
+		Queue:        job.Queue,
+		Created:      created,
+		ClusterId:    cluster,
+		KubernetesId: k8sId,
+		MaxResourcesForPeriod: map[string]resource.Quantity{
+			"cpu":    resource.MustParse(cpu),
+			"memory": resource.MustParse(memory),
+		},
+	}))
+}
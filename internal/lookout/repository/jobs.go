@@ -32,6 +32,42 @@ func (r *SQLJobRepository) GetJobs(ctx context.Context, opts *lookout.GetJobsReq
 	return result, nil
 }
 
+// GetJobSpecs returns jobs as they were submitted, with priority updated by any reprioritization.
+// Jobs which are not found are not returned.
+func (r *SQLJobRepository) GetJobSpecs(ctx context.Context, jobIds []string) ([]*api.Job, error) {
+	if len(jobIds) == 0 {
+		return []*api.Job{}, nil
+	}
+
+	ds := r.goquDb.
+		From(jobTable).
+		Select(job_jobId, job_priority, job_job).
+		Where(job_jobId.In(jobIds))
+
+	rows := make([]*JobRow, 0)
+	err := ds.Prepared(true).ScanStructsContext(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*api.Job, 0, len(rows))
+	for _, row := range rows {
+		if !row.JobJson.Valid {
+			continue
+		}
+		var job api.Job
+		err := json.Unmarshal([]byte(row.JobJson.String), &job)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing job json: %v", err)
+		}
+		if row.Priority.Valid {
+			job.Priority = row.Priority.Float64
+		}
+		jobs = append(jobs, &job)
+	}
+	return jobs, nil
+}
+
 func validateJobStates(jobStates []string) (bool, JobState) {
 	for _, jobState := range jobStates {
 		if !isJobState(jobState) {
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+type SavedViewRepository interface {
+	CreateSavedView(ctx context.Context, owner string, name string, request *lookout.GetJobsRequest) (*lookout.SavedView, error)
+	GetSavedViews(ctx context.Context, owner string) ([]*lookout.SavedView, error)
+	// Returns false if the view does not exist or is not owned by owner
+	DeleteSavedView(ctx context.Context, owner string, id string) (bool, error)
+}
+
+type SQLSavedViewRepository struct {
+	goquDb *goqu.Database
+	clock  Clock
+}
+
+var (
+	savedViewTable = goqu.T("saved_view")
+
+	savedView_viewId  = goqu.I("saved_view.view_id")
+	savedView_owner   = goqu.I("saved_view.owner")
+	savedView_name    = goqu.I("saved_view.name")
+	savedView_request = goqu.I("saved_view.request")
+	savedView_created = goqu.I("saved_view.created")
+)
+
+type savedViewRow struct {
+	ViewId  string    `db:"view_id"`
+	Owner   string    `db:"owner"`
+	Name    string    `db:"name"`
+	Request string    `db:"request"`
+	Created time.Time `db:"created"`
+}
+
+func NewSQLSavedViewRepository(db *goqu.Database, clock Clock) *SQLSavedViewRepository {
+	return &SQLSavedViewRepository{goquDb: db, clock: clock}
+}
+
+// CreateSavedView saves request under name, replacing the request of an existing view with the same name and owner
+func (r *SQLSavedViewRepository) CreateSavedView(ctx context.Context, owner string, name string, request *lookout.GetJobsRequest) (*lookout.SavedView, error) {
+	requestJson, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := r.goquDb.Insert(savedViewTable).
+		Rows(goqu.Record{
+			"view_id": util.NewULID(),
+			"owner":   owner,
+			"name":    name,
+			"request": requestJson,
+			"created": ToUTC(r.clock.Now()),
+		}).
+		OnConflict(goqu.DoUpdate("owner, name", goqu.Record{"request": goqu.L("EXCLUDED.request")})).
+		Returning(savedView_viewId, savedView_owner, savedView_name, savedView_request, savedView_created)
+
+	var row savedViewRow
+	_, err = ds.Prepared(true).Executor().ScanStructContext(ctx, &row)
+	if err != nil {
+		return nil, err
+	}
+	return savedViewFromRow(&row)
+}
+
+func (r *SQLSavedViewRepository) GetSavedViews(ctx context.Context, owner string) ([]*lookout.SavedView, error) {
+	ds := r.goquDb.
+		From(savedViewTable).
+		Select(savedView_viewId, savedView_owner, savedView_name, savedView_request, savedView_created).
+		Where(savedView_owner.Eq(owner)).
+		Order(savedView_name.Asc())
+
+	rows := make([]*savedViewRow, 0)
+	err := ds.Prepared(true).ScanStructsContext(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]*lookout.SavedView, 0, len(rows))
+	for _, row := range rows {
+		view, err := savedViewFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
+
+func (r *SQLSavedViewRepository) DeleteSavedView(ctx context.Context, owner string, id string) (bool, error) {
+	ds := r.goquDb.
+		Delete(savedViewTable).
+		Where(savedView_viewId.Eq(id), savedView_owner.Eq(owner))
+
+	result, err := ds.Prepared(true).Executor().ExecContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+func savedViewFromRow(row *savedViewRow) (*lookout.SavedView, error) {
+	var request lookout.GetJobsRequest
+	err := json.Unmarshal([]byte(row.Request), &request)
+	if err != nil {
+		return nil, err
+	}
+	created := row.Created
+	return &lookout.SavedView{
+		Id:      row.ViewId,
+		Name:    row.Name,
+		Owner:   row.Owner,
+		Request: &request,
+		Created: &created,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestSavedViews_CreateAndGet(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		viewRepo := NewSQLSavedViewRepository(db, &DummyClock{someTime})
+
+		request := &lookout.GetJobsRequest{
+			Queue:           queue,
+			JobStates:       []string{string(JobFailed)},
+			UserAnnotations: map[string]string{"team": "a"},
+		}
+		created, err := viewRepo.CreateSavedView(ctx, "user", "failed jobs", request)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, created.Id)
+		assert.Equal(t, "failed jobs", created.Name)
+		assert.Equal(t, "user", created.Owner)
+		assert.Equal(t, request, created.Request)
+		AssertTimesApproxEqual(t, &someTime, created.Created)
+
+		_, err = viewRepo.CreateSavedView(ctx, "other-user", "other", &lookout.GetJobsRequest{})
+		assert.NoError(t, err)
+
+		views, err := viewRepo.GetSavedViews(ctx, "user")
+		assert.NoError(t, err)
+		assert.Len(t, views, 1)
+		assert.Equal(t, created.Id, views[0].Id)
+		assert.Equal(t, request, views[0].Request)
+	})
+}
+
+func TestSavedViews_CreateWithExistingNameReplacesRequest(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		viewRepo := NewSQLSavedViewRepository(db, &DefaultClock{})
+
+		first, err := viewRepo.CreateSavedView(ctx, "user", "view", &lookout.GetJobsRequest{Queue: queue})
+		assert.NoError(t, err)
+
+		second, err := viewRepo.CreateSavedView(ctx, "user", "view", &lookout.GetJobsRequest{Queue: queue2})
+		assert.NoError(t, err)
+		assert.Equal(t, first.Id, second.Id)
+
+		views, err := viewRepo.GetSavedViews(ctx, "user")
+		assert.NoError(t, err)
+		assert.Len(t, views, 1)
+		assert.Equal(t, queue2, views[0].Request.Queue)
+	})
+}
+
+func TestSavedViews_Delete(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		viewRepo := NewSQLSavedViewRepository(db, &DefaultClock{})
+
+		view, err := viewRepo.CreateSavedView(ctx, "user", "view", &lookout.GetJobsRequest{Queue: queue})
+		assert.NoError(t, err)
+
+		deleted, err := viewRepo.DeleteSavedView(ctx, "other-user", view.Id)
+		assert.NoError(t, err)
+		assert.False(t, deleted)
+
+		deleted, err = viewRepo.DeleteSavedView(ctx, "user", view.Id)
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+
+		views, err := viewRepo.GetSavedViews(ctx, "user")
+		assert.NoError(t, err)
+		assert.Empty(t, views)
+	})
+}
@@ -0,0 +1,21 @@
+-- resources requested by each pod of a job, runs are matched by pod number
+CREATE TABLE job_pod_request (
+    job_id     varchar(32) NOT NULL,
+    pod_number int         NOT NULL,
+    cpu        float       NOT NULL, -- cores
+    memory     float       NOT NULL, -- bytes
+    gpu        float       NOT NULL,
+    PRIMARY KEY (job_id, pod_number)
+);
+
+-- maximum resources used by a run over the period ending at created
+CREATE TABLE job_run_utilisation (
+    run_id  varchar(36) NOT NULL,
+    created timestamp   NOT NULL,
+    cpu     float       NOT NULL, -- cores
+    memory  float       NOT NULL, -- bytes
+    gpu     float       NOT NULL, -- accelerator duty cycle
+    PRIMARY KEY (run_id, created)
+);
+
+CREATE INDEX idx_job_run_started ON job_run (started);
@@ -0,0 +1,10 @@
+-- named GetJobsRequest filters saved by each user
+CREATE TABLE saved_view (
+    view_id varchar(32)  NOT NULL PRIMARY KEY,
+    owner   varchar(512) NOT NULL,
+    name    varchar(512) NOT NULL,
+    request jsonb        NOT NULL,
+    created timestamp    NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_saved_view_owner_name ON saved_view (owner, name);
@@ -8,6 +8,7 @@ import (
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
 	"github.com/lib/pq"
 
+	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/api/lookout"
 )
 
@@ -29,6 +30,8 @@ type JobRepository interface {
 	GetQueueInfos(ctx context.Context) ([]*lookout.QueueInfo, error)
 	GetJobSetInfos(ctx context.Context, opts *lookout.GetJobSetsRequest) ([]*lookout.JobSetInfo, error)
 	GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) ([]*lookout.JobInfo, error)
+	GetJobSpecs(ctx context.Context, jobIds []string) ([]*api.Job, error)
+	GetResourceConsumption(ctx context.Context, opts *lookout.GetResourceConsumptionRequest) ([]*lookout.ResourceConsumption, error)
 }
 
 type SQLJobRepository struct {
@@ -42,6 +45,8 @@ var (
 	jobRunTable               = goqu.T("job_run")
 	jobRunContainerTable      = goqu.T("job_run_container")
 	userAnnotationLookupTable = goqu.T("user_annotation_lookup")
+	jobPodRequestTable        = goqu.T("job_pod_request")
+	jobRunUtilisationTable    = goqu.T("job_run_utilisation")
 
 	// Columns: job table
 	job_jobId     = goqu.I("job.job_id")
@@ -71,6 +76,17 @@ var (
 	annotation_jobId = goqu.I("user_annotation_lookup.job_id")
 	annotation_key   = goqu.I("user_annotation_lookup.key")
 	annotation_value = goqu.I("user_annotation_lookup.value")
+
+	// Columns: pod request table
+	podRequest_jobId     = goqu.I("job_pod_request.job_id")
+	podRequest_podNumber = goqu.I("job_pod_request.pod_number")
+
+	// Columns: utilisation table
+	utilisation_runId   = goqu.I("job_run_utilisation.run_id")
+	utilisation_created = goqu.I("job_run_utilisation.created")
+	utilisation_cpu     = goqu.I("job_run_utilisation.cpu")
+	utilisation_memory  = goqu.I("job_run_utilisation.memory")
+	utilisation_gpu     = goqu.I("job_run_utilisation.gpu")
 )
 
 type JobRow struct {
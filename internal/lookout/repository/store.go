@@ -10,7 +10,9 @@ import (
 	"github.com/doug-martin/goqu/v9/exp"
 	_ "github.com/lib/pq"
 
-	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/database"
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -26,10 +28,19 @@ type JobRecorder interface {
 	RecordJobDuplicate(event *api.JobDuplicateFoundEvent) error
 	RecordJobTerminated(event *api.JobTerminatedEvent) error
 	RecordJobReprioritized(event *api.JobReprioritizedEvent) error
+	RecordJobUtilisation(event *api.JobUtilisationEvent) error
+}
+
+// BatchJobRecorder allows a group of records to be applied atomically.
+// All records made through the recorder passed to the callback are committed together,
+// or not at all if the callback returns an error.
+type BatchJobRecorder interface {
+	JobRecorder
+	RecordBatch(record func(recorder JobRecorder) error) error
 }
 
 type SQLJobStore struct {
-	db                   *goqu.Database
+	db                   database.GoquDatabase
 	userAnnotationPrefix string
 }
 
@@ -37,7 +48,30 @@ func NewSQLJobStore(db *goqu.Database, annotationPrefix string) *SQLJobStore {
 	return &SQLJobStore{db: db, userAnnotationPrefix: annotationPrefix}
 }
 
+func (r *SQLJobStore) RecordBatch(record func(recorder JobRecorder) error) error {
+	db, ok := r.db.(*goqu.Database)
+	if !ok {
+		// already running inside a transaction
+		return record(r)
+	}
+	return db.WithTx(func(tx *goqu.TxDatabase) error {
+		return record(&SQLJobStore{db: tx, userAnnotationPrefix: r.userAnnotationPrefix})
+	})
+}
+
 func (r *SQLJobStore) RecordJob(job *api.Job) error {
+	// Reprioritization events can be processed before the submission event,
+	// the priority stored in the database is always at least as recent as the one in submitted job
+	priority, err := r.getStoredPriority(job.Id)
+	if err != nil {
+		return err
+	}
+	if priority.Valid && priority.Float64 != job.Priority {
+		reprioritizedJob := *job
+		reprioritizedJob.Priority = priority.Float64
+		job = &reprioritizedJob
+	}
+
 	jobJson, err := json.Marshal(job)
 	if err != nil {
 		return err
@@ -69,6 +103,10 @@ func (r *SQLJobStore) RecordJob(job *api.Job) error {
 		return err
 	}
 
+	if err = r.upsertPodRequests(job); err != nil {
+		return err
+	}
+
 	return r.upsertUserAnnotations(job.Id, job.Annotations)
 }
 
@@ -233,10 +271,11 @@ func (r *SQLJobStore) RecordJobSucceeded(event *api.JobSucceededEvent) error {
 }
 
 func (r *SQLJobStore) RecordJobFailed(event *api.JobFailedEvent) error {
-	// If job fails before a pod is created, we generate a new ULID
+	// If job fails before a pod is created, we derive the run id from the job id,
+	// so the same event processed more than once does not create additional runs
 	k8sId := event.KubernetesId
 	if k8sId == "" {
-		k8sId = util.NewULID() + "-nopod"
+		k8sId = fmt.Sprintf("%s-%d-nopod", event.JobId, event.PodNumber)
 	}
 
 	jobRunRecord := goqu.Record{
@@ -320,6 +359,30 @@ func (r *SQLJobStore) RecordJobTerminated(event *api.JobTerminatedEvent) error {
 	return err
 }
 
+func (r *SQLJobStore) RecordJobUtilisation(event *api.JobUtilisationEvent) error {
+	used := common.ComputeResources(event.MaxResourcesForPeriod).AsFloat()
+	return upsert(r.db, jobRunUtilisationTable, []string{"run_id", "created"}, []goqu.Record{{
+		"run_id":  event.GetKubernetesId(),
+		"created": ToUTC(event.GetCreated()),
+		"cpu":     used[cpuResource],
+		"memory":  used[memoryResource],
+		"gpu":     used[domain.AcceleratorDutyCycle],
+	}})
+}
+
+func (r *SQLJobStore) getStoredPriority(jobId string) (sql.NullFloat64, error) {
+	selectDs := r.db.From(jobTable).
+		Select(job_priority).
+		Where(job_jobId.Eq(jobId))
+
+	jobRows := make([]*JobRow, 0)
+	err := selectDs.Prepared(true).ScanStructs(&jobRows)
+	if err != nil || len(jobRows) == 0 {
+		return sql.NullFloat64{}, err
+	}
+	return jobRows[0].Priority, nil
+}
+
 func (r *SQLJobStore) getUpdatedJobJson(event *api.JobReprioritizedEvent) (sql.NullString, error) {
 	selectDs := r.db.From(jobTable).
 		Select(job_job).
@@ -369,6 +432,25 @@ func (r *SQLJobStore) upsertContainers(k8sId string, exitCodes map[string]int32)
 	return upsert(r.db, jobRunContainerTable, []string{"run_id", "container_name"}, containerRecords)
 }
 
+func (r *SQLJobStore) upsertPodRequests(job *api.Job) error {
+	var podRequestRecords []goqu.Record
+	for i, podSpec := range job.GetAllPodSpecs() {
+		if podSpec == nil {
+			continue
+		}
+		requested := common.TotalPodResourceRequest(podSpec).AsFloat()
+		podRequestRecords = append(podRequestRecords, goqu.Record{
+			"job_id":     job.Id,
+			"pod_number": i,
+			"cpu":        requested[cpuResource],
+			"memory":     requested[memoryResource],
+			"gpu":        requested[gpuResource],
+		})
+	}
+
+	return upsert(r.db, jobPodRequestTable, []string{"job_id", "pod_number"}, podRequestRecords)
+}
+
 func (r *SQLJobStore) upsertUserAnnotations(jobId string, annotations map[string]string) error {
 	var annotationRecords []goqu.Record
 	for key, value := range annotations {
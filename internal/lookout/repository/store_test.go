@@ -3,6 +3,7 @@ package repository
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -784,6 +785,114 @@ func Test_JobReprioritizedEvent(t *testing.T) {
 	})
 }
 
+func Test_RecordBatch(t *testing.T) {
+	t.Run("commits all records", func(t *testing.T) {
+		withDatabase(t, func(db *goqu.Database) {
+			jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+			jobId := util.NewULID()
+
+			err := jobStore.RecordBatch(func(recorder JobRecorder) error {
+				err := recorder.RecordJob(&api.Job{
+					Id:      jobId,
+					Queue:   "queue",
+					Created: someTime,
+				})
+				assert.NoError(t, err)
+				return recorder.RecordJobRunning(&api.JobRunningEvent{
+					JobId:        jobId,
+					Queue:        "queue",
+					Created:      someTime,
+					KubernetesId: k8sId1,
+				})
+			})
+			assert.NoError(t, err)
+
+			assert.Equal(t, JobStateToIntMap[JobRunning], selectInt(t, db,
+				"SELECT state FROM job"))
+		})
+	})
+
+	t.Run("rolls back all records on error", func(t *testing.T) {
+		withDatabase(t, func(db *goqu.Database) {
+			jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+
+			err := jobStore.RecordBatch(func(recorder JobRecorder) error {
+				err := recorder.RecordJob(&api.Job{
+					Id:      util.NewULID(),
+					Queue:   "queue",
+					Created: someTime,
+				})
+				assert.NoError(t, err)
+				return errors.New("failed")
+			})
+			assert.Error(t, err)
+
+			assert.Equal(t, 0, selectInt(t, db,
+				"SELECT count(*) FROM job"))
+		})
+	})
+}
+
+func Test_RedeliveredEvents(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobId := util.NewULID()
+
+		job := &api.Job{
+			Id:      jobId,
+			Queue:   "queue",
+			Created: someTime,
+		}
+		failed := &api.JobFailedEvent{
+			JobId:   jobId,
+			Queue:   "queue",
+			Created: someTime,
+		}
+
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, jobStore.RecordJob(job))
+			assert.NoError(t, jobStore.RecordJobFailed(failed))
+		}
+
+		assert.Equal(t, 1, selectInt(t, db,
+			"SELECT count(*) FROM job_run"))
+		assert.Equal(t, JobStateToIntMap[JobFailed], selectInt(t, db,
+			"SELECT state FROM job"))
+	})
+}
+
+func Test_ReprioritizedBeforeSubmitted(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobId := util.NewULID()
+
+		err := jobStore.RecordJobReprioritized(&api.JobReprioritizedEvent{
+			JobId:       jobId,
+			JobSetId:    "job-set",
+			Queue:       "queue",
+			Created:     someTime,
+			NewPriority: 123,
+		})
+		assert.NoError(t, err)
+
+		err = jobStore.RecordJob(&api.Job{
+			Id:       jobId,
+			Queue:    "queue",
+			Created:  someTime,
+			Priority: 1,
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(123), selectDouble(t, db,
+			"SELECT priority FROM job"))
+
+		var job api.Job
+		jobJson := ParseNullString(selectNullString(t, db, "SELECT job FROM job"))
+		_ = json.Unmarshal([]byte(jobJson), &job)
+		assert.Equal(t, float64(123), job.Priority)
+	})
+}
+
 func selectInt(t *testing.T, db *goqu.Database, query string) int {
 	r, err := db.Query(query)
 	assert.NoError(t, err)
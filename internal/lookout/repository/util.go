@@ -9,6 +9,7 @@ import (
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/lib/pq"
 
+	"github.com/G-Research/armada/internal/common/database"
 	"github.com/G-Research/armada/internal/common/util"
 )
 
@@ -25,7 +26,7 @@ func ToUTC(t time.Time) time.Time {
 	return t.In(location)
 }
 
-func upsert(db *goqu.Database, table interface{}, keys []string, records []goqu.Record) error {
+func upsert(db database.GoquDatabase, table interface{}, keys []string, records []goqu.Record) error {
 	if len(records) == 0 {
 		return nil
 	}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+var resourceConsumptionCsvHeader = []string{
+	"queue",
+	"job_set",
+	"owner",
+	"runs",
+	"cpu_hours_requested",
+	"memory_gib_hours_requested",
+	"gpu_hours_requested",
+	"cpu_hours_used",
+	"memory_gib_hours_used",
+	"gpu_duty_cycle_hours_used",
+}
+
+// ResourceConsumptionCsvHandler serves resource consumption report as CSV file.
+// Report is filtered with query parameters queue, owner, jobSetId (can be repeated),
+// from and to (RFC3339 timestamps). Authorization header of the request is forwarded to the Lookout API.
+type ResourceConsumptionCsvHandler struct {
+	client lookout.LookoutClient
+}
+
+func NewResourceConsumptionCsvHandler(client lookout.LookoutClient) *ResourceConsumptionCsvHandler {
+	return &ResourceConsumptionCsvHandler{client: client}
+}
+
+func (h *ResourceConsumptionCsvHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request, err := parseResourceConsumptionRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	response, err := h.client.GetResourceConsumption(ctx, request)
+	if err != nil {
+		st := status.Convert(err)
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"resource-consumption.csv\"")
+
+	err = writeResourceConsumptionCsv(w, response.ResourceConsumption)
+	if err != nil {
+		log.Errorf("failed to write resource consumption csv: %v", err)
+	}
+}
+
+func parseResourceConsumptionRequest(r *http.Request) (*lookout.GetResourceConsumptionRequest, error) {
+	query := r.URL.Query()
+	request := &lookout.GetResourceConsumptionRequest{
+		Queue:     query.Get("queue"),
+		Owner:     query.Get("owner"),
+		JobSetIds: query["jobSetId"],
+	}
+
+	if from := query.Get("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from time: %v", err)
+		}
+		request.From = &t
+	}
+
+	if to := query.Get("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to time: %v", err)
+		}
+		request.To = &t
+	}
+
+	return request, nil
+}
+
+func writeResourceConsumptionCsv(w http.ResponseWriter, consumption []*lookout.ResourceConsumption) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write(resourceConsumptionCsvHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range consumption {
+		err = writer.Write([]string{
+			c.Queue,
+			c.JobSet,
+			c.Owner,
+			strconv.FormatUint(uint64(c.Runs), 10),
+			formatHours(c.CpuHoursRequested),
+			formatHours(c.MemoryGibHoursRequested),
+			formatHours(c.GpuHoursRequested),
+			formatHours(c.CpuHoursUsed),
+			formatHours(c.MemoryGibHoursUsed),
+			formatHours(c.GpuDutyCycleHoursUsed),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatHours(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', 4, 64)
+}
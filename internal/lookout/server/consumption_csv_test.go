@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+type fakeLookoutClient struct {
+	lookout.LookoutClient
+	request  *lookout.GetResourceConsumptionRequest
+	metadata metadata.MD
+	response *lookout.GetResourceConsumptionResponse
+	err      error
+}
+
+func (c *fakeLookoutClient) GetResourceConsumption(ctx context.Context, in *lookout.GetResourceConsumptionRequest, opts ...grpc.CallOption) (*lookout.GetResourceConsumptionResponse, error) {
+	c.request = in
+	c.metadata, _ = metadata.FromOutgoingContext(ctx)
+	return c.response, c.err
+}
+
+func TestResourceConsumptionCsvHandler_WritesCsv(t *testing.T) {
+	client := &fakeLookoutClient{response: &lookout.GetResourceConsumptionResponse{
+		ResourceConsumption: []*lookout.ResourceConsumption{{
+			Queue:                   "queue",
+			JobSet:                  "job-set",
+			Owner:                   "user",
+			Runs:                    2,
+			CpuHoursRequested:       4,
+			MemoryGibHoursRequested: 8.5,
+			CpuHoursUsed:            1.25,
+		}},
+	}}
+	handler := NewResourceConsumptionCsvHandler(client)
+
+	request := httptest.NewRequest("GET", "/api/v1/lookout/consumption.csv?queue=queue&jobSetId=a&jobSetId=b&from=2021-02-05T10:00:00Z", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+	assert.Equal(t,
+		"queue,job_set,owner,runs,cpu_hours_requested,memory_gib_hours_requested,gpu_hours_requested,cpu_hours_used,memory_gib_hours_used,gpu_duty_cycle_hours_used\n"+
+			"queue,job-set,user,2,4.0000,8.5000,0.0000,1.2500,0.0000,0.0000\n",
+		recorder.Body.String())
+
+	assert.Equal(t, "queue", client.request.Queue)
+	assert.Equal(t, []string{"a", "b"}, client.request.JobSetIds)
+	assert.Equal(t, time.Date(2021, 2, 5, 10, 0, 0, 0, time.UTC), *client.request.From)
+	assert.Nil(t, client.request.To)
+}
+
+func TestResourceConsumptionCsvHandler_InvalidTime(t *testing.T) {
+	client := &fakeLookoutClient{}
+	handler := NewResourceConsumptionCsvHandler(client)
+
+	request := httptest.NewRequest("GET", "/api/v1/lookout/consumption.csv?to=yesterday", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Nil(t, client.request)
+}
+
+func TestResourceConsumptionCsvHandler_ForwardsAuthorization(t *testing.T) {
+	client := &fakeLookoutClient{err: status.Error(codes.PermissionDenied, "user has no permission")}
+	handler := NewResourceConsumptionCsvHandler(client)
+
+	request := httptest.NewRequest("GET", "/api/v1/lookout/consumption.csv", nil)
+	request.Header.Set("Authorization", "Bearer token")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, []string{"Bearer token"}, client.metadata.Get("authorization"))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Equal(t, "user has no permission\n", recorder.Body.String())
+}
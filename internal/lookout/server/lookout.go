@@ -7,16 +7,27 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/api/lookout"
 )
 
 type LookoutServer struct {
-	jobRepository repository.JobRepository
+	jobRepository       repository.JobRepository
+	savedViewRepository repository.SavedViewRepository
+	submitClient        api.SubmitClient
 }
 
-func NewLookoutServer(jobRepository repository.JobRepository) *LookoutServer {
-	return &LookoutServer{jobRepository: jobRepository}
+func NewLookoutServer(
+	jobRepository repository.JobRepository,
+	savedViewRepository repository.SavedViewRepository,
+	submitClient api.SubmitClient) *LookoutServer {
+	return &LookoutServer{
+		jobRepository:       jobRepository,
+		savedViewRepository: savedViewRepository,
+		submitClient:        submitClient,
+	}
 }
 
 func (s *LookoutServer) Overview(ctx context.Context, _ *types.Empty) (*lookout.SystemOverview, error) {
@@ -42,3 +53,64 @@ func (s *LookoutServer) GetJobs(ctx context.Context, opts *lookout.GetJobsReques
 	}
 	return &lookout.GetJobsResponse{JobInfos: jobInfos}, nil
 }
+
+func (s *LookoutServer) GetResourceConsumption(ctx context.Context, opts *lookout.GetResourceConsumptionRequest) (*lookout.GetResourceConsumptionResponse, error) {
+	consumption, err := s.jobRepository.GetResourceConsumption(ctx, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query resource consumption: %s", err)
+	}
+	return &lookout.GetResourceConsumptionResponse{ResourceConsumption: consumption}, nil
+}
+
+func (s *LookoutServer) CreateSavedView(ctx context.Context, request *lookout.CreateSavedViewRequest) (*lookout.SavedView, error) {
+	if request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "saved view name must not be empty")
+	}
+	if request.Request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "saved view request must not be empty")
+	}
+
+	owner, err := savedViewOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	view, err := s.savedViewRepository.CreateSavedView(ctx, owner, request.Name, request.Request)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save view: %s", err)
+	}
+	return view, nil
+}
+
+func (s *LookoutServer) GetSavedViews(ctx context.Context, _ *types.Empty) (*lookout.GetSavedViewsResponse, error) {
+	owner := authorization.GetPrincipal(ctx).GetName()
+	views, err := s.savedViewRepository.GetSavedViews(ctx, owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query saved views: %s", err)
+	}
+	return &lookout.GetSavedViewsResponse{SavedViews: views}, nil
+}
+
+func (s *LookoutServer) DeleteSavedView(ctx context.Context, request *lookout.DeleteSavedViewRequest) (*types.Empty, error) {
+	owner, err := savedViewOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.savedViewRepository.DeleteSavedView(ctx, owner, request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete saved view: %s", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "saved view %s not found", request.Id)
+	}
+	return &types.Empty{}, nil
+}
+
+// savedViewOwner returns name of the caller, views can be modified only by authenticated users,
+// as all anonymous users share the same name
+func savedViewOwner(ctx context.Context) (string, error) {
+	principal := authorization.GetPrincipal(ctx)
+	if authorization.IsAnonymous(principal) {
+		return "", status.Errorf(codes.Unauthenticated, "saved views require authenticated user")
+	}
+	return principal.GetName(), nil
+}
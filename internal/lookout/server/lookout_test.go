@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+type fakeSavedViewRepository struct {
+	repository.SavedViewRepository
+	owners []string
+}
+
+func (r *fakeSavedViewRepository) CreateSavedView(ctx context.Context, owner string, name string, request *lookout.GetJobsRequest) (*lookout.SavedView, error) {
+	r.owners = append(r.owners, owner)
+	return &lookout.SavedView{Id: "view-1", Name: name, Request: request}, nil
+}
+
+func (r *fakeSavedViewRepository) DeleteSavedView(ctx context.Context, owner string, id string) (bool, error) {
+	r.owners = append(r.owners, owner)
+	return true, nil
+}
+
+func TestSavedViews_StoredUnderCallerName(t *testing.T) {
+	savedViewRepository := &fakeSavedViewRepository{}
+	server := NewLookoutServer(nil, savedViewRepository, nil)
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("user", []string{}))
+
+	_, err := server.CreateSavedView(ctx, &lookout.CreateSavedViewRequest{Name: "view", Request: &lookout.GetJobsRequest{}})
+	assert.NoError(t, err)
+	_, err = server.DeleteSavedView(ctx, &lookout.DeleteSavedViewRequest{Id: "view-1"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"user", "user"}, savedViewRepository.owners)
+}
+
+func TestSavedViews_RejectsAnonymousUser(t *testing.T) {
+	savedViewRepository := &fakeSavedViewRepository{}
+	server := NewLookoutServer(nil, savedViewRepository, nil)
+	ctx := context.Background()
+
+	_, err := server.CreateSavedView(ctx, &lookout.CreateSavedViewRequest{Name: "view", Request: &lookout.GetJobsRequest{}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.DeleteSavedView(ctx, &lookout.DeleteSavedViewRequest{Id: "view-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	assert.Empty(t, savedViewRepository.owners)
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+// ResubmittedFromAnnotation is set on resubmitted jobs to the id of the job they were copied from
+const ResubmittedFromAnnotation = "armadaproject.io/resubmittedFrom"
+
+type jobSetKey struct {
+	queue    string
+	jobSetId string
+}
+
+// ResubmitJobs submits copies of the requested jobs to Armada.
+// Jobs are submitted with credentials of the caller, so Armada checks permissions as for any other submission.
+func (s *LookoutServer) ResubmitJobs(ctx context.Context, request *lookout.ResubmitJobsRequest) (*lookout.ResubmitJobsResponse, error) {
+	if s.submitClient == nil {
+		return nil, status.Errorf(codes.Unimplemented, "resubmitting jobs is not enabled, Armada API url is not configured")
+	}
+	if len(request.JobIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no job ids provided")
+	}
+
+	resources, err := parseResources(request.Resources)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resources: %s", err)
+	}
+
+	submitCtx, err := forwardAuthorization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := s.jobRepository.GetJobSpecs(ctx, request.JobIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query jobs: %s", err)
+	}
+	jobsById := make(map[string]*api.Job, len(jobs))
+	for _, job := range jobs {
+		jobsById[job.Id] = job
+	}
+
+	results := make([]*lookout.ResubmittedJob, 0, len(request.JobIds))
+	resultsByJobSet := map[jobSetKey][]*lookout.ResubmittedJob{}
+	itemsByJobSet := map[jobSetKey][]*api.JobSubmitRequestItem{}
+	jobSetOrder := []jobSetKey{}
+
+	for _, jobId := range request.JobIds {
+		result := &lookout.ResubmittedJob{OriginalJobId: jobId}
+		results = append(results, result)
+
+		job, exists := jobsById[jobId]
+		if !exists {
+			result.Error = "job not found"
+			continue
+		}
+
+		key := jobSetKey{queue: job.Queue, jobSetId: job.JobSetId}
+		if request.Queue != "" {
+			key.queue = request.Queue
+		}
+		if request.JobSetId != "" {
+			key.jobSetId = request.JobSetId
+		}
+		if _, exists := itemsByJobSet[key]; !exists {
+			jobSetOrder = append(jobSetOrder, key)
+		}
+		itemsByJobSet[key] = append(itemsByJobSet[key], createResubmitRequestItem(job, request, resources))
+		resultsByJobSet[key] = append(resultsByJobSet[key], result)
+	}
+
+	for _, key := range jobSetOrder {
+		response, err := s.submitClient.SubmitJobs(submitCtx, &api.JobSubmitRequest{
+			Queue:           key.queue,
+			JobSetId:        key.jobSetId,
+			JobRequestItems: itemsByJobSet[key],
+		})
+		jobSetResults := resultsByJobSet[key]
+		if err != nil {
+			for _, result := range jobSetResults {
+				result.Error = status.Convert(err).Message()
+			}
+			continue
+		}
+		for i, item := range response.JobResponseItems {
+			if i < len(jobSetResults) {
+				jobSetResults[i].JobId = item.JobId
+				jobSetResults[i].Error = item.Error
+			}
+		}
+	}
+
+	return &lookout.ResubmitJobsResponse{ResubmittedJobs: results}, nil
+}
+
+func createResubmitRequestItem(job *api.Job, request *lookout.ResubmitJobsRequest, resources v1.ResourceList) *api.JobSubmitRequestItem {
+	annotations := make(map[string]string, len(job.Annotations)+1)
+	for key, value := range job.Annotations {
+		annotations[key] = value
+	}
+	annotations[ResubmittedFromAnnotation] = job.Id
+
+	priority := job.Priority
+	if request.Priority != nil {
+		priority = request.Priority.Value
+	}
+
+	podSpecs := []*v1.PodSpec{}
+	for _, podSpec := range job.GetAllPodSpecs() {
+		podSpec = podSpec.DeepCopy()
+		overrideResources(podSpec, resources)
+		podSpecs = append(podSpecs, podSpec)
+	}
+
+	return &api.JobSubmitRequestItem{
+		Priority:           priority,
+		Namespace:          job.Namespace,
+		Labels:             job.Labels,
+		Annotations:        annotations,
+		RequiredNodeLabels: job.RequiredNodeLabels,
+		PodSpecs:           podSpecs,
+		Ingress:            job.Ingress,
+	}
+}
+
+func overrideResources(podSpec *v1.PodSpec, resources v1.ResourceList) {
+	if len(resources) == 0 {
+		return
+	}
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		if container.Resources.Requests == nil {
+			container.Resources.Requests = v1.ResourceList{}
+		}
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = v1.ResourceList{}
+		}
+		for name, quantity := range resources {
+			container.Resources.Requests[name] = quantity
+			container.Resources.Limits[name] = quantity
+		}
+	}
+}
+
+func parseResources(resources map[string]string) (v1.ResourceList, error) {
+	result := v1.ResourceList{}
+	for name, value := range resources {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, err
+		}
+		result[v1.ResourceName(name)] = quantity
+	}
+	return result, nil
+}
+
+func forwardAuthorization(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authorization := md.Get("authorization")
+	if len(authorization) == 0 {
+		// without the header jobs would be submitted as anonymous user of Armada, so the request is rejected
+		return nil, status.Errorf(codes.Unauthenticated, "resubmitting jobs requires authorization header, it is forwarded to Armada API")
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", authorization[0]), nil
+}
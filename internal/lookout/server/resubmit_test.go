@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+type fakeJobRepository struct {
+	repository.JobRepository
+	jobs []*api.Job
+}
+
+func (r *fakeJobRepository) GetJobSpecs(ctx context.Context, jobIds []string) ([]*api.Job, error) {
+	return r.jobs, nil
+}
+
+type fakeSubmitClient struct {
+	api.SubmitClient
+	requests      []*api.JobSubmitRequest
+	authorization []string
+	err           error
+}
+
+func (c *fakeSubmitClient) SubmitJobs(ctx context.Context, in *api.JobSubmitRequest, opts ...grpc.CallOption) (*api.JobSubmitResponse, error) {
+	c.requests = append(c.requests, in)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.authorization = md.Get("authorization")
+	if c.err != nil {
+		return nil, c.err
+	}
+	response := &api.JobSubmitResponse{}
+	for i := range in.JobRequestItems {
+		response.JobResponseItems = append(response.JobResponseItems, &api.JobSubmitResponseItem{
+			JobId: fmt.Sprintf("%s-new-%d", in.JobSetId, i),
+		})
+	}
+	return response, nil
+}
+
+func TestResubmitJobs_SubmitsCopiesWithOverrides(t *testing.T) {
+	submitClient := &fakeSubmitClient{}
+	jobRepository := &fakeJobRepository{jobs: []*api.Job{
+		makeStoredJob("job-1", "queue", "job-set"),
+		makeStoredJob("job-2", "queue", "job-set"),
+	}}
+	server := NewLookoutServer(jobRepository, nil, submitClient)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+	response, err := server.ResubmitJobs(ctx, &lookout.ResubmitJobsRequest{
+		JobIds:    []string{"job-1", "job-2", "job-3"},
+		JobSetId:  "retry",
+		Priority:  &types.DoubleValue{Value: 5},
+		Resources: map[string]string{"memory": "2Gi"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*lookout.ResubmittedJob{
+		{OriginalJobId: "job-1", JobId: "retry-new-0"},
+		{OriginalJobId: "job-2", JobId: "retry-new-1"},
+		{OriginalJobId: "job-3", Error: "job not found"},
+	}, response.ResubmittedJobs)
+
+	assert.Equal(t, []string{"Bearer token"}, submitClient.authorization)
+	assert.Len(t, submitClient.requests, 1)
+	request := submitClient.requests[0]
+	assert.Equal(t, "queue", request.Queue)
+	assert.Equal(t, "retry", request.JobSetId)
+
+	item := request.JobRequestItems[0]
+	assert.Equal(t, 5.0, item.Priority)
+	assert.Equal(t, "job-1", item.Annotations[ResubmittedFromAnnotation])
+	assert.Equal(t, "value", item.Annotations["key"])
+	container := item.PodSpecs[0].Containers[0]
+	assert.Equal(t, resource.MustParse("1"), container.Resources.Requests[v1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("2Gi"), container.Resources.Requests[v1.ResourceMemory])
+	assert.Equal(t, resource.MustParse("2Gi"), container.Resources.Limits[v1.ResourceMemory])
+}
+
+func TestResubmitJobs_SubmitsToOriginalJobSets(t *testing.T) {
+	submitClient := &fakeSubmitClient{}
+	jobRepository := &fakeJobRepository{jobs: []*api.Job{
+		makeStoredJob("job-1", "queue", "job-set-1"),
+		makeStoredJob("job-2", "queue", "job-set-2"),
+	}}
+	server := NewLookoutServer(jobRepository, nil, submitClient)
+
+	response, err := server.ResubmitJobs(authorizedContext(), &lookout.ResubmitJobsRequest{
+		JobIds: []string{"job-1", "job-2"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, response.ResubmittedJobs, 2)
+
+	assert.Len(t, submitClient.requests, 2)
+	assert.Equal(t, "job-set-1", submitClient.requests[0].JobSetId)
+	assert.Equal(t, "job-set-2", submitClient.requests[1].JobSetId)
+	assert.Equal(t, 1.0, submitClient.requests[0].JobRequestItems[0].Priority)
+}
+
+func TestResubmitJobs_RejectsRequestWithoutAuthorization(t *testing.T) {
+	submitClient := &fakeSubmitClient{}
+	jobRepository := &fakeJobRepository{jobs: []*api.Job{makeStoredJob("job-1", "queue", "job-set")}}
+	server := NewLookoutServer(jobRepository, nil, submitClient)
+
+	_, err := server.ResubmitJobs(context.Background(), &lookout.ResubmitJobsRequest{JobIds: []string{"job-1"}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Empty(t, submitClient.requests)
+}
+
+func TestResubmitJobs_ReportsSubmitErrors(t *testing.T) {
+	submitClient := &fakeSubmitClient{err: status.Error(codes.PermissionDenied, "no permission")}
+	jobRepository := &fakeJobRepository{jobs: []*api.Job{makeStoredJob("job-1", "queue", "job-set")}}
+	server := NewLookoutServer(jobRepository, nil, submitClient)
+
+	response, err := server.ResubmitJobs(authorizedContext(), &lookout.ResubmitJobsRequest{JobIds: []string{"job-1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "no permission", response.ResubmittedJobs[0].Error)
+}
+
+func TestResubmitJobs_InvalidResources(t *testing.T) {
+	server := NewLookoutServer(&fakeJobRepository{}, nil, &fakeSubmitClient{})
+
+	_, err := server.ResubmitJobs(context.Background(), &lookout.ResubmitJobsRequest{
+		JobIds:    []string{"job-1"},
+		Resources: map[string]string{"cpu": "lots"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func authorizedContext() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+}
+
+func makeStoredJob(id string, queue string, jobSetId string) *api.Job {
+	return &api.Job{
+		Id:          id,
+		Queue:       queue,
+		JobSetId:    jobSetId,
+		Priority:    1,
+		Annotations: map[string]string{"key": "value"},
+		PodSpecs: []*v1.PodSpec{{
+			Containers: []v1.Container{{
+				Name: "container",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("1"),
+						v1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+				},
+			}},
+		}},
+	}
+}
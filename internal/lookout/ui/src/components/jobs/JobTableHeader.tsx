@@ -6,13 +6,16 @@ import LowPriority from "@material-ui/icons/LowPriority"
 import RefreshIcon from "@material-ui/icons/Refresh"
 
 import { ColumnSpec } from "../../containers/JobsContainer"
+import { SavedView } from "../../services/JobService"
 import ColumnSelect from "./ColumnSelect"
+import SavedViews from "./SavedViews"
 
 import "./JobTableHeader.css"
 
 type JobTableHeaderProps = {
   defaultColumns: ColumnSpec<string | boolean | string[]>[]
   annotationColumns: ColumnSpec<string>[]
+  savedViews: SavedView[]
   canCancel: boolean
   canReprioritize: boolean
   onRefresh: () => void
@@ -22,6 +25,9 @@ type JobTableHeaderProps = {
   onDeleteColumn: (columnId: string) => void
   onAddColumn: () => void
   onChangeAnnotationColumnKey: (columnId: string, newKey: string) => void
+  onApplySavedView: (view: SavedView) => void
+  onSaveView: (name: string) => void
+  onDeleteSavedView: (id: string) => void
 }
 
 export default function JobTableHeader(props: JobTableHeaderProps) {
@@ -31,6 +37,14 @@ export default function JobTableHeader(props: JobTableHeaderProps) {
         <h2 className="title">Jobs</h2>
       </div>
       <div className="right">
+        <div className="saved-views-container">
+          <SavedViews
+            savedViews={props.savedViews}
+            onApplyView={props.onApplySavedView}
+            onSaveView={props.onSaveView}
+            onDeleteView={props.onDeleteSavedView}
+          />
+        </div>
         <div className="select-columns">
           <ColumnSelect
             defaultColumns={props.defaultColumns}
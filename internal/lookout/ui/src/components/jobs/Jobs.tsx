@@ -3,7 +3,7 @@ import React from "react"
 import { AutoSizer, InfiniteLoader, Table } from "react-virtualized"
 
 import { ColumnSpec } from "../../containers/JobsContainer"
-import { Job } from "../../services/JobService"
+import { Job, SavedView } from "../../services/JobService"
 import CheckboxHeaderRow from "../CheckboxHeaderRow"
 import CheckboxRow from "../CheckboxRow"
 import JobTableHeader from "./JobTableHeader"
@@ -17,6 +17,7 @@ type JobsProps = {
   canLoadMore: boolean
   defaultColumns: ColumnSpec<string | boolean | string[]>[]
   annotationColumns: ColumnSpec<string>[]
+  savedViews: SavedView[]
   selectedJobs: Map<string, Job>
   cancelJobsButtonIsEnabled: boolean
   reprioritizeButtonIsEnabled: boolean
@@ -27,6 +28,9 @@ type JobsProps = {
   onDeleteColumn: (columnId: string) => void
   onAddColumn: () => void
   onChangeAnnotationColumnKey: (columnId: string, newKey: string) => void
+  onApplySavedView: (view: SavedView) => void
+  onSaveView: (name: string) => void
+  onDeleteSavedView: (id: string) => void
   onRefresh: () => void
   onSelectJob: (index: number, selected: boolean) => void
   onShiftSelect: (index: number, selected: boolean) => void
@@ -81,6 +85,7 @@ export default class Jobs extends React.Component<JobsProps, Record<string, neve
           <JobTableHeader
             defaultColumns={this.props.defaultColumns}
             annotationColumns={this.props.annotationColumns}
+            savedViews={this.props.savedViews}
             canCancel={this.props.cancelJobsButtonIsEnabled}
             canReprioritize={this.props.reprioritizeButtonIsEnabled}
             onRefresh={this.props.onRefresh}
@@ -90,6 +95,9 @@ export default class Jobs extends React.Component<JobsProps, Record<string, neve
             onDeleteColumn={this.props.onDeleteColumn}
             onAddColumn={this.props.onAddColumn}
             onChangeAnnotationColumnKey={this.props.onChangeAnnotationColumnKey}
+            onApplySavedView={this.props.onApplySavedView}
+            onSaveView={this.props.onSaveView}
+            onDeleteSavedView={this.props.onDeleteSavedView}
           />
         </div>
         <div className="job-table">
@@ -0,0 +1,118 @@
+import React, { useState } from "react"
+
+import {
+  Button,
+  Dialog,
+  DialogActions,
+  DialogContent,
+  DialogTitle,
+  Divider,
+  IconButton,
+  ListItemSecondaryAction,
+  ListItemText,
+  Menu,
+  MenuItem,
+  TextField,
+} from "@material-ui/core"
+import { Bookmarks, Clear } from "@material-ui/icons"
+
+import { SavedView } from "../../services/JobService"
+
+type SavedViewsProps = {
+  savedViews: SavedView[]
+  onApplyView: (view: SavedView) => void
+  onSaveView: (name: string) => void
+  onDeleteView: (id: string) => void
+}
+
+export default function SavedViews(props: SavedViewsProps) {
+  const [anchorEl, setAnchorEl] = useState<HTMLElement | null>(null)
+  const [saveDialogOpen, setSaveDialogOpen] = useState(false)
+  const [name, setName] = useState("")
+
+  const closeMenu = () => setAnchorEl(null)
+  const closeSaveDialog = () => {
+    setSaveDialogOpen(false)
+    setName("")
+  }
+
+  return (
+    <div className="saved-views">
+      <Button
+        variant="outlined"
+        color="primary"
+        startIcon={<Bookmarks />}
+        onClick={(event) => setAnchorEl(event.currentTarget)}
+      >
+        Views
+      </Button>
+      <Menu anchorEl={anchorEl} keepMounted open={Boolean(anchorEl)} onClose={closeMenu}>
+        {props.savedViews.map((view) => (
+          <MenuItem
+            key={view.id}
+            onClick={() => {
+              closeMenu()
+              props.onApplyView(view)
+            }}
+          >
+            <ListItemText primary={view.name} />
+            <ListItemSecondaryAction>
+              <IconButton
+                edge="end"
+                color="secondary"
+                onClick={(event) => {
+                  event.stopPropagation()
+                  props.onDeleteView(view.id)
+                }}
+              >
+                <Clear />
+              </IconButton>
+            </ListItemSecondaryAction>
+          </MenuItem>
+        ))}
+        {props.savedViews.length > 0 && <Divider />}
+        <MenuItem
+          onClick={() => {
+            closeMenu()
+            setSaveDialogOpen(true)
+          }}
+        >
+          <ListItemText secondary="Save current view" />
+        </MenuItem>
+        <MenuItem
+          onClick={() => {
+            closeMenu()
+            navigator.clipboard.writeText(window.location.href)
+          }}
+        >
+          <ListItemText secondary="Copy link to current view" />
+        </MenuItem>
+      </Menu>
+      <Dialog open={saveDialogOpen} onClose={closeSaveDialog} aria-labelledby="save-view-dialog-title">
+        <DialogTitle id="save-view-dialog-title">Save View</DialogTitle>
+        <DialogContent>
+          <TextField
+            autoFocus
+            fullWidth
+            label="Name"
+            value={name}
+            onChange={(event) => setName(event.target.value)}
+          />
+        </DialogContent>
+        <DialogActions>
+          <Button onClick={closeSaveDialog}>Cancel</Button>
+          <Button
+            color="primary"
+            disabled={name.trim() === ""}
+            onClick={() => {
+              props.onSaveView(name.trim())
+              closeSaveDialog()
+            }}
+          >
+            Save
+          </Button>
+        </DialogActions>
+      </Dialog>
+    </div>
+  )
+}
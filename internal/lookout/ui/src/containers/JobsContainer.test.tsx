@@ -1,4 +1,9 @@
-import { makeQueryString, updateColumnsFromQueryString } from "./JobsContainer"
+import {
+  getAnnotationFiltersFromQueryString,
+  makeQueryString,
+  updateAnnotationColumnsFromFilters,
+  updateColumnsFromQueryString,
+} from "./JobsContainer"
 
 function assertStringHasQueryParams(expected: string[], actual: string) {
   const actualQueryParams = actual.split("&")
@@ -270,3 +275,60 @@ describe("updateColumnsFromQueryString", () => {
     expect(columns[0].filter).toStrictEqual(expectedJobStates)
   })
 })
+
+describe("annotation filters", () => {
+  test("makes string with annotation filters", () => {
+    const annotationColumns = [
+      {
+        id: "1",
+        name: "team",
+        accessor: "team",
+        isDisabled: false,
+        filter: "a",
+        defaultFilter: "",
+      },
+      {
+        id: "2",
+        name: "project",
+        accessor: "project",
+        isDisabled: false,
+        filter: "",
+        defaultFilter: "",
+      },
+    ]
+    const queryString = makeQueryString([], annotationColumns)
+    assertStringHasQueryParams(["annotation.team=a"], queryString)
+  })
+
+  test("gets annotation filters from query string", () => {
+    const query = "queue=test&annotation.team=a&annotation.project=b"
+    expect(getAnnotationFiltersFromQueryString(query)).toStrictEqual({ team: "a", project: "b" })
+  })
+
+  test("updates annotation columns and adds missing ones", () => {
+    const annotationColumns = [
+      {
+        id: "1",
+        name: "team",
+        accessor: "team",
+        isDisabled: false,
+        filter: "old",
+        defaultFilter: "",
+      },
+      {
+        id: "2",
+        name: "project",
+        accessor: "project",
+        isDisabled: false,
+        filter: "old",
+        defaultFilter: "",
+      },
+    ]
+    updateAnnotationColumnsFromFilters(annotationColumns, { team: "a", user: "b" })
+    expect(annotationColumns.map((col) => [col.accessor, col.filter])).toStrictEqual([
+      ["team", "a"],
+      ["project", ""],
+      ["user", "b"],
+    ])
+  })
+})
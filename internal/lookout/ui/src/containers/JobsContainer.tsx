@@ -11,7 +11,7 @@ import ReprioritizeJobsDialog, {
   ReprioritizeJobsDialogContext,
   ReprioritizeJobsDialogState,
 } from "../components/jobs/ReprioritizeJobsDialog"
-import JobService, { GetJobsRequest, JOB_STATES_FOR_DISPLAY, Job } from "../services/JobService"
+import JobService, { GetJobsRequest, JOB_STATES_FOR_DISPLAY, Job, JobFilters, SavedView } from "../services/JobService"
 import LogService from "../services/LogService"
 import { debounced, selectItem } from "../utils"
 
@@ -30,6 +30,7 @@ interface JobsContainerState {
   lastSelectedIndex: number
   defaultColumns: ColumnSpec<string | boolean | string[]>[]
   annotationColumns: ColumnSpec<string>[]
+  savedViews: SavedView[]
   cancelJobsModalContext: CancelJobsModalContext
   reprioritizeJobsDialogContext: ReprioritizeJobsDialogContext
   jobDetailsModalContext: JobDetailsModalContext
@@ -59,12 +60,16 @@ const QUERY_STRING_OPTIONS: ParseOptions | StringifyOptions = {
   arrayFormat: "comma",
   parseBooleans: true,
 }
+const ANNOTATION_QUERY_PARAM_PREFIX = "annotation."
 const LOCAL_STORAGE_KEY = "armada_lookout_annotation_columns"
 const BATCH_SIZE = 100
 const CANCELLABLE_JOB_STATES = ["Queued", "Pending", "Running"]
 const REPRIORITIZEABLE_JOB_STATES = ["Queued", "Pending", "Running"]
 
-export function makeQueryString(columns: ColumnSpec<string | boolean | string[]>[]): string {
+export function makeQueryString(
+  columns: ColumnSpec<string | boolean | string[]>[],
+  annotationColumns: ColumnSpec<string>[] = [],
+): string {
   const columnMap = new Map<string, ColumnSpec<string | boolean | string[]>>()
   for (const col of columns) {
     columnMap.set(col.id, col)
@@ -97,7 +102,14 @@ export function makeQueryString(columns: ColumnSpec<string | boolean | string[]>
     queryObject.owner = ownerCol.filter as string
   }
 
-  return queryString.stringify(queryObject, QUERY_STRING_OPTIONS)
+  const annotationParams: { [key: string]: string } = {}
+  for (const col of annotationColumns) {
+    if (col.accessor && col.filter) {
+      annotationParams[ANNOTATION_QUERY_PARAM_PREFIX + col.accessor] = col.filter
+    }
+  }
+
+  return queryString.stringify({ ...queryObject, ...annotationParams }, QUERY_STRING_OPTIONS)
 }
 
 export function updateColumnsFromQueryString(query: string, columns: ColumnSpec<string | boolean | string[]>[]) {
@@ -125,6 +137,42 @@ export function updateColumnsFromQueryString(query: string, columns: ColumnSpec<
   }
 }
 
+export function getAnnotationFiltersFromQueryString(query: string): { [key: string]: string } {
+  const params = queryString.parse(query, QUERY_STRING_OPTIONS)
+
+  const annotationFilters: { [key: string]: string } = {}
+  for (const [key, value] of Object.entries(params)) {
+    if (key.startsWith(ANNOTATION_QUERY_PARAM_PREFIX) && value !== null && value !== undefined) {
+      const filter = Array.isArray(value) ? value.join(",") : String(value)
+      annotationFilters[key.substring(ANNOTATION_QUERY_PARAM_PREFIX.length)] = filter
+    }
+  }
+  return annotationFilters
+}
+
+// Sets annotation column filters to the given values, adding columns for annotations that are not shown yet
+export function updateAnnotationColumnsFromFilters(
+  annotationColumns: ColumnSpec<string>[],
+  annotationFilters: { [key: string]: string },
+) {
+  for (const col of annotationColumns) {
+    col.filter = annotationFilters[col.accessor] ?? col.defaultFilter
+  }
+
+  for (const [key, value] of Object.entries(annotationFilters)) {
+    if (!annotationColumns.some((col) => col.accessor === key)) {
+      annotationColumns.push({
+        id: uuidv4(),
+        name: key,
+        accessor: key,
+        isDisabled: false,
+        filter: value,
+        defaultFilter: "",
+      })
+    }
+  }
+}
+
 function parseJobStates(jobStates: string[] | string): string[] {
   if (!Array.isArray(jobStates)) {
     if (JOB_STATES_FOR_DISPLAY.includes(jobStates)) {
@@ -196,6 +244,7 @@ class JobsContainer extends React.Component<JobsContainerProps, JobsContainerSta
         },
       ],
       annotationColumns: [],
+      savedViews: [],
       cancelJobsModalContext: {
         modalState: "None",
         jobsToCancel: [],
@@ -242,22 +291,35 @@ class JobsContainer extends React.Component<JobsContainerProps, JobsContainerSta
     this.deleteAnnotationColumn = this.deleteAnnotationColumn.bind(this)
     this.changeAnnotationColumnKey = this.changeAnnotationColumnKey.bind(this)
 
+    this.applySavedView = this.applySavedView.bind(this)
+    this.saveView = this.saveView.bind(this)
+    this.deleteSavedView = this.deleteSavedView.bind(this)
+
     this.fetchNextJobInfos = debounced(this.fetchNextJobInfos.bind(this), 100)
     this.handlePriorityChange = this.handlePriorityChange.bind(this)
   }
 
   componentDidMount() {
     const annotationColumnsJson = localStorage.getItem(LOCAL_STORAGE_KEY)
-    let annotationColumns: ColumnSpec<string>[] | undefined
+    let annotationColumns: ColumnSpec<string>[] = []
     if (annotationColumnsJson) {
       annotationColumns = JSON.parse(annotationColumnsJson) as ColumnSpec<string>[]
     }
 
+    // Shared links define all filters, including annotation filters
+    if (this.props.location.search) {
+      updateAnnotationColumnsFromFilters(
+        annotationColumns,
+        getAnnotationFiltersFromQueryString(this.props.location.search),
+      )
+    }
+
     updateColumnsFromQueryString(this.props.location.search, this.state.defaultColumns)
     this.setState({
       ...this.state,
-      annotationColumns: annotationColumns ?? [],
+      annotationColumns: annotationColumns,
     })
+    this.loadSavedViews()
   }
 
   async serveJobs(start: number, stop: number): Promise<Job[]> {
@@ -345,6 +407,59 @@ class JobsContainer extends React.Component<JobsContainerProps, JobsContainerSta
     this.saveAnnotationColumns()
   }
 
+  applySavedView(view: SavedView) {
+    for (const col of this.state.defaultColumns) {
+      switch (col.id) {
+        case "queue": {
+          col.filter = view.filters.queue
+          break
+        }
+        case "jobId": {
+          col.filter = view.filters.jobId
+          break
+        }
+        case "owner": {
+          col.filter = view.filters.owner
+          break
+        }
+        case "jobSet": {
+          col.filter = view.filters.jobSets.length > 0 ? view.filters.jobSets[0] : ""
+          break
+        }
+        case "submissionTime": {
+          col.filter = view.filters.newestFirst
+          break
+        }
+        case "jobState": {
+          col.filter = view.filters.jobStates
+          break
+        }
+      }
+    }
+    updateAnnotationColumnsFromFilters(this.state.annotationColumns, view.filters.annotations)
+
+    this.setFilters(this.state)
+    this.saveAnnotationColumns()
+  }
+
+  async saveView(name: string) {
+    try {
+      await this.props.jobService.createSavedView(name, this.getJobFilters())
+    } catch (e) {
+      console.error(e)
+    }
+    await this.loadSavedViews()
+  }
+
+  async deleteSavedView(id: string) {
+    try {
+      await this.props.jobService.deleteSavedView(id)
+    } catch (e) {
+      console.error(e)
+    }
+    await this.loadSavedViews()
+  }
+
   refresh() {
     this.setFilters(this.state)
   }
@@ -645,15 +760,21 @@ class JobsContainer extends React.Component<JobsContainerProps, JobsContainerSta
   }
 
   private getJobsRequest(startIndex: number): GetJobsRequest {
-    const request: GetJobsRequest = {
+    return {
+      ...this.getJobFilters(),
+      take: BATCH_SIZE,
+      skip: startIndex,
+    }
+  }
+
+  private getJobFilters(): JobFilters {
+    const request: JobFilters = {
       queue: "",
       jobId: "",
       owner: "",
       jobSets: [],
       newestFirst: true,
       jobStates: [],
-      take: BATCH_SIZE,
-      skip: startIndex,
       annotations: {},
     }
 
@@ -715,6 +836,18 @@ class JobsContainer extends React.Component<JobsContainerProps, JobsContainerSta
     this.setUrlParams()
   }
 
+  private async loadSavedViews() {
+    try {
+      const savedViews = await this.props.jobService.getSavedViews()
+      this.setState({
+        ...this.state,
+        savedViews: savedViews,
+      })
+    } catch (e) {
+      console.error(e)
+    }
+  }
+
   private setStateAsync(state: JobsContainerState): Promise<void> {
     return new Promise((resolve) => this.setState(state, resolve))
   }
@@ -722,7 +855,7 @@ class JobsContainer extends React.Component<JobsContainerProps, JobsContainerSta
   private setUrlParams() {
     this.props.history.push({
       ...this.props.location,
-      search: makeQueryString(this.state.defaultColumns),
+      search: makeQueryString(this.state.defaultColumns, this.state.annotationColumns),
     })
   }
 
@@ -783,6 +916,7 @@ class JobsContainer extends React.Component<JobsContainerProps, JobsContainerSta
           canLoadMore={this.state.canLoadMore}
           defaultColumns={this.state.defaultColumns}
           annotationColumns={this.state.annotationColumns}
+          savedViews={this.state.savedViews}
           selectedJobs={this.state.selectedJobs}
           cancelJobsButtonIsEnabled={this.selectedJobsAreCancellable()}
           reprioritizeButtonIsEnabled={this.selectedJobsAreReprioritizeable()}
@@ -793,6 +927,9 @@ class JobsContainer extends React.Component<JobsContainerProps, JobsContainerSta
           onDeleteColumn={this.deleteAnnotationColumn}
           onAddColumn={this.addAnnotationColumn}
           onChangeAnnotationColumnKey={this.changeAnnotationColumnKey}
+          onApplySavedView={this.applySavedView}
+          onSaveView={this.saveView}
+          onDeleteSavedView={this.deleteSavedView}
           onRefresh={this.refresh}
           onSelectJob={this.selectJob}
           onShiftSelect={this.shiftSelectJob}
@@ -8,6 +8,7 @@ import {
   LookoutJobSetInfo,
   LookoutQueueInfo,
   LookoutRunInfo,
+  LookoutSavedView,
   ApiJob,
 } from "../openapi/lookout"
 import { reverseMap, secondsToDurationString, getErrorMessage } from "../utils"
@@ -62,6 +63,14 @@ export interface GetJobsRequest {
   annotations: { [key: string]: string }
 }
 
+export type JobFilters = Omit<GetJobsRequest, "take" | "skip">
+
+export type SavedView = {
+  id: string
+  name: string
+  filters: JobFilters
+}
+
 export type Job = {
   jobId: string
   queue: string
@@ -197,6 +206,37 @@ export default class JobService {
     return []
   }
 
+  async getSavedViews(): Promise<SavedView[]> {
+    const response = await this.lookoutApi.getSavedViews()
+    if (!response.savedViews) {
+      return []
+    }
+
+    return response.savedViews.map(savedViewToViewModel)
+  }
+
+  async createSavedView(name: string, filters: JobFilters): Promise<SavedView> {
+    const savedView = await this.lookoutApi.createSavedView({
+      body: {
+        name: name,
+        request: {
+          queue: filters.queue,
+          jobSetIds: filters.jobSets,
+          newestFirst: filters.newestFirst,
+          jobStates: filters.jobStates.map(getJobStateForApi),
+          jobId: filters.jobId,
+          owner: filters.owner,
+          userAnnotations: filters.annotations,
+        },
+      },
+    })
+    return savedViewToViewModel(savedView)
+  }
+
+  async deleteSavedView(id: string): Promise<void> {
+    await this.lookoutApi.deleteSavedView({ id: id })
+  }
+
   async cancelJobs(jobs: Job[]): Promise<CancelJobsResult> {
     const result: CancelJobsResult = { cancelledJobs: [], failedJobCancellations: [] }
     for (const job of jobs) {
@@ -430,6 +470,25 @@ function jobSetToViewModel(jobSet: LookoutJobSetInfo): JobSet {
   }
 }
 
+function savedViewToViewModel(savedView: LookoutSavedView): SavedView {
+  const request = savedView.request
+  return {
+    id: savedView.id ?? "",
+    name: savedView.name ?? "",
+    filters: {
+      queue: request?.queue ?? "",
+      jobSets: request?.jobSetIds ?? [],
+      newestFirst: request?.newestFirst ?? false,
+      jobStates: (request?.jobStates ?? [])
+        .map((jobState) => JOB_STATE_MAP.get(jobState))
+        .filter((jobState): jobState is string => jobState !== undefined),
+      jobId: request?.jobId ?? "",
+      owner: request?.owner ?? "",
+      annotations: request?.userAnnotations ?? {},
+    },
+  }
+}
+
 function durationStatsToViewModel(durationStats?: LookoutDurationStats): DurationStats | undefined {
   if (
     !(
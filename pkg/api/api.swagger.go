@@ -18,10 +18,185 @@ func SwaggerJsonTemplate() string {
 		"  ],\n" +
 		"  \"swagger\": \"2.0\",\n" +
 		"  \"info\": {\n" +
-		"    \"title\": \"pkg/api/event.proto\",\n" +
+		"    \"title\": \"pkg/api/audit.proto\",\n" +
 		"    \"version\": \"version not set\"\n" +
 		"  },\n" +
 		"  \"paths\": {\n" +
+		"    \"/v1/api-tokens\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"ApiTokens\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetApiTokens\",\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiApiTokenList\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"ApiTokens\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CreateApiToken\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiApiTokenCreateRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiApiTokenCreateResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/api-tokens/{id}\": {\n" +
+		"      \"delete\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"ApiTokens\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"RevokeApiToken\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"id\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/audit\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Audit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetAuditLog\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"All filters are optional, records matching all specified filters are returned.\",\n" +
+		"            \"name\": \"principal\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobSetId\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"method\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"format\": \"date-time\",\n" +
+		"            \"name\": \"from\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"format\": \"date-time\",\n" +
+		"            \"name\": \"to\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"format\": \"int64\",\n" +
+		"            \"description\": \"Maximum number of returned records, the most recent records are returned first.\",\n" +
+		"            \"name\": \"limit\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiAuditLog\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job-set/close\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Closed job sets do not accept new jobs, they are finalized once all their jobs finish\",\n" +
+		"        \"operationId\": \"CloseJobSet\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetCloseRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job-set/{queue}/{id}\": {\n" +
 		"      \"post\": {\n" +
 		"        \"produces\": [\n" +
@@ -78,6 +253,42 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job-set/{queue}/{jobSetId}/state\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Event\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobSetState\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobSetId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetState\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/cancel\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -162,7 +373,360 @@ func SwaggerJsonTemplate() string {
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiJobSubmitResponse\"\n" +
+		"              \"$ref\": \"#/definitions/apiJobSubmitResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/{jobId}/explain\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Scheduling\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Evaluates a queued job against node types reported by clusters and current limits of its queue\",\n" +
+		"        \"operationId\": \"ExplainJob\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobExplanation\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetQueueInfo\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueInfo\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"put\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CreateQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"delete\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"DeleteQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"patch\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"UpdateQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}/role-bindings\": {\n" +
+		"      \"put\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"SetQueueRoleBindings\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueRoleBindingsRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/events\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Event\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"WatchQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiWatchQueueRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"object\",\n" +
+		"              \"title\": \"Stream result of apiEventStreamMessage\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/apiEventStreamMessage\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/events/filtered\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Event\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"WatchQueueFiltered\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiWatchQueueFilteredRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"object\",\n" +
+		"              \"title\": \"Stream result of apiEventStreamMessage\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/apiEventStreamMessage\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/notifications\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Notification\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetSubscriptions\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiNotificationSubscriptionList\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Notification\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CreateSubscription\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiNotificationSubscription\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiNotificationSubscription\"\n" +
 		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
@@ -174,25 +738,31 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/queue/{name}\": {\n" +
+		"    \"/v1/queue/{queue}/notifications/dead-letters\": {\n" +
 		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
-		"          \"Submit\"\n" +
+		"          \"Notification\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"GetQueueInfo\",\n" +
+		"        \"operationId\": \"GetDeadLetters\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
-		"            \"name\": \"name\",\n" +
+		"            \"name\": \"queue\",\n" +
 		"            \"in\": \"path\",\n" +
 		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"format\": \"int64\",\n" +
+		"            \"name\": \"limit\",\n" +
+		"            \"in\": \"query\"\n" +
 		"          }\n" +
 		"        ],\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiQueueInfo\"\n" +
+		"              \"$ref\": \"#/definitions/apiNotificationDeadLetterList\"\n" +
 		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
@@ -202,26 +772,26 @@ func SwaggerJsonTemplate() string {
 		"            }\n" +
 		"          }\n" +
 		"        }\n" +
-		"      },\n" +
-		"      \"put\": {\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/notifications/{id}\": {\n" +
+		"      \"delete\": {\n" +
 		"        \"tags\": [\n" +
-		"          \"Submit\"\n" +
+		"          \"Notification\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"CreateQueue\",\n" +
+		"        \"operationId\": \"DeleteSubscription\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
-		"            \"name\": \"name\",\n" +
+		"            \"name\": \"queue\",\n" +
 		"            \"in\": \"path\",\n" +
 		"            \"required\": true\n" +
 		"          },\n" +
 		"          {\n" +
-		"            \"name\": \"body\",\n" +
-		"            \"in\": \"body\",\n" +
-		"            \"required\": true,\n" +
-		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
-		"            }\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"id\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
 		"          }\n" +
 		"        ],\n" +
 		"        \"responses\": {\n" +
@@ -236,24 +806,20 @@ func SwaggerJsonTemplate() string {
 		"            }\n" +
 		"          }\n" +
 		"        }\n" +
-		"      },\n" +
-		"      \"delete\": {\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queues\": {\n" +
+		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"DeleteQueue\",\n" +
-		"        \"parameters\": [\n" +
-		"          {\n" +
-		"            \"type\": \"string\",\n" +
-		"            \"name\": \"name\",\n" +
-		"            \"in\": \"path\",\n" +
-		"            \"required\": true\n" +
-		"          }\n" +
-		"        ],\n" +
+		"        \"operationId\": \"GetQueues\",\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
-		"            \"schema\": {}\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueList\"\n" +
+		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
 		"            \"description\": \"An unexpected error response.\",\n" +
@@ -266,6 +832,154 @@ func SwaggerJsonTemplate() string {
 		"    }\n" +
 		"  },\n" +
 		"  \"definitions\": {\n" +
+		"    \"apiApiToken\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Long-lived token issued by Armada, requests authenticated with the token act as the principal with its groups and scopes\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"createdBy\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"expires\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"The token never expires when empty\"\n" +
+		"        },\n" +
+		"        \"groups\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Description of the token, e.g. name of the pipeline using it\"\n" +
+		"        },\n" +
+		"        \"principal\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"scopes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Scopes are mapped to permissions with permissionScopeMapping\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"secretHash\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Hex encoded SHA-256 hash of the token secret, it is never returned by the API\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiApiTokenCreateRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"expires\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"groups\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"principal\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"scopes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiApiTokenCreateResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"secretToken\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Bearer token to authenticate with, it is returned only once and can't be recovered\"\n" +
+		"        },\n" +
+		"        \"token\": {\n" +
+		"          \"$ref\": \"#/definitions/apiApiToken\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiApiTokenList\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"tokens\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiApiToken\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiAuditLog\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"records\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiAuditRecord\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiAuditRecord\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Record of a mutating API call\",\n" +
+		"      \"properties\": {\n" +
+		"        \"code\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Grpc status code of the call, \\\"OK\\\" when the call succeeded\"\n" +
+		"        },\n" +
+		"        \"error\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"groups\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"method\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Full grpc method name, e.g. \\\"/api.Submit/CancelJobs\\\"\"\n" +
+		"        },\n" +
+		"        \"principal\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"time\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiCancellationResult\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -288,6 +1002,34 @@ func SwaggerJsonTemplate() string {
 		"        \"DeadlineExceeded\"\n" +
 		"      ]\n" +
 		"    },\n" +
+		"    \"apiClusterExplanation\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeTypes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiNodeTypeExplanation\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reasons\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Reasons which apply to the whole cluster, e.g. the job is smaller than minimum job size\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"schedulable\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"True when every pod of the job fits some node type of the cluster\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiContainerStatus\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -327,6 +1069,9 @@ func SwaggerJsonTemplate() string {
 		"        \"ingressInfo\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobIngressInfoEvent\"\n" +
 		"        },\n" +
+		"        \"jobSetCompleted\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSetCompletedEvent\"\n" +
+		"        },\n" +
 		"        \"leaseExpired\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobLeaseExpiredEvent\"\n" +
 		"        },\n" +
@@ -544,6 +1289,44 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobExplanation\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Explanation why a queued job is not running, reasons are human readable\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusters\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiClusterExplanation\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"leasedClusterId\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Cluster the job is leased to, empty when the job is still queued\"\n" +
+		"        },\n" +
+		"        \"pools\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiPoolExplanation\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reasons\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Reasons which apply to all clusters, e.g. no cluster reported its nodes recently\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobFailedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -864,12 +1647,67 @@ func SwaggerJsonTemplate() string {
 		"        \"podNamespace\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"podNumber\": {\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetCloseRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetCompletedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Reported when a closed job set is finalized, after all of its jobs finished\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelled\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"duration\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Time from the first submission to finalization of the job set\"\n" +
+		"        },\n" +
+		"        \"failed\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"maxRunTime\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"submitted\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"succeeded\": {\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"totalRunTime\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Sum and maximum of times between start and end of jobs which ran\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -907,10 +1745,71 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobSetState\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Current state of jobs compacted from the job set event stream\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobs\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobState\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"lastMessageId\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Id of the last event included in the snapshot, events after it can be streamed using from_message_id\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobState\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"lastMessageId\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Id of the latest event of the job in the job set stream\"\n" +
+		"        },\n" +
+		"        \"status\": {\n" +
+		"          \"title\": \"Status according to the latest lifecycle event of the job, for multi node jobs it is status of the last updated pod\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobStatus\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobStatus\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"UnknownStatus\",\n" +
+		"      \"enum\": [\n" +
+		"        \"UnknownStatus\",\n" +
+		"        \"Submitted\",\n" +
+		"        \"Duplicate\",\n" +
+		"        \"Queued\",\n" +
+		"        \"Leased\",\n" +
+		"        \"Pending\",\n" +
+		"        \"Running\",\n" +
+		"        \"Succeeded\",\n" +
+		"        \"Failed\",\n" +
+		"        \"Cancelled\"\n" +
+		"      ]\n" +
+		"    },\n" +
 		"    \"apiJobSubmitRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"dryRun\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Validate the jobs and check each of them can be scheduled on some cluster without submitting them\"\n" +
+		"        },\n" +
 		"        \"jobRequestItems\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -922,6 +1821,10 @@ func SwaggerJsonTemplate() string {
 		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"returnDuplicateStatus\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Return current status of the original job for items detected as duplicates of earlier submissions with the same client id\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -988,11 +1891,20 @@ func SwaggerJsonTemplate() string {
 		"    \"apiJobSubmitResponseItem\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"duplicate\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Job with the same client id was submitted earlier, job_id is id of the original job\"\n" +
+		"        },\n" +
 		"        \"error\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Empty for dry run, no job is created\"\n" +
+		"        },\n" +
+		"        \"originalJobStatus\": {\n" +
+		"          \"title\": \"Current status of the original job, set only when requested by return_duplicate_status\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobStatus\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -1174,6 +2086,165 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiNodeType\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"allocatableResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"taints\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Taint\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiNodeTypeExplanation\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"matches\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"True when all pods of the job fit the node type\"\n" +
+		"        },\n" +
+		"        \"nodeType\": {\n" +
+		"          \"$ref\": \"#/definitions/apiNodeType\"\n" +
+		"        },\n" +
+		"        \"reasons\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiNotificationDeadLetter\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Notification which could not be delivered after all retries\",\n" +
+		"      \"properties\": {\n" +
+		"        \"attempts\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"error\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"failed\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"$ref\": \"#/definitions/apiEventMessage\"\n" +
+		"        },\n" +
+		"        \"subscriptionId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"url\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiNotificationDeadLetterList\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"deadLetters\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiNotificationDeadLetter\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiNotificationSubscription\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Webhook notification of events matching the subscription, POSTed as JSON to the subscription url\",\n" +
+		"      \"properties\": {\n" +
+		"        \"eventTypes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Names of event messages to notify about, e.g. \\\"JobFailedEvent\\\", all events when empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetPatterns\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Job set ids to notify about, \\\"*\\\" matches any sequence of characters, all job sets of the queue when empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"owner\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"secret\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Secret used to sign notifications with HMAC-SHA256, it is never returned by the API\"\n" +
+		"        },\n" +
+		"        \"url\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiNotificationSubscriptionList\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"subscriptions\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiNotificationSubscription\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiPoolExplanation\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Limits and share of the job's queue in a pool of clusters\",\n" +
+		"      \"properties\": {\n" +
+		"        \"activeQueues\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\",\n" +
+		"          \"title\": \"Number of queues with queued jobs competing for the pool\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queueFairShare\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\",\n" +
+		"          \"title\": \"Fraction of the pool the queue is entitled to by its priority among active queues\"\n" +
+		"        },\n" +
+		"        \"queuePriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queueUsage\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\",\n" +
+		"          \"title\": \"Fraction of the pool currently used by the queue, weighted by resource scarcity\"\n" +
+		"        },\n" +
+		"        \"reasons\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiQueue\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -1198,11 +2269,22 @@ func SwaggerJsonTemplate() string {
 		"            \"format\": \"double\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"roleBindings\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueRoleBinding\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"userOwners\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"version\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"uint64\",\n" +
+		"          \"title\": \"Incremented on every change of the queue, updates are accepted only for the current version\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -1221,6 +2303,103 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiQueueList\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"queues\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueRole\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"Roles granted on a single queue, each role includes rights of the previous ones\",\n" +
+		"      \"default\": \"NoRole\",\n" +
+		"      \"enum\": [\n" +
+		"        \"NoRole\",\n" +
+		"        \"Viewer\",\n" +
+		"        \"Submitter\",\n" +
+		"        \"Operator\",\n" +
+		"        \"Admin\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiQueueRoleBinding\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Grants the role on the queue to users and members of groups\",\n" +
+		"      \"properties\": {\n" +
+		"        \"groups\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"role\": {\n" +
+		"          \"$ref\": \"#/definitions/apiQueueRole\"\n" +
+		"        },\n" +
+		"        \"users\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueRoleBindingsRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"roleBindings\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Replaces all existing bindings of the queue\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueRoleBinding\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiWatchQueueFilteredRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"eventTypes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Names of event messages to stream, e.g. \\\"JobFailedEvent\\\"\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"fromMessageId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetPatterns\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Job set ids to stream events of, \\\"*\\\" matches any sequence of characters, e.g. \\\"nightly-*\\\"\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiWatchQueueRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"fromMessageId\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Id of the last received message, events after it are streamed, all retained events are streamed when empty\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"intstrIntOrString\": {\n" +
 		"      \"description\": \"+protobuf=true\\n+protobuf.options.(gogoproto.goproto_stringer)=false\\n+k8s:openapi-gen=true\",\n" +
 		"      \"type\": \"object\",\n" +
@@ -3918,6 +5097,28 @@ func SwaggerJsonTemplate() string {
 		"      },\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
 		"    },\n" +
+		"    \"v1Taint\": {\n" +
+		"      \"description\": \"The node this Taint is attached to has the \\\"effect\\\" on\\nany pod that does not tolerate the Taint.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"effect\": {\n" +
+		"          \"description\": \"Required. The effect of the taint on pods\\nthat do not tolerate the taint.\\nValid effects are NoSchedule, PreferNoSchedule and NoExecute.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"key\": {\n" +
+		"          \"description\": \"Required. The taint key to be applied to a node.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"timeAdded\": {\n" +
+		"          \"title\": \"TimeAdded represents the time at which the taint was added.\\nIt is only written for NoExecute taints.\\n+optional\",\n" +
+		"          \"$ref\": \"#/definitions/v1Time\"\n" +
+		"        },\n" +
+		"        \"value\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Required. The taint value corresponding to the taint key.\\n+optional\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"v1TaintEffect\": {\n" +
 		"      \"type\": \"string\",\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
+	"strings"
 	"time"
 )
 
@@ -23,6 +25,11 @@ type KubernetesEvent interface {
 	GetPodNamespace() string
 }
 
+// JobSetCompletedEvent is not related to a single job
+func (m *JobSetCompletedEvent) GetJobId() string {
+	return ""
+}
+
 // customize oneof serialization
 func (message *EventMessage) MarshalJSON() ([]byte, error) {
 	return json.Marshal(message.Events)
@@ -69,6 +76,8 @@ func UnwrapEvent(message *EventMessage) (Event, error) {
 		return event.Utilisation, nil
 	case *EventMessage_IngressInfo:
 		return event.IngressInfo, nil
+	case *EventMessage_JobSetCompleted:
+		return event.JobSetCompleted, nil
 	}
 	return nil, fmt.Errorf("unknown event type: %s", reflect.TypeOf(message.Events))
 }
@@ -183,6 +192,109 @@ func Wrap(event Event) (*EventMessage, error) {
 				IngressInfo: typed,
 			},
 		}, nil
+	case *JobSetCompletedEvent:
+		return &EventMessage{
+			Events: &EventMessage_JobSetCompleted{
+				JobSetCompleted: typed,
+			},
+		}, nil
 	}
 	return nil, fmt.Errorf("unknown event type: %s", reflect.TypeOf(event))
 }
+
+// EventFilter selects events by job set id patterns and event types, an empty filter matches all events
+type EventFilter struct {
+	jobSetPatterns []*regexp.Regexp
+	eventTypes     map[string]bool
+}
+
+// NewEventFilter creates filter matching job set ids against patterns, where "*" matches any sequence of characters,
+// and event types against names of event messages, e.g. JobFailedEvent
+func NewEventFilter(jobSetPatterns []string, eventTypes []string) (*EventFilter, error) {
+	filter := &EventFilter{}
+
+	for _, pattern := range jobSetPatterns {
+		parts := strings.Split(pattern, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		filter.jobSetPatterns = append(filter.jobSetPatterns, regexp.MustCompile("^"+strings.Join(parts, ".*")+"$"))
+	}
+
+	if len(eventTypes) > 0 {
+		knownTypes := eventTypeNames()
+		filter.eventTypes = map[string]bool{}
+		for _, eventType := range eventTypes {
+			if !knownTypes[eventType] {
+				return nil, fmt.Errorf("unknown event type %q", eventType)
+			}
+			filter.eventTypes[eventType] = true
+		}
+	}
+
+	return filter, nil
+}
+
+func (f *EventFilter) Matches(message *EventMessage) bool {
+	if len(f.jobSetPatterns) == 0 && len(f.eventTypes) == 0 {
+		return true
+	}
+
+	event, e := UnwrapEvent(message)
+	if e != nil {
+		return false
+	}
+
+	if len(f.eventTypes) > 0 && !f.eventTypes[EventTypeName(event)] {
+		return false
+	}
+
+	if len(f.jobSetPatterns) == 0 {
+		return true
+	}
+	for _, pattern := range f.jobSetPatterns {
+		if pattern.MatchString(event.GetJobSetId()) {
+			return true
+		}
+	}
+	return false
+}
+
+// JobStatusFromEvent returns status of the job after the event, false is returned for events which don't change job status
+func JobStatusFromEvent(event Event) (JobStatus, bool) {
+	switch event.(type) {
+	case *JobSubmittedEvent:
+		return JobStatus_Submitted, true
+	case *JobDuplicateFoundEvent:
+		return JobStatus_Duplicate, true
+	case *JobQueuedEvent, *JobLeaseReturnedEvent, *JobLeaseExpiredEvent:
+		return JobStatus_Queued, true
+	case *JobLeasedEvent:
+		return JobStatus_Leased, true
+	case *JobPendingEvent:
+		return JobStatus_Pending, true
+	case *JobRunningEvent:
+		return JobStatus_Running, true
+	case *JobSucceededEvent:
+		return JobStatus_Succeeded, true
+	case *JobFailedEvent:
+		return JobStatus_Failed, true
+	case *JobCancelledEvent:
+		return JobStatus_Cancelled, true
+	}
+	return JobStatus_UnknownStatus, false
+}
+
+// EventTypeName returns name of the event message, e.g. JobFailedEvent
+func EventTypeName(event Event) string {
+	return reflect.TypeOf(event).Elem().Name()
+}
+
+// Names of all event messages which can be wrapped in EventMessage
+func eventTypeNames() map[string]bool {
+	names := map[string]bool{}
+	for _, wrapper := range (&EventMessage{}).XXX_OneofWrappers() {
+		names[reflect.TypeOf(wrapper).Elem().Field(0).Type.Elem().Name()] = true
+	}
+	return names
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventFilter(t *testing.T) {
+	filter, e := NewEventFilter([]string{"nightly-*", "exact"}, []string{"JobFailedEvent", "JobSucceededEvent"})
+	assert.Nil(t, e)
+
+	assert.True(t, filter.Matches(wrapEvent(&JobFailedEvent{JobSetId: "nightly-1"})))
+	assert.True(t, filter.Matches(wrapEvent(&JobSucceededEvent{JobSetId: "exact"})))
+	assert.False(t, filter.Matches(wrapEvent(&JobSucceededEvent{JobSetId: "exact-1"})))
+	assert.False(t, filter.Matches(wrapEvent(&JobFailedEvent{JobSetId: "weekly-1"})))
+	assert.False(t, filter.Matches(wrapEvent(&JobQueuedEvent{JobSetId: "nightly-1"})))
+
+	emptyFilter, e := NewEventFilter(nil, nil)
+	assert.Nil(t, e)
+	assert.True(t, emptyFilter.Matches(wrapEvent(&JobQueuedEvent{JobSetId: "any"})))
+}
+
+func TestEventFilter_UnknownEventType(t *testing.T) {
+	_, e := NewEventFilter(nil, []string{"JobExplodedEvent"})
+	assert.Error(t, e)
+}
+
+func wrapEvent(event Event) *EventMessage {
+	msg, _ := Wrap(event)
+	return msg
+}
+
+func TestJobStatusFromEvent(t *testing.T) {
+	status, changed := JobStatusFromEvent(&JobLeaseReturnedEvent{})
+	assert.True(t, changed)
+	assert.Equal(t, JobStatus_Queued, status)
+
+	status, changed = JobStatusFromEvent(&JobRunningEvent{})
+	assert.True(t, changed)
+	assert.Equal(t, JobStatus_Running, status)
+
+	_, changed = JobStatusFromEvent(&JobUtilisationEvent{})
+	assert.False(t, changed)
+}
@@ -0,0 +1,2029 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/api/explain.proto
+
+package api
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type JobExplainRequest struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *JobExplainRequest) Reset()      { *m = JobExplainRequest{} }
+func (*JobExplainRequest) ProtoMessage() {}
+func (*JobExplainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8d2213980438c95, []int{0}
+}
+func (m *JobExplainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobExplainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobExplainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobExplainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobExplainRequest.Merge(m, src)
+}
+func (m *JobExplainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobExplainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobExplainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobExplainRequest proto.InternalMessageInfo
+
+func (m *JobExplainRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+// Explanation why a queued job is not running, reasons are human readable
+type JobExplanation struct {
+	JobId    string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Queue    string `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,3,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	// Cluster the job is leased to, empty when the job is still queued
+	LeasedClusterId string `protobuf:"bytes,4,opt,name=leased_cluster_id,json=leasedClusterId,proto3" json:"leasedClusterId,omitempty"`
+	// Reasons which apply to all clusters, e.g. no cluster reported its nodes recently
+	Reasons  []string              `protobuf:"bytes,5,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Pools    []*PoolExplanation    `protobuf:"bytes,6,rep,name=pools,proto3" json:"pools,omitempty"`
+	Clusters []*ClusterExplanation `protobuf:"bytes,7,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (m *JobExplanation) Reset()      { *m = JobExplanation{} }
+func (*JobExplanation) ProtoMessage() {}
+func (*JobExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8d2213980438c95, []int{1}
+}
+func (m *JobExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobExplanation.Merge(m, src)
+}
+func (m *JobExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobExplanation proto.InternalMessageInfo
+
+func (m *JobExplanation) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobExplanation) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobExplanation) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobExplanation) GetLeasedClusterId() string {
+	if m != nil {
+		return m.LeasedClusterId
+	}
+	return ""
+}
+
+func (m *JobExplanation) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func (m *JobExplanation) GetPools() []*PoolExplanation {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *JobExplanation) GetClusters() []*ClusterExplanation {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+// Limits and share of the job's queue in a pool of clusters
+type PoolExplanation struct {
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Number of queues with queued jobs competing for the pool
+	ActiveQueues  int32   `protobuf:"varint,2,opt,name=active_queues,json=activeQueues,proto3" json:"activeQueues,omitempty"`
+	QueuePriority float64 `protobuf:"fixed64,3,opt,name=queue_priority,json=queuePriority,proto3" json:"queuePriority,omitempty"`
+	// Fraction of the pool the queue is entitled to by its priority among active queues
+	QueueFairShare float64 `protobuf:"fixed64,4,opt,name=queue_fair_share,json=queueFairShare,proto3" json:"queueFairShare,omitempty"`
+	// Fraction of the pool currently used by the queue, weighted by resource scarcity
+	QueueUsage float64  `protobuf:"fixed64,5,opt,name=queue_usage,json=queueUsage,proto3" json:"queueUsage,omitempty"`
+	Reasons    []string `protobuf:"bytes,6,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (m *PoolExplanation) Reset()      { *m = PoolExplanation{} }
+func (*PoolExplanation) ProtoMessage() {}
+func (*PoolExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8d2213980438c95, []int{2}
+}
+func (m *PoolExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolExplanation.Merge(m, src)
+}
+func (m *PoolExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolExplanation proto.InternalMessageInfo
+
+func (m *PoolExplanation) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *PoolExplanation) GetActiveQueues() int32 {
+	if m != nil {
+		return m.ActiveQueues
+	}
+	return 0
+}
+
+func (m *PoolExplanation) GetQueuePriority() float64 {
+	if m != nil {
+		return m.QueuePriority
+	}
+	return 0
+}
+
+func (m *PoolExplanation) GetQueueFairShare() float64 {
+	if m != nil {
+		return m.QueueFairShare
+	}
+	return 0
+}
+
+func (m *PoolExplanation) GetQueueUsage() float64 {
+	if m != nil {
+		return m.QueueUsage
+	}
+	return 0
+}
+
+func (m *PoolExplanation) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+type ClusterExplanation struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Pool      string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// True when every pod of the job fits some node type of the cluster
+	Schedulable bool `protobuf:"varint,3,opt,name=schedulable,proto3" json:"schedulable,omitempty"`
+	// Reasons which apply to the whole cluster, e.g. the job is smaller than minimum job size
+	Reasons   []string               `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	NodeTypes []*NodeTypeExplanation `protobuf:"bytes,5,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
+}
+
+func (m *ClusterExplanation) Reset()      { *m = ClusterExplanation{} }
+func (*ClusterExplanation) ProtoMessage() {}
+func (*ClusterExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8d2213980438c95, []int{3}
+}
+func (m *ClusterExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterExplanation.Merge(m, src)
+}
+func (m *ClusterExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterExplanation proto.InternalMessageInfo
+
+func (m *ClusterExplanation) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *ClusterExplanation) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *ClusterExplanation) GetSchedulable() bool {
+	if m != nil {
+		return m.Schedulable
+	}
+	return false
+}
+
+func (m *ClusterExplanation) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func (m *ClusterExplanation) GetNodeTypes() []*NodeTypeExplanation {
+	if m != nil {
+		return m.NodeTypes
+	}
+	return nil
+}
+
+type NodeTypeExplanation struct {
+	NodeType *NodeType `protobuf:"bytes,1,opt,name=node_type,json=nodeType,proto3" json:"nodeType,omitempty"`
+	// True when all pods of the job fit the node type
+	Matches bool     `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	Reasons []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (m *NodeTypeExplanation) Reset()      { *m = NodeTypeExplanation{} }
+func (*NodeTypeExplanation) ProtoMessage() {}
+func (*NodeTypeExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8d2213980438c95, []int{4}
+}
+func (m *NodeTypeExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeTypeExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeTypeExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeTypeExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTypeExplanation.Merge(m, src)
+}
+func (m *NodeTypeExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeTypeExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTypeExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTypeExplanation proto.InternalMessageInfo
+
+func (m *NodeTypeExplanation) GetNodeType() *NodeType {
+	if m != nil {
+		return m.NodeType
+	}
+	return nil
+}
+
+func (m *NodeTypeExplanation) GetMatches() bool {
+	if m != nil {
+		return m.Matches
+	}
+	return false
+}
+
+func (m *NodeTypeExplanation) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*JobExplainRequest)(nil), "api.JobExplainRequest")
+	proto.RegisterType((*JobExplanation)(nil), "api.JobExplanation")
+	proto.RegisterType((*PoolExplanation)(nil), "api.PoolExplanation")
+	proto.RegisterType((*ClusterExplanation)(nil), "api.ClusterExplanation")
+	proto.RegisterType((*NodeTypeExplanation)(nil), "api.NodeTypeExplanation")
+}
+
+func init() { proto.RegisterFile("pkg/api/explain.proto", fileDescriptor_c8d2213980438c95) }
+
+var fileDescriptor_c8d2213980438c95 = []byte{
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x93, 0x26, 0x4d, 0x26, 0xb4, 0xa5, 0xdb, 0x16, 0xac, 0xa8, 0x98, 0x28, 0x08, 0x29,
+	0x8a, 0x44, 0x2c, 0xd2, 0x03, 0x77, 0x10, 0x48, 0xed, 0x01, 0x15, 0x17, 0x4e, 0x1c, 0xac, 0xb5,
+	0xbd, 0x75, 0x36, 0xb8, 0x5e, 0xd7, 0xbb, 0xae, 0xa8, 0x10, 0x12, 0xea, 0x17, 0x20, 0xf1, 0x3b,
+	0x7c, 0x00, 0xc7, 0x4a, 0x48, 0xa8, 0x47, 0x48, 0xf9, 0x10, 0xe4, 0x59, 0x3b, 0x72, 0x28, 0xdc,
+	0x3c, 0x6f, 0xde, 0xce, 0xbc, 0x99, 0xa7, 0x31, 0xec, 0x24, 0xef, 0x42, 0x9b, 0x26, 0xdc, 0x66,
+	0xef, 0x93, 0x88, 0xf2, 0x78, 0x9c, 0xa4, 0x42, 0x09, 0xd2, 0xa0, 0x09, 0xef, 0x6d, 0x95, 0xb9,
+	0xd3, 0x8c, 0x65, 0x4c, 0x67, 0x7a, 0x8f, 0x42, 0xae, 0xa6, 0x99, 0x37, 0xf6, 0xc5, 0x89, 0x1d,
+	0x8a, 0x50, 0xd8, 0x08, 0x7b, 0xd9, 0x31, 0x46, 0x18, 0xe0, 0x57, 0x41, 0xdf, 0x0d, 0x85, 0x08,
+	0x23, 0x86, 0x65, 0x68, 0x1c, 0x0b, 0x45, 0x15, 0x17, 0xb1, 0xd4, 0xd9, 0xc1, 0x08, 0x36, 0x0f,
+	0x84, 0xf7, 0x5c, 0xb7, 0x76, 0xd8, 0x69, 0xc6, 0xa4, 0x22, 0x3b, 0xd0, 0x9a, 0x09, 0xcf, 0xe5,
+	0x81, 0x69, 0xf4, 0x8d, 0x61, 0xc7, 0x69, 0xce, 0x84, 0xb7, 0x1f, 0x0c, 0x2e, 0xea, 0xb0, 0x5e,
+	0x92, 0x63, 0xac, 0xf2, 0x1f, 0x26, 0xd9, 0x86, 0x26, 0x2a, 0x36, 0xeb, 0x1a, 0xc5, 0x80, 0xec,
+	0x02, 0xe4, 0x64, 0xc9, 0x54, 0xfe, 0xa0, 0x81, 0xa9, 0xf6, 0x4c, 0x78, 0x47, 0x4c, 0xed, 0x07,
+	0x64, 0x04, 0x9b, 0x11, 0xa3, 0x92, 0x05, 0xae, 0x1f, 0x65, 0x52, 0xb1, 0x34, 0x27, 0xad, 0x20,
+	0x69, 0x43, 0x27, 0x9e, 0x69, 0x7c, 0x3f, 0x20, 0x26, 0xac, 0xa6, 0x8c, 0x4a, 0x11, 0x4b, 0xb3,
+	0xd9, 0x6f, 0x0c, 0x3b, 0x4e, 0x19, 0x92, 0x11, 0x34, 0x13, 0x21, 0x22, 0x69, 0xb6, 0xfa, 0x8d,
+	0x61, 0x77, 0xb2, 0x3d, 0xa6, 0x09, 0x1f, 0x1f, 0x0a, 0x11, 0x55, 0x54, 0x3b, 0x9a, 0x42, 0xf6,
+	0xa0, 0x5d, 0xb4, 0x92, 0xe6, 0x2a, 0xd2, 0xef, 0x22, 0xbd, 0xe8, 0x53, 0x7d, 0xb1, 0x20, 0x0e,
+	0x7e, 0x18, 0xb0, 0xf1, 0x57, 0x3d, 0x42, 0x60, 0x25, 0xaf, 0x58, 0xec, 0x00, 0xbf, 0xc9, 0x03,
+	0x58, 0xa3, 0xbe, 0xe2, 0x67, 0xcc, 0xc5, 0xe1, 0x25, 0xae, 0xa2, 0xe9, 0xdc, 0xd2, 0xe0, 0x2b,
+	0xc4, 0xc8, 0x43, 0x58, 0xc7, 0xac, 0x9b, 0xa4, 0x5c, 0xa4, 0x5c, 0x9d, 0xe3, 0x56, 0x0c, 0x67,
+	0x0d, 0xd1, 0xc3, 0x02, 0x24, 0x43, 0xb8, 0xad, 0x69, 0xc7, 0x94, 0xa7, 0xae, 0x9c, 0xd2, 0x94,
+	0xe1, 0x66, 0x0c, 0x47, 0x3f, 0x7f, 0x41, 0x79, 0x7a, 0x94, 0xa3, 0xe4, 0x3e, 0x74, 0x35, 0x33,
+	0x93, 0x34, 0x64, 0x66, 0x13, 0x49, 0x80, 0xd0, 0x9b, 0x1c, 0xa9, 0x6e, 0xae, 0xb5, 0xb4, 0xb9,
+	0xc1, 0x57, 0x03, 0xc8, 0xcd, 0xc9, 0xc9, 0x3d, 0x80, 0x8a, 0x1f, 0x7a, 0xc2, 0x8e, 0xbf, 0x70,
+	0xa2, 0x1c, 0xbd, 0x5e, 0x19, 0xbd, 0x0f, 0x5d, 0xe9, 0x4f, 0x59, 0x90, 0x45, 0xd4, 0x8b, 0x18,
+	0x8e, 0xd4, 0x76, 0xaa, 0x50, 0x55, 0xc5, 0xca, 0xb2, 0x7f, 0x4f, 0x00, 0x62, 0x11, 0x30, 0x57,
+	0x9d, 0x27, 0x4c, 0x9b, 0xdb, 0x9d, 0x98, 0xe8, 0xca, 0x4b, 0x11, 0xb0, 0xd7, 0xe7, 0x09, 0xab,
+	0xda, 0xd2, 0x89, 0x0b, 0x50, 0x0e, 0x32, 0xd8, 0xfa, 0x07, 0x83, 0x8c, 0xa0, 0xb3, 0xa8, 0x87,
+	0xea, 0xbb, 0x93, 0xb5, 0xa5, 0x72, 0x4e, 0xbb, 0xac, 0x91, 0xab, 0x3a, 0xa1, 0xca, 0x9f, 0x16,
+	0x66, 0xb5, 0x9d, 0x32, 0xac, 0xea, 0x6d, 0x2c, 0xe9, 0x9d, 0x70, 0x80, 0x23, 0x3d, 0x18, 0x8f,
+	0x43, 0xf2, 0x16, 0xa0, 0x38, 0xa5, 0x03, 0xe1, 0x91, 0x3b, 0xd8, 0xe8, 0xc6, 0x79, 0xf5, 0xb6,
+	0x96, 0x70, 0x2d, 0x74, 0xd0, 0xbf, 0xf8, 0xfe, 0xfb, 0x4b, 0xbd, 0x47, 0x4c, 0xfb, 0xec, 0xb1,
+	0x3d, 0x13, 0x9e, 0xfd, 0x41, 0x1f, 0xd6, 0xc7, 0xf2, 0xbf, 0xf0, 0xb4, 0x7f, 0xf5, 0xcb, 0xaa,
+	0x7d, 0x9a, 0x5b, 0xc6, 0xb7, 0xb9, 0x65, 0x5c, 0xce, 0x2d, 0xe3, 0xe7, 0xdc, 0x32, 0x3e, 0x5f,
+	0x5b, 0xb5, 0xcb, 0x6b, 0xab, 0x76, 0x75, 0x6d, 0xd5, 0xbc, 0x16, 0xde, 0xf4, 0xde, 0x9f, 0x01,
+	0x00, 0x5f, 0x5d, 0xaa, 0xed, 0x53, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SchedulingClient is the client API for Scheduling service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SchedulingClient interface {
+	// Evaluates a queued job against node types reported by clusters and current limits of its queue
+	ExplainJob(ctx context.Context, in *JobExplainRequest, opts ...grpc.CallOption) (*JobExplanation, error)
+}
+
+type schedulingClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSchedulingClient(cc *grpc.ClientConn) SchedulingClient {
+	return &schedulingClient{cc}
+}
+
+func (c *schedulingClient) ExplainJob(ctx context.Context, in *JobExplainRequest, opts ...grpc.CallOption) (*JobExplanation, error) {
+	out := new(JobExplanation)
+	err := c.cc.Invoke(ctx, "/api.Scheduling/ExplainJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulingServer is the server API for Scheduling service.
+type SchedulingServer interface {
+	// Evaluates a queued job against node types reported by clusters and current limits of its queue
+	ExplainJob(context.Context, *JobExplainRequest) (*JobExplanation, error)
+}
+
+// UnimplementedSchedulingServer can be embedded to have forward compatible implementations.
+type UnimplementedSchedulingServer struct {
+}
+
+func (*UnimplementedSchedulingServer) ExplainJob(ctx context.Context, req *JobExplainRequest) (*JobExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainJob not implemented")
+}
+
+func RegisterSchedulingServer(s *grpc.Server, srv SchedulingServer) {
+	s.RegisterService(&_Scheduling_serviceDesc, srv)
+}
+
+func _Scheduling_ExplainJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServer).ExplainJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Scheduling/ExplainJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServer).ExplainJob(ctx, req.(*JobExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Scheduling_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Scheduling",
+	HandlerType: (*SchedulingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExplainJob",
+			Handler:    _Scheduling_ExplainJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/explain.proto",
+}
+
+func (m *JobExplainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobExplainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobExplainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintExplain(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExplain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExplain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintExplain(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LeasedClusterId) > 0 {
+		i -= len(m.LeasedClusterId)
+		copy(dAtA[i:], m.LeasedClusterId)
+		i = encodeVarintExplain(dAtA, i, uint64(len(m.LeasedClusterId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintExplain(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintExplain(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintExplain(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintExplain(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.QueueUsage != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.QueueUsage))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.QueueFairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.QueueFairShare))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.QueuePriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.QueuePriority))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.ActiveQueues != 0 {
+		i = encodeVarintExplain(dAtA, i, uint64(m.ActiveQueues))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintExplain(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeTypes) > 0 {
+		for iNdEx := len(m.NodeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExplain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintExplain(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Schedulable {
+		i--
+		if m.Schedulable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintExplain(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintExplain(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeTypeExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeTypeExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeTypeExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintExplain(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Matches {
+		i--
+		if m.Matches {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NodeType != nil {
+		{
+			size, err := m.NodeType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExplain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExplain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExplain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobExplainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	return n
+}
+
+func (m *JobExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	l = len(m.LeasedClusterId)
+	if l > 0 {
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovExplain(uint64(l))
+		}
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovExplain(uint64(l))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovExplain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	if m.ActiveQueues != 0 {
+		n += 1 + sovExplain(uint64(m.ActiveQueues))
+	}
+	if m.QueuePriority != 0 {
+		n += 9
+	}
+	if m.QueueFairShare != 0 {
+		n += 9
+	}
+	if m.QueueUsage != 0 {
+		n += 9
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovExplain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	if m.Schedulable {
+		n += 2
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovExplain(uint64(l))
+		}
+	}
+	if len(m.NodeTypes) > 0 {
+		for _, e := range m.NodeTypes {
+			l = e.Size()
+			n += 1 + l + sovExplain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeTypeExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeType != nil {
+		l = m.NodeType.Size()
+		n += 1 + l + sovExplain(uint64(l))
+	}
+	if m.Matches {
+		n += 2
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovExplain(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovExplain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExplain(x uint64) (n int) {
+	return sovExplain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *JobExplainRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobExplainRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobExplanation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPools := "[]*PoolExplanation{"
+	for _, f := range this.Pools {
+		repeatedStringForPools += strings.Replace(f.String(), "PoolExplanation", "PoolExplanation", 1) + ","
+	}
+	repeatedStringForPools += "}"
+	repeatedStringForClusters := "[]*ClusterExplanation{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(f.String(), "ClusterExplanation", "ClusterExplanation", 1) + ","
+	}
+	repeatedStringForClusters += "}"
+	s := strings.Join([]string{`&JobExplanation{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`LeasedClusterId:` + fmt.Sprintf("%v", this.LeasedClusterId) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`Pools:` + repeatedStringForPools + `,`,
+		`Clusters:` + repeatedStringForClusters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PoolExplanation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PoolExplanation{`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`ActiveQueues:` + fmt.Sprintf("%v", this.ActiveQueues) + `,`,
+		`QueuePriority:` + fmt.Sprintf("%v", this.QueuePriority) + `,`,
+		`QueueFairShare:` + fmt.Sprintf("%v", this.QueueFairShare) + `,`,
+		`QueueUsage:` + fmt.Sprintf("%v", this.QueueUsage) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterExplanation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNodeTypes := "[]*NodeTypeExplanation{"
+	for _, f := range this.NodeTypes {
+		repeatedStringForNodeTypes += strings.Replace(f.String(), "NodeTypeExplanation", "NodeTypeExplanation", 1) + ","
+	}
+	repeatedStringForNodeTypes += "}"
+	s := strings.Join([]string{`&ClusterExplanation{`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Schedulable:` + fmt.Sprintf("%v", this.Schedulable) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`NodeTypes:` + repeatedStringForNodeTypes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeTypeExplanation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeTypeExplanation{`,
+		`NodeType:` + strings.Replace(fmt.Sprintf("%v", this.NodeType), "NodeType", "NodeType", 1) + `,`,
+		`Matches:` + fmt.Sprintf("%v", this.Matches) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringExplain(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JobExplainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExplain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobExplainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobExplainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExplain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExplain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasedClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeasedClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &PoolExplanation{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &ClusterExplanation{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExplain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExplain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveQueues", wireType)
+			}
+			m.ActiveQueues = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveQueues |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.QueuePriority = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueFairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.QueueFairShare = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueUsage", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.QueueUsage = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExplain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExplain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedulable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Schedulable = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeTypes = append(m.NodeTypes, &NodeTypeExplanation{})
+			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExplain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeTypeExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExplain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeTypeExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeTypeExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeType == nil {
+				m.NodeType = &NodeType{}
+			}
+			if err := m.NodeType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matches = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExplain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExplain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExplain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExplain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExplain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExplain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExplain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExplain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExplain
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExplain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExplain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExplain = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ package lookout
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -804,921 +805,1114 @@ func (m *GetJobsResponse) GetJobInfos() []*JobInfo {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*SystemOverview)(nil), "lookout.SystemOverview")
-	proto.RegisterType((*JobInfo)(nil), "lookout.JobInfo")
-	proto.RegisterType((*RunInfo)(nil), "lookout.RunInfo")
-	proto.RegisterType((*QueueInfo)(nil), "lookout.QueueInfo")
-	proto.RegisterType((*JobSetInfo)(nil), "lookout.JobSetInfo")
-	proto.RegisterType((*DurationStats)(nil), "lookout.DurationStats")
-	proto.RegisterType((*GetJobSetsRequest)(nil), "lookout.GetJobSetsRequest")
-	proto.RegisterType((*GetJobSetsResponse)(nil), "lookout.GetJobSetsResponse")
-	proto.RegisterType((*GetJobsRequest)(nil), "lookout.GetJobsRequest")
-	proto.RegisterMapType((map[string]string)(nil), "lookout.GetJobsRequest.UserAnnotationsEntry")
-	proto.RegisterType((*GetJobsResponse)(nil), "lookout.GetJobsResponse")
+type GetResourceConsumptionRequest struct {
+	Queue     string     `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetIds []string   `protobuf:"bytes,2,rep,name=job_set_ids,json=jobSetIds,proto3" json:"jobSetIds,omitempty"`
+	Owner     string     `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	From      *time.Time `protobuf:"bytes,4,opt,name=from,proto3,stdtime" json:"from,omitempty"`
+	To        *time.Time `protobuf:"bytes,5,opt,name=to,proto3,stdtime" json:"to,omitempty"`
 }
 
-func init() { proto.RegisterFile("pkg/api/lookout/lookout.proto", fileDescriptor_6ee7620a6fb9cfb1) }
-
-var fileDescriptor_6ee7620a6fb9cfb1 = []byte{
-	// 1219 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x4e, 0x1c, 0x47,
-	0x17, 0xa6, 0xe7, 0xde, 0x67, 0x8c, 0x0d, 0x05, 0x3f, 0x34, 0x03, 0x1e, 0xe6, 0x6f, 0x25, 0x12,
-	0x46, 0xf6, 0x20, 0x40, 0x51, 0x10, 0xb2, 0x22, 0x82, 0x62, 0x47, 0xa0, 0x24, 0x4e, 0x7a, 0x1c,
-	0x65, 0x65, 0x8d, 0xba, 0xa7, 0x6b, 0x86, 0x9e, 0x4b, 0x55, 0xd3, 0x55, 0x0d, 0x62, 0x17, 0xe5,
-	0x09, 0x2c, 0xe5, 0x3d, 0xb2, 0xc8, 0x2e, 0x4f, 0x10, 0x2f, 0x2d, 0x65, 0xe3, 0x55, 0xe2, 0x40,
-	0x5e, 0x22, 0xbb, 0xa8, 0x2e, 0xdd, 0x73, 0x01, 0x0f, 0x62, 0xd5, 0x55, 0xe7, 0x7c, 0xe7, 0x52,
-	0xe7, 0x3b, 0xa7, 0xba, 0xe0, 0x61, 0xd8, 0xeb, 0x6c, 0xb9, 0x61, 0xb0, 0xd5, 0xa7, 0xb4, 0x47,
-	0x63, 0x9e, 0x7c, 0xeb, 0x61, 0x44, 0x39, 0x45, 0x45, 0xbd, 0xad, 0xac, 0x77, 0x28, 0xed, 0xf4,
-	0xf1, 0x96, 0x14, 0x7b, 0x71, 0x7b, 0x8b, 0x07, 0x03, 0xcc, 0xb8, 0x3b, 0x08, 0x15, 0xb2, 0x52,
-	0x9d, 0x04, 0xf8, 0x71, 0xe4, 0xf2, 0x80, 0x12, 0xad, 0x5f, 0x9d, 0xd4, 0xe3, 0x41, 0xc8, 0x2f,
-	0xb4, 0x72, 0x4d, 0x2b, 0x45, 0x22, 0x2e, 0x21, 0x94, 0x4b, 0x4b, 0xa6, 0xb5, 0x4f, 0x3a, 0x01,
-	0x3f, 0x89, 0xbd, 0x7a, 0x8b, 0x0e, 0xb6, 0x3a, 0xb4, 0x43, 0x87, 0x3e, 0xc4, 0x4e, 0x6e, 0xe4,
-	0x4a, 0xc3, 0x17, 0x92, 0x23, 0x9d, 0xc6, 0x38, 0xc6, 0x4a, 0x68, 0x3f, 0x85, 0xfb, 0x8d, 0x0b,
-	0xc6, 0xf1, 0xe0, 0xc5, 0x19, 0x8e, 0xce, 0x02, 0x7c, 0x8e, 0x36, 0xa1, 0x20, 0x01, 0xcc, 0x32,
-	0x6a, 0xd9, 0x8d, 0xf2, 0x0e, 0xaa, 0x27, 0x47, 0xff, 0x4e, 0x88, 0x8f, 0x48, 0x9b, 0x3a, 0x1a,
-	0x61, 0xff, 0x6e, 0x40, 0xf1, 0x98, 0x7a, 0x42, 0x86, 0x2a, 0x90, 0xed, 0x52, 0xcf, 0x32, 0x6a,
-	0xc6, 0x46, 0x79, 0xa7, 0x54, 0x77, 0xc3, 0xa0, 0x7e, 0x4c, 0x3d, 0x47, 0x08, 0xd1, 0x47, 0x90,
-	0x8b, 0x62, 0xc2, 0xac, 0x8c, 0xf4, 0x38, 0x97, 0x7a, 0x74, 0x62, 0x22, 0xfd, 0x49, 0x2d, 0x3a,
-	0x04, 0xb3, 0xe5, 0x92, 0x16, 0xee, 0xf7, 0xb1, 0x6f, 0x65, 0xa5, 0x9f, 0x4a, 0x5d, 0x55, 0xa0,
-	0x9e, 0x1c, 0xad, 0xfe, 0x32, 0xa9, 0xef, 0x61, 0xe9, 0xcd, 0x9f, 0xeb, 0xc6, 0xeb, 0xbf, 0xd6,
-	0x0d, 0x67, 0x68, 0x86, 0x56, 0xc1, 0xec, 0x52, 0xaf, 0xc9, 0xb8, 0xcb, 0xb1, 0x95, 0xab, 0x19,
-	0x1b, 0xa6, 0x53, 0xea, 0x52, 0xaf, 0x21, 0xf6, 0x68, 0x05, 0xc4, 0xba, 0xd9, 0x65, 0x94, 0x58,
-	0x79, 0xa9, 0x2b, 0x76, 0xa9, 0x77, 0xcc, 0x28, 0xb1, 0x7f, 0xc9, 0x42, 0x51, 0x67, 0x83, 0xfe,
-	0x07, 0x85, 0xde, 0x1e, 0x6b, 0x06, 0xbe, 0x3c, 0x8c, 0xe9, 0xe4, 0x7b, 0x7b, 0xec, 0xc8, 0x47,
-	0x16, 0x14, 0x5b, 0xfd, 0x98, 0x71, 0x1c, 0x59, 0x19, 0x65, 0xac, 0xb7, 0x08, 0x41, 0x8e, 0x50,
-	0x1f, 0xcb, 0x9c, 0x4d, 0x47, 0xae, 0xd1, 0x1a, 0x98, 0x2c, 0x6e, 0xb5, 0x30, 0xf6, 0xb1, 0x2f,
-	0x13, 0x29, 0x39, 0x43, 0x01, 0x5a, 0x84, 0x3c, 0x8e, 0x22, 0x1a, 0xe9, 0x34, 0xd4, 0x06, 0x7d,
-	0x06, 0xc5, 0x56, 0x84, 0x5d, 0x8e, 0x7d, 0xab, 0x70, 0x87, 0xe3, 0x27, 0x46, 0xc2, 0x9e, 0x71,
-	0x37, 0x12, 0xf6, 0xc5, 0xbb, 0xd8, 0x6b, 0x23, 0x74, 0x00, 0xa5, 0x76, 0x40, 0x02, 0x76, 0x82,
-	0x7d, 0xab, 0x74, 0x07, 0x07, 0xa9, 0x15, 0x7a, 0x08, 0x10, 0x52, 0xbf, 0x49, 0xe2, 0x81, 0x87,
-	0x23, 0xcb, 0xac, 0x19, 0x1b, 0x79, 0xc7, 0x0c, 0xa9, 0xff, 0x8d, 0x14, 0x08, 0x76, 0xa2, 0x98,
-	0x68, 0x76, 0x40, 0xb1, 0x13, 0xc5, 0x44, 0xb1, 0xf3, 0x18, 0x50, 0x4c, 0x5c, 0xaf, 0x8f, 0x9b,
-	0x9c, 0x36, 0x59, 0xeb, 0x04, 0xfb, 0x71, 0x1f, 0x5b, 0x65, 0x59, 0xba, 0x39, 0xa5, 0x79, 0x49,
-	0x1b, 0x5a, 0x2e, 0x08, 0x33, 0xd3, 0x86, 0x14, 0xf5, 0x94, 0x2d, 0x99, 0x30, 0x26, 0x37, 0x68,
-	0x1d, 0xca, 0x5d, 0xea, 0xb1, 0xa6, 0xdc, 0xf9, 0x92, 0xb5, 0x59, 0x07, 0x84, 0x48, 0x5a, 0xfa,
-	0xe8, 0xff, 0x70, 0x4f, 0x02, 0x42, 0x4c, 0xfc, 0x80, 0x74, 0x24, 0x81, 0xb3, 0x8e, 0x34, 0xfa,
-	0x56, 0x89, 0x52, 0x48, 0x14, 0x13, 0x22, 0x20, 0xb9, 0x21, 0xc4, 0x51, 0x22, 0xf4, 0x14, 0xe6,
-	0x69, 0xdf, 0xc7, 0x8c, 0xeb, 0x40, 0x4d, 0x31, 0x07, 0x79, 0x59, 0xbf, 0x61, 0xab, 0xeb, 0x31,
-	0x71, 0x1e, 0x28, 0xa8, 0x4a, 0xe0, 0x98, 0x7a, 0xe8, 0x00, 0x16, 0xfa, 0x94, 0x74, 0x84, 0xb9,
-	0x8e, 0x21, 0xed, 0x0b, 0x1f, 0xb0, 0x9f, 0xd7, 0x60, 0x1d, 0x5c, 0x78, 0x78, 0x01, 0x4b, 0xe3,
-	0xf1, 0x93, 0x2b, 0x46, 0x77, 0xc1, 0xca, 0x35, 0x12, 0xbf, 0xd0, 0x00, 0x67, 0x71, 0x34, 0x9b,
-	0x44, 0x8a, 0x1a, 0x60, 0x4d, 0xa6, 0x94, 0xba, 0x2c, 0xdd, 0xe6, 0x72, 0x69, 0x3c, 0xc1, 0x44,
-	0x6e, 0xbf, 0xcf, 0x00, 0x1c, 0x53, 0xaf, 0x81, 0xf9, 0x14, 0xc6, 0x96, 0xa1, 0x28, 0xc7, 0x17,
-	0x73, 0x3d, 0x63, 0x85, 0xae, 0x34, 0x99, 0xa4, 0x32, 0x7b, 0x2b, 0x95, 0xb9, 0xdb, 0xa9, 0xcc,
-	0x5f, 0xa7, 0xf2, 0x63, 0xb8, 0x2f, 0x21, 0xc3, 0xd1, 0x2d, 0x48, 0xd0, 0xac, 0x90, 0x36, 0xd2,
-	0xf1, 0x4d, 0xb2, 0x69, 0xbb, 0x41, 0x5f, 0x0f, 0x9b, 0xce, 0xe6, 0xb9, 0x94, 0xa0, 0x7d, 0xb8,
-	0xa7, 0xa3, 0x88, 0xde, 0x66, 0xba, 0x6a, 0x4b, 0x29, 0x9b, 0x49, 0x55, 0xa4, 0xd6, 0x19, 0xc3,
-	0xa2, 0x3d, 0x28, 0xab, 0x53, 0x2a, 0x53, 0x73, 0xaa, 0xe9, 0x28, 0xd4, 0xfe, 0x2d, 0x03, 0xb3,
-	0x63, 0x6a, 0xf4, 0x09, 0x94, 0xd8, 0x09, 0x8d, 0x38, 0x66, 0x5c, 0xdf, 0xcc, 0x53, 0x98, 0x4b,
-	0xa1, 0x68, 0x17, 0x8a, 0x9a, 0x45, 0x49, 0xc3, 0x54, 0xab, 0x04, 0x29, 0x8c, 0xdc, 0x33, 0x1c,
-	0xb9, 0x1d, 0xac, 0x2f, 0xef, 0x69, 0x46, 0x1a, 0x89, 0xb6, 0xa1, 0x30, 0xc0, 0x7e, 0xe0, 0x12,
-	0x49, 0xd8, 0x54, 0x1b, 0x0d, 0x44, 0x8f, 0x20, 0x73, 0xba, 0xad, 0xe7, 0x6b, 0x0a, 0x3c, 0x73,
-	0xba, 0x2d, 0xa1, 0xbb, 0x7a, 0x94, 0xa6, 0x42, 0x77, 0xed, 0x47, 0x30, 0xff, 0x25, 0xe6, 0xaa,
-	0x41, 0x99, 0x83, 0x4f, 0x63, 0x71, 0xa4, 0x1b, 0x9b, 0xd4, 0xfe, 0x1a, 0xd0, 0x28, 0x94, 0x85,
-	0x94, 0x30, 0x8c, 0x3e, 0x85, 0x59, 0xdd, 0xba, 0xcd, 0x80, 0xb4, 0x69, 0xf2, 0xfb, 0x5c, 0x18,
-	0x9d, 0x60, 0xdd, 0xfc, 0xb2, 0xe7, 0xf4, 0x9a, 0xd9, 0xff, 0x66, 0xe0, 0xbe, 0xf2, 0x37, 0x3d,
-	0xae, 0xe8, 0x5f, 0x82, 0xcf, 0xc5, 0x54, 0xb6, 0x83, 0x48, 0x53, 0x53, 0x72, 0xca, 0x4a, 0xf6,
-	0x5c, 0x88, 0xc4, 0xfd, 0x9b, 0xfe, 0xfe, 0x98, 0x95, 0xad, 0x65, 0x37, 0x4c, 0xc7, 0x4c, 0xfe,
-	0x7f, 0x0c, 0x55, 0x65, 0xdf, 0xaa, 0x1c, 0x7d, 0x66, 0xe5, 0x86, 0x7a, 0xcc, 0x8f, 0x7c, 0x26,
-	0x7e, 0x64, 0xdc, 0xed, 0x61, 0x3d, 0x19, 0x72, 0x2d, 0x64, 0xac, 0x17, 0x84, 0x7a, 0x10, 0xe4,
-	0x5a, 0xe4, 0xd7, 0xa5, 0xde, 0x91, 0xea, 0x7c, 0xd3, 0x51, 0x1b, 0x21, 0xa5, 0xe7, 0x04, 0x47,
-	0xb2, 0xdb, 0x4d, 0x47, 0x6d, 0xd0, 0x0f, 0x30, 0x17, 0x33, 0x1c, 0x35, 0x47, 0xde, 0x2f, 0x96,
-	0x29, 0x4b, 0xf3, 0x38, 0x2d, 0xcd, 0xf8, 0xf1, 0xeb, 0xdf, 0x33, 0x1c, 0x7d, 0x3e, 0x84, 0x3f,
-	0x23, 0x3c, 0xba, 0x70, 0x1e, 0xc4, 0xe3, 0xd2, 0xca, 0x21, 0x2c, 0xde, 0x04, 0x44, 0x73, 0x90,
-	0xed, 0xe1, 0x0b, 0x5d, 0x3a, 0xb1, 0x14, 0x89, 0x9d, 0xb9, 0xfd, 0x18, 0xeb, 0x3b, 0x45, 0x6d,
-	0xf6, 0x33, 0x7b, 0x86, 0x7d, 0x00, 0x0f, 0xd2, 0xd8, 0x9a, 0xc7, 0x27, 0xea, 0x05, 0x31, 0xca,
-	0xe1, 0xf5, 0x5b, 0x58, 0xbc, 0x23, 0x24, 0x7b, 0x3b, 0xbf, 0x66, 0xa0, 0xf8, 0x95, 0xd2, 0xa2,
-	0x57, 0x50, 0x4a, 0x9f, 0x51, 0x4b, 0xd7, 0xda, 0xed, 0x99, 0x78, 0xd8, 0x55, 0x96, 0x53, 0x5f,
-	0xe3, 0xef, 0x2e, 0xbb, 0xf6, 0xd3, 0x1f, 0xff, 0xfc, 0x9c, 0xa9, 0x20, 0x4b, 0xbe, 0xd1, 0xce,
-	0xb6, 0xd3, 0x97, 0x27, 0x4d, 0x5c, 0x06, 0x00, 0xc3, 0xbe, 0x43, 0x95, 0x89, 0xea, 0x8d, 0xf4,
-	0x6d, 0x65, 0xf5, 0x46, 0x9d, 0x3a, 0xa0, 0x6d, 0xcb, 0x40, 0x6b, 0xf6, 0xf2, 0x64, 0x20, 0x71,
-	0x7f, 0x61, 0xce, 0xf6, 0x8d, 0x4d, 0xf4, 0x0a, 0x8a, 0xba, 0x2e, 0x68, 0xf9, 0x03, 0x2c, 0x55,
-	0xac, 0xeb, 0x0a, 0x1d, 0x61, 0x5d, 0x46, 0x58, 0xb1, 0x17, 0x6f, 0x8a, 0xb0, 0x6f, 0x6c, 0x1e,
-	0xd6, 0xde, 0xfd, 0x5d, 0x9d, 0xf9, 0xf1, 0xb2, 0x6a, 0xbc, 0xb9, 0xac, 0x1a, 0x6f, 0x2f, 0xab,
-	0xc6, 0xfb, 0xcb, 0xaa, 0xf1, 0xfa, 0xaa, 0x3a, 0xf3, 0xf6, 0xaa, 0x3a, 0xf3, 0xee, 0xaa, 0x3a,
-	0xe3, 0x15, 0x64, 0xd9, 0x76, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x04, 0x1f, 0xf2, 0x88,
-	0x0b, 0x00, 0x00,
+func (m *GetResourceConsumptionRequest) Reset()      { *m = GetResourceConsumptionRequest{} }
+func (*GetResourceConsumptionRequest) ProtoMessage() {}
+func (*GetResourceConsumptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// LookoutClient is the client API for Lookout service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type LookoutClient interface {
-	Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error)
-	GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error)
-	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
+func (m *GetResourceConsumptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type lookoutClient struct {
-	cc *grpc.ClientConn
+func (m *GetResourceConsumptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetResourceConsumptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewLookoutClient(cc *grpc.ClientConn) LookoutClient {
-	return &lookoutClient{cc}
+func (m *GetResourceConsumptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceConsumptionRequest.Merge(m, src)
+}
+func (m *GetResourceConsumptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetResourceConsumptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceConsumptionRequest.DiscardUnknown(m)
 }
 
-func (c *lookoutClient) Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error) {
-	out := new(SystemOverview)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/Overview", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetResourceConsumptionRequest proto.InternalMessageInfo
+
+func (m *GetResourceConsumptionRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lookoutClient) GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error) {
-	out := new(GetJobSetsResponse)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobSets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetResourceConsumptionRequest) GetJobSetIds() []string {
+	if m != nil {
+		return m.JobSetIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lookoutClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
-	out := new(GetJobsResponse)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetResourceConsumptionRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-// LookoutServer is the server API for Lookout service.
-type LookoutServer interface {
-	Overview(context.Context, *types.Empty) (*SystemOverview, error)
-	GetJobSets(context.Context, *GetJobSetsRequest) (*GetJobSetsResponse, error)
-	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
+func (m *GetResourceConsumptionRequest) GetFrom() *time.Time {
+	if m != nil {
+		return m.From
+	}
+	return nil
 }
 
-// UnimplementedLookoutServer can be embedded to have forward compatible implementations.
-type UnimplementedLookoutServer struct {
+func (m *GetResourceConsumptionRequest) GetTo() *time.Time {
+	if m != nil {
+		return m.To
+	}
+	return nil
 }
 
-func (*UnimplementedLookoutServer) Overview(ctx context.Context, req *types.Empty) (*SystemOverview, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Overview not implemented")
+// Resources consumed by runs of jobs in a job set between requested times,
+// cpu is measured in core hours and memory in GiB hours.
+// Used gpu is measured by duty cycle of the gpus, e.g. two gpus busy half of the time count as one hour per hour,
+// so it shows how much of the requested gpu hours were actually used rather than gpu hours allocated to the runs.
+type ResourceConsumption struct {
+	Queue                   string  `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSet                  string  `protobuf:"bytes,2,opt,name=job_set,json=jobSet,proto3" json:"jobSet,omitempty"`
+	Owner                   string  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Runs                    uint32  `protobuf:"varint,4,opt,name=runs,proto3" json:"runs,omitempty"`
+	CpuHoursRequested       float64 `protobuf:"fixed64,5,opt,name=cpu_hours_requested,json=cpuHoursRequested,proto3" json:"cpuHoursRequested,omitempty"`
+	MemoryGibHoursRequested float64 `protobuf:"fixed64,6,opt,name=memory_gib_hours_requested,json=memoryGibHoursRequested,proto3" json:"memoryGibHoursRequested,omitempty"`
+	GpuHoursRequested       float64 `protobuf:"fixed64,7,opt,name=gpu_hours_requested,json=gpuHoursRequested,proto3" json:"gpuHoursRequested,omitempty"`
+	CpuHoursUsed            float64 `protobuf:"fixed64,8,opt,name=cpu_hours_used,json=cpuHoursUsed,proto3" json:"cpuHoursUsed,omitempty"`
+	MemoryGibHoursUsed      float64 `protobuf:"fixed64,9,opt,name=memory_gib_hours_used,json=memoryGibHoursUsed,proto3" json:"memoryGibHoursUsed,omitempty"`
+	GpuDutyCycleHoursUsed   float64 `protobuf:"fixed64,10,opt,name=gpu_duty_cycle_hours_used,json=gpuDutyCycleHoursUsed,proto3" json:"gpuDutyCycleHoursUsed,omitempty"`
+}
+
+func (m *ResourceConsumption) Reset()      { *m = ResourceConsumption{} }
+func (*ResourceConsumption) ProtoMessage() {}
+func (*ResourceConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{11}
+}
+func (m *ResourceConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedLookoutServer) GetJobSets(ctx context.Context, req *GetJobSetsRequest) (*GetJobSetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobSets not implemented")
+func (m *ResourceConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceConsumption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedLookoutServer) GetJobs(ctx context.Context, req *GetJobsRequest) (*GetJobsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+func (m *ResourceConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceConsumption.Merge(m, src)
 }
-
-func RegisterLookoutServer(s *grpc.Server, srv LookoutServer) {
-	s.RegisterService(&_Lookout_serviceDesc, srv)
+func (m *ResourceConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceConsumption.DiscardUnknown(m)
 }
 
-func _Lookout_Overview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(LookoutServer).Overview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lookout.Lookout/Overview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LookoutServer).Overview(ctx, req.(*types.Empty))
+var xxx_messageInfo_ResourceConsumption proto.InternalMessageInfo
+
+func (m *ResourceConsumption) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Lookout_GetJobSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJobSetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(LookoutServer).GetJobSets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lookout.Lookout/GetJobSets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LookoutServer).GetJobSets(ctx, req.(*GetJobSetsRequest))
+func (m *ResourceConsumption) GetJobSet() string {
+	if m != nil {
+		return m.JobSet
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Lookout_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJobsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(LookoutServer).GetJobs(ctx, in)
+func (m *ResourceConsumption) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lookout.Lookout/GetJobs",
+	return ""
+}
+
+func (m *ResourceConsumption) GetRuns() uint32 {
+	if m != nil {
+		return m.Runs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LookoutServer).GetJobs(ctx, req.(*GetJobsRequest))
+	return 0
+}
+
+func (m *ResourceConsumption) GetCpuHoursRequested() float64 {
+	if m != nil {
+		return m.CpuHoursRequested
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Lookout_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lookout.Lookout",
-	HandlerType: (*LookoutServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Overview",
-			Handler:    _Lookout_Overview_Handler,
-		},
-		{
-			MethodName: "GetJobSets",
-			Handler:    _Lookout_GetJobSets_Handler,
-		},
-		{
-			MethodName: "GetJobs",
-			Handler:    _Lookout_GetJobs_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/api/lookout/lookout.proto",
+func (m *ResourceConsumption) GetMemoryGibHoursRequested() float64 {
+	if m != nil {
+		return m.MemoryGibHoursRequested
+	}
+	return 0
 }
 
-func (m *SystemOverview) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ResourceConsumption) GetGpuHoursRequested() float64 {
+	if m != nil {
+		return m.GpuHoursRequested
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SystemOverview) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ResourceConsumption) GetCpuHoursUsed() float64 {
+	if m != nil {
+		return m.CpuHoursUsed
+	}
+	return 0
 }
 
-func (m *SystemOverview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queues) > 0 {
-		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLookout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ResourceConsumption) GetMemoryGibHoursUsed() float64 {
+	if m != nil {
+		return m.MemoryGibHoursUsed
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ResourceConsumption) GetGpuDutyCycleHoursUsed() float64 {
+	if m != nil {
+		return m.GpuDutyCycleHoursUsed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetResourceConsumptionResponse struct {
+	ResourceConsumption []*ResourceConsumption `protobuf:"bytes,1,rep,name=resource_consumption,json=resourceConsumption,proto3" json:"resourceConsumption,omitempty"`
 }
 
-func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobJson) > 0 {
-		i -= len(m.JobJson)
-		copy(dAtA[i:], m.JobJson)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobJson)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.JobState) > 0 {
-		i -= len(m.JobState)
-		copy(dAtA[i:], m.JobState)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobState)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Cancelled != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Cancelled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintLookout(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Runs) > 0 {
-		for iNdEx := len(m.Runs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Runs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLookout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
+func (m *GetResourceConsumptionResponse) Reset()      { *m = GetResourceConsumptionResponse{} }
+func (*GetResourceConsumptionResponse) ProtoMessage() {}
+func (*GetResourceConsumptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{12}
+}
+func (m *GetResourceConsumptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetResourceConsumptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetResourceConsumptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetResourceConsumptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceConsumptionResponse.Merge(m, src)
+}
+func (m *GetResourceConsumptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetResourceConsumptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceConsumptionResponse.DiscardUnknown(m)
 }
 
-func (m *RunInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetResourceConsumptionResponse proto.InternalMessageInfo
+
+func (m *GetResourceConsumptionResponse) GetResourceConsumption() []*ResourceConsumption {
+	if m != nil {
+		return m.ResourceConsumption
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RunInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SavedView struct {
+	Id      string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner   string          `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Request *GetJobsRequest `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	Created *time.Time      `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created,omitempty"`
 }
 
-func (m *RunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnableToSchedule {
-		i--
-		if m.UnableToSchedule {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *SavedView) Reset()      { *m = SavedView{} }
+func (*SavedView) ProtoMessage() {}
+func (*SavedView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{13}
+}
+func (m *SavedView) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SavedView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SavedView.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.RunState) > 0 {
-		i -= len(m.RunState)
-		copy(dAtA[i:], m.RunState)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.RunState)))
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if m.PodNumber != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x48
+}
+func (m *SavedView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SavedView.Merge(m, src)
+}
+func (m *SavedView) XXX_Size() int {
+	return m.Size()
+}
+func (m *SavedView) XXX_DiscardUnknown() {
+	xxx_messageInfo_SavedView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SavedView proto.InternalMessageInfo
+
+func (m *SavedView) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Finished != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Finished, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintLookout(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *SavedView) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.Started != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Started, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintLookout(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *SavedView) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if m.Created != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintLookout(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *SavedView) GetRequest() *GetJobsRequest {
+	if m != nil {
+		return m.Request
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *SavedView) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	if m.Succeeded {
-		i--
-		if m.Succeeded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+type CreateSavedViewRequest struct {
+	Name    string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Request *GetJobsRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *CreateSavedViewRequest) Reset()      { *m = CreateSavedViewRequest{} }
+func (*CreateSavedViewRequest) ProtoMessage() {}
+func (*CreateSavedViewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{14}
+}
+func (m *CreateSavedViewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSavedViewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSavedViewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Node) > 0 {
-		i -= len(m.Node)
-		copy(dAtA[i:], m.Node)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Node)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Cluster) > 0 {
-		i -= len(m.Cluster)
-		copy(dAtA[i:], m.Cluster)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cluster)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.K8SId) > 0 {
-		i -= len(m.K8SId)
-		copy(dAtA[i:], m.K8SId)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.K8SId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CreateSavedViewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSavedViewRequest.Merge(m, src)
+}
+func (m *CreateSavedViewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSavedViewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSavedViewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSavedViewRequest proto.InternalMessageInfo
+
+func (m *CreateSavedViewRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CreateSavedViewRequest) GetRequest() *GetJobsRequest {
+	if m != nil {
+		return m.Request
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetSavedViewsResponse struct {
+	SavedViews []*SavedView `protobuf:"bytes,1,rep,name=saved_views,json=savedViews,proto3" json:"savedViews,omitempty"`
 }
 
-func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LongestRunningDuration != nil {
-		{
-			size, err := m.LongestRunningDuration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
+func (m *GetSavedViewsResponse) Reset()      { *m = GetSavedViewsResponse{} }
+func (*GetSavedViewsResponse) ProtoMessage() {}
+func (*GetSavedViewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{15}
+}
+func (m *GetSavedViewsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSavedViewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSavedViewsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x42
+		return b[:n], nil
 	}
-	if m.OldestQueuedDuration != nil {
-		{
-			size, err := m.OldestQueuedDuration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *GetSavedViewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSavedViewsResponse.Merge(m, src)
+}
+func (m *GetSavedViewsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSavedViewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSavedViewsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSavedViewsResponse proto.InternalMessageInfo
+
+func (m *GetSavedViewsResponse) GetSavedViews() []*SavedView {
+	if m != nil {
+		return m.SavedViews
 	}
-	if m.LongestRunningJob != nil {
-		{
-			size, err := m.LongestRunningJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
+	return nil
+}
+
+type DeleteSavedViewRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *DeleteSavedViewRequest) Reset()      { *m = DeleteSavedViewRequest{} }
+func (*DeleteSavedViewRequest) ProtoMessage() {}
+func (*DeleteSavedViewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{16}
+}
+func (m *DeleteSavedViewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSavedViewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSavedViewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if m.OldestQueuedJob != nil {
-		{
-			size, err := m.OldestQueuedJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
+}
+func (m *DeleteSavedViewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSavedViewRequest.Merge(m, src)
+}
+func (m *DeleteSavedViewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSavedViewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSavedViewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSavedViewRequest proto.InternalMessageInfo
+
+func (m *DeleteSavedViewRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Submits copies of jobs stored in Lookout, fields which are not set are copied from the original job
+type ResubmitJobsRequest struct {
+	JobIds   []string           `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+	Queue    string             `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string             `protobuf:"bytes,3,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Priority *types.DoubleValue `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Quantities replacing requests and limits of all containers, e.g. {"cpu": "2", "memory": "4Gi"}
+	Resources map[string]string `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *ResubmitJobsRequest) Reset()      { *m = ResubmitJobsRequest{} }
+func (*ResubmitJobsRequest) ProtoMessage() {}
+func (*ResubmitJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{17}
+}
+func (m *ResubmitJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResubmitJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResubmitJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if m.JobsRunning != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRunning))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *ResubmitJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResubmitJobsRequest.Merge(m, src)
+}
+func (m *ResubmitJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResubmitJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResubmitJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResubmitJobsRequest proto.InternalMessageInfo
+
+func (m *ResubmitJobsRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
 	}
-	if m.JobsPending != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsPending))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *ResubmitJobsRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	if m.JobsQueued != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsQueued))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *ResubmitJobsRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ResubmitJobsRequest) GetPriority() *types.DoubleValue {
+	if m != nil {
+		return m.Priority
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ResubmitJobsRequest) GetResources() map[string]string {
+	if m != nil {
+		return m.Resources
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ResubmitJobsResponse struct {
+	ResubmittedJobs []*ResubmittedJob `protobuf:"bytes,1,rep,name=resubmitted_jobs,json=resubmittedJobs,proto3" json:"resubmittedJobs,omitempty"`
 }
 
-func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueuedStats != nil {
-		{
-			size, err := m.QueuedStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.RunningStats != nil {
-		{
-			size, err := m.RunningStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
+func (m *ResubmitJobsResponse) Reset()      { *m = ResubmitJobsResponse{} }
+func (*ResubmitJobsResponse) ProtoMessage() {}
+func (*ResubmitJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{18}
+}
+func (m *ResubmitJobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResubmitJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResubmitJobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.JobsFailed != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsFailed))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.JobsSucceeded != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsSucceeded))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.JobsRunning != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRunning))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.JobsPending != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsPending))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.JobsQueued != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsQueued))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *ResubmitJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResubmitJobsResponse.Merge(m, src)
+}
+func (m *ResubmitJobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResubmitJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResubmitJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResubmitJobsResponse proto.InternalMessageInfo
+
+func (m *ResubmitJobsResponse) GetResubmittedJobs() []*ResubmittedJob {
+	if m != nil {
+		return m.ResubmittedJobs
 	}
-	if len(m.JobSet) > 0 {
-		i -= len(m.JobSet)
-		copy(dAtA[i:], m.JobSet)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSet)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+type ResubmittedJob struct {
+	OriginalJobId string `protobuf:"bytes,1,opt,name=original_job_id,json=originalJobId,proto3" json:"originalJobId,omitempty"`
+	JobId         string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ResubmittedJob) Reset()      { *m = ResubmittedJob{} }
+func (*ResubmittedJob) ProtoMessage() {}
+func (*ResubmittedJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{19}
+}
+func (m *ResubmittedJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResubmittedJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResubmittedJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ResubmittedJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResubmittedJob.Merge(m, src)
+}
+func (m *ResubmittedJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResubmittedJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResubmittedJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResubmittedJob proto.InternalMessageInfo
+
+func (m *ResubmittedJob) GetOriginalJobId() string {
+	if m != nil {
+		return m.OriginalJobId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DurationStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ResubmittedJob) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DurationStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ResubmittedJob) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *DurationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Q3 != nil {
-		{
-			size, err := m.Q3.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Q1 != nil {
-		{
-			size, err := m.Q1.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Median != nil {
-		{
-			size, err := m.Median.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Average != nil {
-		{
-			size, err := m.Average.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Longest != nil {
-		{
-			size, err := m.Longest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Shortest != nil {
-		{
-			size, err := m.Shortest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func init() {
+	proto.RegisterType((*SystemOverview)(nil), "lookout.SystemOverview")
+	proto.RegisterType((*JobInfo)(nil), "lookout.JobInfo")
+	proto.RegisterType((*RunInfo)(nil), "lookout.RunInfo")
+	proto.RegisterType((*QueueInfo)(nil), "lookout.QueueInfo")
+	proto.RegisterType((*JobSetInfo)(nil), "lookout.JobSetInfo")
+	proto.RegisterType((*DurationStats)(nil), "lookout.DurationStats")
+	proto.RegisterType((*GetJobSetsRequest)(nil), "lookout.GetJobSetsRequest")
+	proto.RegisterType((*GetJobSetsResponse)(nil), "lookout.GetJobSetsResponse")
+	proto.RegisterType((*GetJobsRequest)(nil), "lookout.GetJobsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "lookout.GetJobsRequest.UserAnnotationsEntry")
+	proto.RegisterType((*GetJobsResponse)(nil), "lookout.GetJobsResponse")
+	proto.RegisterType((*GetResourceConsumptionRequest)(nil), "lookout.GetResourceConsumptionRequest")
+	proto.RegisterType((*ResourceConsumption)(nil), "lookout.ResourceConsumption")
+	proto.RegisterType((*GetResourceConsumptionResponse)(nil), "lookout.GetResourceConsumptionResponse")
+	proto.RegisterType((*SavedView)(nil), "lookout.SavedView")
+	proto.RegisterType((*CreateSavedViewRequest)(nil), "lookout.CreateSavedViewRequest")
+	proto.RegisterType((*GetSavedViewsResponse)(nil), "lookout.GetSavedViewsResponse")
+	proto.RegisterType((*DeleteSavedViewRequest)(nil), "lookout.DeleteSavedViewRequest")
+	proto.RegisterType((*ResubmitJobsRequest)(nil), "lookout.ResubmitJobsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "lookout.ResubmitJobsRequest.ResourcesEntry")
+	proto.RegisterType((*ResubmitJobsResponse)(nil), "lookout.ResubmitJobsResponse")
+	proto.RegisterType((*ResubmittedJob)(nil), "lookout.ResubmittedJob")
+}
+
+func init() { proto.RegisterFile("pkg/api/lookout/lookout.proto", fileDescriptor_6ee7620a6fb9cfb1) }
+
+var fileDescriptor_6ee7620a6fb9cfb1 = []byte{
+	// 1854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5b, 0x6f, 0xe4, 0x48,
+	0xf5, 0x1f, 0xbb, 0xaf, 0x3e, 0x99, 0x5c, 0xa6, 0x72, 0xf3, 0x78, 0x92, 0x4e, 0xff, 0xfd, 0x5f,
+	0x86, 0x6c, 0xd8, 0xed, 0x28, 0x13, 0x10, 0x51, 0x18, 0xa1, 0x21, 0x33, 0xbb, 0x43, 0xa2, 0x85,
+	0x01, 0x67, 0x77, 0x91, 0x90, 0x56, 0x96, 0xdd, 0xae, 0x74, 0xdc, 0xe9, 0x76, 0x39, 0x55, 0x76,
+	0x46, 0x2d, 0x84, 0x84, 0xf8, 0x04, 0x8b, 0x78, 0xe7, 0x8d, 0x57, 0x9e, 0xe1, 0x13, 0xb0, 0x8f,
+	0x2b, 0xf1, 0xb2, 0x12, 0x12, 0x2c, 0x33, 0x7c, 0x09, 0x78, 0x42, 0x75, 0xb1, 0xdb, 0x7d, 0x4b,
+	0x13, 0x9e, 0xba, 0xea, 0x9c, 0xf3, 0xab, 0xf3, 0xab, 0x3a, 0x97, 0x2a, 0x37, 0x6c, 0xc7, 0x57,
+	0x9d, 0x7d, 0x2f, 0x0e, 0xf7, 0x7b, 0x84, 0x5c, 0x91, 0x34, 0xc9, 0x7e, 0x5b, 0x31, 0x25, 0x09,
+	0x41, 0x35, 0x35, 0xb5, 0x76, 0x3a, 0x84, 0x74, 0x7a, 0x78, 0x5f, 0x88, 0xfd, 0xf4, 0x62, 0x3f,
+	0x09, 0xfb, 0x98, 0x25, 0x5e, 0x3f, 0x96, 0x96, 0x56, 0x63, 0xdc, 0x20, 0x48, 0xa9, 0x97, 0x84,
+	0x24, 0x52, 0xfa, 0x47, 0xe3, 0x7a, 0xdc, 0x8f, 0x93, 0xc1, 0x2c, 0xf0, 0x6b, 0xea, 0xc5, 0x31,
+	0xa6, 0x4c, 0xe9, 0xb7, 0x94, 0x9e, 0x13, 0xf5, 0xa2, 0x88, 0x24, 0x62, 0xe5, 0x4c, 0xfb, 0x7e,
+	0x27, 0x4c, 0x2e, 0x53, 0xbf, 0xd5, 0x26, 0xfd, 0xfd, 0x0e, 0xe9, 0x90, 0xe1, 0x32, 0x7c, 0x26,
+	0x26, 0x62, 0xa4, 0xcc, 0x57, 0xb3, 0x2d, 0x5f, 0xa7, 0x38, 0xc5, 0x52, 0x68, 0x3f, 0x85, 0xa5,
+	0xf3, 0x01, 0x4b, 0x70, 0xff, 0xd5, 0x0d, 0xa6, 0x37, 0x21, 0x7e, 0x8d, 0xf6, 0xa0, 0x2a, 0x0c,
+	0x98, 0xa9, 0x35, 0x4b, 0xbb, 0x0b, 0x4f, 0x50, 0x2b, 0x3b, 0x9a, 0x9f, 0x72, 0xf1, 0x69, 0x74,
+	0x41, 0x1c, 0x65, 0x61, 0xff, 0x59, 0x83, 0xda, 0x19, 0xf1, 0xb9, 0x0c, 0x59, 0x50, 0xea, 0x12,
+	0xdf, 0xd4, 0x9a, 0xda, 0xee, 0xc2, 0x93, 0x7a, 0xcb, 0x8b, 0xc3, 0xd6, 0x19, 0xf1, 0x1d, 0x2e,
+	0x44, 0xef, 0x40, 0x99, 0xa6, 0x11, 0x33, 0x75, 0xb1, 0xe2, 0x4a, 0xbe, 0xa2, 0x93, 0x46, 0x62,
+	0x3d, 0xa1, 0x45, 0x27, 0x60, 0xb4, 0xbd, 0xa8, 0x8d, 0x7b, 0x3d, 0x1c, 0x98, 0x25, 0xb1, 0x8e,
+	0xd5, 0x92, 0x27, 0xd0, 0xca, 0xb6, 0xd6, 0xfa, 0x38, 0x3b, 0xff, 0x93, 0xfa, 0x17, 0x7f, 0xdb,
+	0xd1, 0x3e, 0xff, 0xfb, 0x8e, 0xe6, 0x0c, 0x61, 0xe8, 0x11, 0x18, 0x5d, 0xe2, 0xbb, 0x2c, 0xf1,
+	0x12, 0x6c, 0x96, 0x9b, 0xda, 0xae, 0xe1, 0xd4, 0xbb, 0xc4, 0x3f, 0xe7, 0x73, 0xf4, 0x10, 0xf8,
+	0xd8, 0xed, 0x32, 0x12, 0x99, 0x15, 0xa1, 0xab, 0x75, 0x89, 0x7f, 0xc6, 0x48, 0x64, 0xff, 0xa1,
+	0x04, 0x35, 0xc5, 0x06, 0xad, 0x43, 0xf5, 0xea, 0x88, 0xb9, 0x61, 0x20, 0x36, 0x63, 0x38, 0x95,
+	0xab, 0x23, 0x76, 0x1a, 0x20, 0x13, 0x6a, 0xed, 0x5e, 0xca, 0x12, 0x4c, 0x4d, 0x5d, 0x82, 0xd5,
+	0x14, 0x21, 0x28, 0x47, 0x24, 0xc0, 0x82, 0xb3, 0xe1, 0x88, 0x31, 0xda, 0x02, 0x83, 0xa5, 0xed,
+	0x36, 0xc6, 0x01, 0x0e, 0x04, 0x91, 0xba, 0x33, 0x14, 0xa0, 0x35, 0xa8, 0x60, 0x4a, 0x09, 0x55,
+	0x34, 0xe4, 0x04, 0x7d, 0x1f, 0x6a, 0x6d, 0x8a, 0xbd, 0x04, 0x07, 0x66, 0xf5, 0x0e, 0xdb, 0xcf,
+	0x40, 0x1c, 0xcf, 0x12, 0x8f, 0x72, 0x7c, 0xed, 0x2e, 0x78, 0x05, 0x42, 0xcf, 0xa0, 0x7e, 0x11,
+	0x46, 0x21, 0xbb, 0xc4, 0x81, 0x59, 0xbf, 0xc3, 0x02, 0x39, 0x0a, 0x6d, 0x03, 0xc4, 0x24, 0x70,
+	0xa3, 0xb4, 0xef, 0x63, 0x6a, 0x1a, 0x4d, 0x6d, 0xb7, 0xe2, 0x18, 0x31, 0x09, 0x7e, 0x2c, 0x04,
+	0x3c, 0x3a, 0x34, 0x8d, 0x54, 0x74, 0x40, 0x46, 0x87, 0xa6, 0x91, 0x8c, 0xce, 0x7b, 0x80, 0xd2,
+	0xc8, 0xf3, 0x7b, 0xd8, 0x4d, 0x88, 0xcb, 0xda, 0x97, 0x38, 0x48, 0x7b, 0xd8, 0x5c, 0x10, 0x47,
+	0xb7, 0x22, 0x35, 0x1f, 0x93, 0x73, 0x25, 0xe7, 0x01, 0x33, 0xf2, 0x84, 0xe4, 0xe7, 0x29, 0x52,
+	0x32, 0x8b, 0x98, 0x98, 0xa0, 0x1d, 0x58, 0xe8, 0x12, 0x9f, 0xb9, 0x62, 0x16, 0x88, 0xa8, 0x2d,
+	0x3a, 0xc0, 0x45, 0x02, 0x19, 0xa0, 0xff, 0x83, 0xfb, 0xc2, 0x20, 0xc6, 0x51, 0x10, 0x46, 0x1d,
+	0x11, 0xc0, 0x45, 0x47, 0x80, 0x7e, 0x22, 0x45, 0xb9, 0x09, 0x4d, 0xa3, 0x88, 0x9b, 0x94, 0x87,
+	0x26, 0x8e, 0x14, 0xa1, 0xa7, 0xf0, 0x80, 0xf4, 0x02, 0xcc, 0x12, 0xe5, 0xc8, 0xe5, 0x75, 0x50,
+	0x69, 0x6a, 0x23, 0xa9, 0xae, 0xca, 0xc4, 0x59, 0x96, 0xa6, 0x92, 0xc0, 0x19, 0xf1, 0xd1, 0x33,
+	0x58, 0xed, 0x91, 0xa8, 0xc3, 0xe1, 0xca, 0x87, 0xc0, 0x57, 0x67, 0xe0, 0x1f, 0x28, 0x63, 0xe5,
+	0x9c, 0xaf, 0xf0, 0x0a, 0x36, 0x46, 0xfd, 0x67, 0x2d, 0x48, 0x65, 0xc1, 0xc3, 0x89, 0x20, 0xbe,
+	0x50, 0x06, 0xce, 0x5a, 0x91, 0x4d, 0x26, 0x45, 0xe7, 0x60, 0x8e, 0x53, 0xca, 0x97, 0xac, 0xcf,
+	0x5b, 0x72, 0x63, 0x94, 0x60, 0x26, 0xb7, 0xbf, 0xd6, 0x01, 0xce, 0x88, 0x7f, 0x8e, 0x93, 0x5b,
+	0x22, 0xb6, 0x09, 0x35, 0x51, 0xbe, 0x38, 0x51, 0x35, 0x56, 0xed, 0x0a, 0xc8, 0x78, 0x28, 0x4b,
+	0x73, 0x43, 0x59, 0x9e, 0x1f, 0xca, 0xca, 0x64, 0x28, 0xbf, 0x01, 0x4b, 0xc2, 0x64, 0x58, 0xba,
+	0x55, 0x61, 0xb4, 0xc8, 0xa5, 0xe7, 0x99, 0x30, 0x67, 0x73, 0xe1, 0x85, 0x3d, 0x55, 0x6c, 0x8a,
+	0xcd, 0x87, 0x42, 0x82, 0x8e, 0xe1, 0xbe, 0xf2, 0xc2, 0x73, 0x9b, 0xa9, 0x53, 0xdb, 0xc8, 0xa3,
+	0x99, 0x9d, 0x8a, 0xd0, 0x3a, 0x23, 0xb6, 0xe8, 0x08, 0x16, 0xe4, 0x2e, 0x25, 0xd4, 0xb8, 0x15,
+	0x5a, 0x34, 0xb5, 0xff, 0xa4, 0xc3, 0xe2, 0x88, 0x1a, 0x7d, 0x07, 0xea, 0xec, 0x92, 0xd0, 0x04,
+	0xb3, 0xc4, 0xd4, 0xe6, 0x45, 0x2e, 0x37, 0x45, 0x87, 0x50, 0x53, 0x51, 0x34, 0xf5, 0x79, 0xa8,
+	0xcc, 0x92, 0x83, 0xbc, 0x1b, 0x4c, 0xbd, 0x0e, 0x36, 0x4b, 0x73, 0x41, 0xca, 0x12, 0x1d, 0x40,
+	0xb5, 0x8f, 0x83, 0xd0, 0x8b, 0xcc, 0xf2, 0x3c, 0x8c, 0x32, 0x44, 0xef, 0x82, 0x7e, 0x7d, 0x60,
+	0x56, 0xe6, 0x99, 0xeb, 0xd7, 0x07, 0xc2, 0xf4, 0xd0, 0xac, 0xce, 0x37, 0x3d, 0xb4, 0xdf, 0x85,
+	0x07, 0x2f, 0x71, 0x22, 0x13, 0x94, 0x39, 0xf8, 0x3a, 0xe5, 0x5b, 0x9a, 0x9a, 0xa4, 0xf6, 0x8f,
+	0x00, 0x15, 0x4d, 0x59, 0x4c, 0x22, 0x86, 0xd1, 0x77, 0x61, 0x51, 0xa5, 0xae, 0x1b, 0x46, 0x17,
+	0x24, 0xbb, 0x3e, 0x57, 0x8b, 0x15, 0xac, 0x92, 0x5f, 0xe4, 0x9c, 0x1a, 0x33, 0xfb, 0x5f, 0x3a,
+	0x2c, 0xc9, 0xf5, 0x6e, 0xf7, 0xcb, 0xf3, 0x37, 0xc2, 0xaf, 0x79, 0x55, 0x5e, 0x84, 0x54, 0x85,
+	0xa6, 0xee, 0x2c, 0x48, 0xd9, 0x87, 0x5c, 0xc4, 0xfb, 0x6f, 0x7e, 0xfd, 0x31, 0xb3, 0xd4, 0x2c,
+	0xed, 0x1a, 0x8e, 0x91, 0xdd, 0x7f, 0x0c, 0x35, 0x60, 0x21, 0xe7, 0x18, 0x30, 0xb3, 0x3c, 0xd4,
+	0xe3, 0xe4, 0x34, 0x60, 0xfc, 0x22, 0x4b, 0xbc, 0x2b, 0xac, 0x2a, 0x43, 0x8c, 0xb9, 0x8c, 0x5d,
+	0x85, 0xb1, 0x2a, 0x04, 0x31, 0xe6, 0xfc, 0xba, 0xc4, 0x3f, 0x95, 0x99, 0x6f, 0x38, 0x72, 0xc2,
+	0xa5, 0xe4, 0x75, 0x84, 0xa9, 0xc8, 0x76, 0xc3, 0x91, 0x13, 0xf4, 0x33, 0x58, 0x49, 0x19, 0xa6,
+	0x6e, 0xe1, 0xfd, 0x62, 0x1a, 0xe2, 0x68, 0xde, 0xcb, 0x8f, 0x66, 0x74, 0xfb, 0xad, 0x4f, 0x18,
+	0xa6, 0x3f, 0x18, 0x9a, 0x7f, 0x10, 0x25, 0x74, 0xe0, 0x2c, 0xa7, 0xa3, 0x52, 0xeb, 0x04, 0xd6,
+	0xa6, 0x19, 0xa2, 0x15, 0x28, 0x5d, 0xe1, 0x81, 0x3a, 0x3a, 0x3e, 0xe4, 0xc4, 0x6e, 0xbc, 0x5e,
+	0x8a, 0x55, 0x4f, 0x91, 0x93, 0x63, 0xfd, 0x48, 0xb3, 0x9f, 0xc1, 0x72, 0xee, 0x5b, 0xc5, 0xf1,
+	0x7d, 0xf9, 0x82, 0x28, 0xc6, 0x70, 0xb2, 0x0b, 0xd7, 0xbb, 0x72, 0xc0, 0xec, 0xbf, 0x6a, 0xb0,
+	0xfd, 0x12, 0x27, 0x0e, 0x66, 0x24, 0xa5, 0x6d, 0xfc, 0x9c, 0x44, 0x2c, 0xed, 0xc7, 0x22, 0xad,
+	0x6e, 0x0d, 0xe6, 0x58, 0x28, 0xf4, 0xf1, 0x50, 0xe4, 0x87, 0x59, 0x2a, 0x1e, 0xe6, 0x11, 0x94,
+	0x2f, 0x28, 0xe9, 0x9b, 0xe5, 0x3b, 0xdc, 0xce, 0x02, 0x81, 0xbe, 0x0d, 0x7a, 0x42, 0xcc, 0xca,
+	0x1d, 0x70, 0x7a, 0x42, 0xec, 0xdf, 0x95, 0x60, 0x75, 0xca, 0xd6, 0xee, 0xda, 0xbd, 0xa7, 0x6f,
+	0x06, 0xa9, 0x57, 0xa1, 0x6c, 0xd5, 0x62, 0x8c, 0x5a, 0xb0, 0xda, 0x8e, 0x53, 0xf7, 0x92, 0xa4,
+	0x94, 0xb9, 0x54, 0x9e, 0x20, 0x0e, 0x04, 0x6f, 0xcd, 0x79, 0xd0, 0x8e, 0xd3, 0x1f, 0x72, 0x8d,
+	0x93, 0x29, 0xd0, 0xf7, 0xc0, 0xea, 0xe3, 0x3e, 0xa1, 0x03, 0xb7, 0x13, 0xfa, 0x13, 0xb0, 0xaa,
+	0x80, 0x6d, 0x4a, 0x8b, 0x97, 0xa1, 0x3f, 0x06, 0x6e, 0xc1, 0x6a, 0x67, 0x8a, 0xb3, 0x9a, 0x74,
+	0xd6, 0x99, 0x70, 0xf6, 0x0e, 0x2c, 0x0d, 0xc9, 0xa5, 0x4c, 0xbd, 0x92, 0x34, 0xe7, 0x7e, 0xc6,
+	0xeb, 0x13, 0x86, 0x03, 0x74, 0x00, 0xeb, 0x13, 0x94, 0x84, 0xb1, 0x21, 0x8c, 0xd1, 0x28, 0x1b,
+	0x01, 0x39, 0x82, 0x87, 0x9c, 0x48, 0x90, 0x26, 0x03, 0xb7, 0x3d, 0x68, 0xf7, 0x70, 0x11, 0x06,
+	0x02, 0xb6, 0xde, 0x89, 0xd3, 0x17, 0x69, 0x32, 0x78, 0xce, 0xd5, 0x39, 0xd2, 0xbe, 0x86, 0xc6,
+	0xac, 0xec, 0x53, 0xf9, 0xfc, 0x0a, 0xd6, 0xa8, 0x52, 0xbb, 0xed, 0xa1, 0x5e, 0xa5, 0xf6, 0xd6,
+	0xf0, 0x2d, 0x3e, 0x65, 0x8d, 0x55, 0x3a, 0x29, 0xb4, 0xff, 0xa8, 0x81, 0x71, 0xee, 0xdd, 0xe0,
+	0xe0, 0x53, 0xfe, 0xb9, 0xb0, 0x04, 0x7a, 0xfe, 0x50, 0xd6, 0xc3, 0x40, 0xbc, 0x85, 0xbd, 0x7e,
+	0x56, 0x6a, 0x62, 0x3c, 0x23, 0xfc, 0x07, 0x50, 0x53, 0x67, 0xae, 0xd2, 0x79, 0x73, 0x46, 0x3f,
+	0x70, 0x32, 0xbb, 0xe2, 0x03, 0xb9, 0xf2, 0x3f, 0x3c, 0x90, 0x6d, 0x17, 0x36, 0x9e, 0x8b, 0x61,
+	0xce, 0x3f, 0x2b, 0xd2, 0x8c, 0xb6, 0x56, 0xa0, 0x5d, 0x20, 0xa8, 0xff, 0x77, 0x04, 0xed, 0x8f,
+	0x60, 0xfd, 0x25, 0x4e, 0xf2, 0xd5, 0x87, 0x5d, 0xe5, 0x10, 0x16, 0x18, 0x97, 0xba, 0xfc, 0x1b,
+	0x6b, 0xf2, 0xd3, 0x6a, 0xc8, 0x07, 0x58, 0x0e, 0xb6, 0x77, 0x61, 0xe3, 0x05, 0xee, 0xe1, 0x29,
+	0x74, 0xc7, 0x4e, 0xdd, 0xfe, 0xbd, 0x2e, 0xea, 0x34, 0xf5, 0xfb, 0xe1, 0xc8, 0x45, 0xa2, 0x2a,
+	0x32, 0x0c, 0xa4, 0x4b, 0x59, 0x91, 0xaa, 0xbd, 0xc8, 0x02, 0xd6, 0x8b, 0x05, 0xbc, 0x05, 0x30,
+	0x6c, 0x4a, 0x2a, 0x5a, 0xf5, 0xac, 0x27, 0xa1, 0x23, 0xa8, 0xc7, 0x34, 0x24, 0x34, 0x4c, 0x06,
+	0x2a, 0x62, 0x5b, 0x93, 0x77, 0x2a, 0x49, 0xfd, 0x1e, 0xfe, 0x94, 0x37, 0x58, 0x27, 0xb7, 0x46,
+	0xa7, 0x60, 0x64, 0x99, 0xc4, 0xcc, 0x8a, 0xd8, 0xfb, 0xb7, 0x8a, 0x89, 0x37, 0xce, 0x3b, 0x4f,
+	0x46, 0xd5, 0xfb, 0x87, 0x68, 0xeb, 0x29, 0x2c, 0x8d, 0x2a, 0xef, 0xd4, 0xef, 0x7f, 0x0e, 0x6b,
+	0xa3, 0xee, 0x54, 0x78, 0x4e, 0x60, 0x85, 0x2a, 0x79, 0x22, 0x1f, 0xf0, 0x59, 0x8c, 0x36, 0x27,
+	0x78, 0x26, 0xe2, 0xdd, 0xee, 0x2c, 0xd3, 0x91, 0x39, 0xb3, 0xb1, 0x60, 0x56, 0x10, 0xa1, 0xc7,
+	0xb0, 0x4c, 0x68, 0xd8, 0x09, 0x23, 0xaf, 0xe7, 0xca, 0x30, 0x28, 0x96, 0x8b, 0x99, 0xf8, 0x4c,
+	0x5c, 0x9c, 0xeb, 0x50, 0x55, 0x6a, 0x7d, 0xec, 0x3e, 0x95, 0x1f, 0x89, 0xa5, 0xc2, 0x47, 0xe2,
+	0x93, 0x7f, 0x57, 0xa1, 0xf6, 0x91, 0xa4, 0x84, 0x3e, 0x83, 0x7a, 0xfe, 0xdd, 0xbe, 0x31, 0x11,
+	0x8b, 0x0f, 0xf8, 0x3f, 0x0d, 0xd6, 0x70, 0x03, 0xa3, 0x1f, 0xfa, 0x76, 0xf3, 0xd7, 0x7f, 0xf9,
+	0xe7, 0x6f, 0x75, 0x0b, 0x99, 0xe2, 0x4f, 0x81, 0x9b, 0x83, 0xfc, 0xaf, 0x10, 0x92, 0x2d, 0x19,
+	0x02, 0x0c, 0x1f, 0x3a, 0xc8, 0x1a, 0xcb, 0xfe, 0xc2, 0x43, 0xc9, 0x7a, 0x34, 0x55, 0x27, 0x0f,
+	0xd7, 0xb6, 0x85, 0xa3, 0x2d, 0x7b, 0x73, 0xdc, 0x11, 0x3f, 0x66, 0x9c, 0xb0, 0x63, 0x6d, 0x0f,
+	0x7d, 0x06, 0x35, 0x89, 0x64, 0x68, 0x56, 0x95, 0x59, 0xe6, 0xa4, 0x42, 0x79, 0xd8, 0x11, 0x1e,
+	0x1e, 0xda, 0x6b, 0xd3, 0x3c, 0xf0, 0xe5, 0x7f, 0xa3, 0xc1, 0xc6, 0xf4, 0x3e, 0x89, 0x1e, 0x17,
+	0x57, 0x9d, 0x7d, 0x8d, 0x5b, 0xdf, 0x9c, 0x6b, 0xa7, 0xc8, 0x3c, 0x16, 0x64, 0x9a, 0xf6, 0xa3,
+	0x71, 0x32, 0x85, 0xee, 0xcb, 0x39, 0x5d, 0xc2, 0xf2, 0x58, 0x33, 0x42, 0x3b, 0xb9, 0x8f, 0xe9,
+	0x6d, 0xca, 0x9a, 0xd2, 0x31, 0xb2, 0x38, 0x1e, 0x6b, 0x7b, 0xf6, 0xfa, 0xb8, 0x4b, 0xd1, 0x6d,
+	0xd0, 0x05, 0x2c, 0x8e, 0x74, 0xa5, 0x99, 0xb9, 0xd2, 0x28, 0xee, 0x71, 0xb2, 0x8b, 0xd9, 0xdb,
+	0xc2, 0xd5, 0x26, 0x9a, 0xe1, 0x27, 0x82, 0xe5, 0xb1, 0x7e, 0x55, 0xd8, 0xd1, 0xf4, 0x4e, 0x66,
+	0xcd, 0xa0, 0x92, 0x25, 0xcd, 0x9e, 0x35, 0xd5, 0xd5, 0xfe, 0x2f, 0xc2, 0xe0, 0x97, 0x28, 0x86,
+	0xfb, 0xc5, 0x6a, 0x46, 0x5b, 0xb7, 0xf5, 0x14, 0x6b, 0x7b, 0x86, 0x56, 0xed, 0xed, 0xff, 0x85,
+	0xc3, 0x6d, 0x7b, 0xa2, 0x1c, 0xb2, 0x3a, 0x3f, 0xd6, 0xf6, 0x4e, 0x9a, 0x5f, 0xfd, 0xa3, 0x71,
+	0xef, 0x57, 0x6f, 0x1a, 0xda, 0x17, 0x6f, 0x1a, 0xda, 0x97, 0x6f, 0x1a, 0xda, 0xd7, 0x6f, 0x1a,
+	0xda, 0xe7, 0x6f, 0x1b, 0xf7, 0xbe, 0x7c, 0xdb, 0xb8, 0xf7, 0xd5, 0xdb, 0xc6, 0x3d, 0xbf, 0x2a,
+	0xf6, 0x71, 0xf8, 0x9f, 0x01, 0x00, 0xc6, 0x86, 0x3c, 0x61, 0x61, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LookoutClient is the client API for Lookout service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LookoutClient interface {
+	Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error)
+	GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error)
+	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
+	GetResourceConsumption(ctx context.Context, in *GetResourceConsumptionRequest, opts ...grpc.CallOption) (*GetResourceConsumptionResponse, error)
+	CreateSavedView(ctx context.Context, in *CreateSavedViewRequest, opts ...grpc.CallOption) (*SavedView, error)
+	GetSavedViews(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetSavedViewsResponse, error)
+	DeleteSavedView(ctx context.Context, in *DeleteSavedViewRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ResubmitJobs(ctx context.Context, in *ResubmitJobsRequest, opts ...grpc.CallOption) (*ResubmitJobsResponse, error)
+}
+
+type lookoutClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLookoutClient(cc *grpc.ClientConn) LookoutClient {
+	return &lookoutClient{cc}
+}
+
+func (c *lookoutClient) Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error) {
+	out := new(SystemOverview)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/Overview", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetJobSetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *lookoutClient) GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error) {
+	out := new(GetJobSetsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobSets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetJobSetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *lookoutClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
+	out := new(GetJobsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetJobSetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+func (c *lookoutClient) GetResourceConsumption(ctx context.Context, in *GetResourceConsumptionRequest, opts ...grpc.CallOption) (*GetResourceConsumptionResponse, error) {
+	out := new(GetResourceConsumptionResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetResourceConsumption", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetJobSetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *lookoutClient) CreateSavedView(ctx context.Context, in *CreateSavedViewRequest, opts ...grpc.CallOption) (*SavedView, error) {
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/CreateSavedView", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetJobSetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *lookoutClient) GetSavedViews(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetSavedViewsResponse, error) {
+	out := new(GetSavedViewsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetSavedViews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetJobSetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobSetInfos) > 0 {
-		for iNdEx := len(m.JobSetInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobSetInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLookout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *lookoutClient) DeleteSavedView(ctx context.Context, in *DeleteSavedViewRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/DeleteSavedView", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetJobsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *lookoutClient) ResubmitJobs(ctx context.Context, in *ResubmitJobsRequest, opts ...grpc.CallOption) (*ResubmitJobsResponse, error) {
+	out := new(ResubmitJobsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/ResubmitJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetJobsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LookoutServer is the server API for Lookout service.
+type LookoutServer interface {
+	Overview(context.Context, *types.Empty) (*SystemOverview, error)
+	GetJobSets(context.Context, *GetJobSetsRequest) (*GetJobSetsResponse, error)
+	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
+	GetResourceConsumption(context.Context, *GetResourceConsumptionRequest) (*GetResourceConsumptionResponse, error)
+	CreateSavedView(context.Context, *CreateSavedViewRequest) (*SavedView, error)
+	GetSavedViews(context.Context, *types.Empty) (*GetSavedViewsResponse, error)
+	DeleteSavedView(context.Context, *DeleteSavedViewRequest) (*types.Empty, error)
+	ResubmitJobs(context.Context, *ResubmitJobsRequest) (*ResubmitJobsResponse, error)
 }
 
-func (m *GetJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserAnnotations) > 0 {
-		for k := range m.UserAnnotations {
-			v := m.UserAnnotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintLookout(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
+// UnimplementedLookoutServer can be embedded to have forward compatible implementations.
+type UnimplementedLookoutServer struct {
+}
+
+func (*UnimplementedLookoutServer) Overview(ctx context.Context, req *types.Empty) (*SystemOverview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Overview not implemented")
+}
+func (*UnimplementedLookoutServer) GetJobSets(ctx context.Context, req *GetJobSetsRequest) (*GetJobSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSets not implemented")
+}
+func (*UnimplementedLookoutServer) GetJobs(ctx context.Context, req *GetJobsRequest) (*GetJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (*UnimplementedLookoutServer) GetResourceConsumption(ctx context.Context, req *GetResourceConsumptionRequest) (*GetResourceConsumptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceConsumption not implemented")
+}
+func (*UnimplementedLookoutServer) CreateSavedView(ctx context.Context, req *CreateSavedViewRequest) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedView not implemented")
+}
+func (*UnimplementedLookoutServer) GetSavedViews(ctx context.Context, req *types.Empty) (*GetSavedViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedViews not implemented")
+}
+func (*UnimplementedLookoutServer) DeleteSavedView(ctx context.Context, req *DeleteSavedViewRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedView not implemented")
+}
+func (*UnimplementedLookoutServer) ResubmitJobs(ctx context.Context, req *ResubmitJobsRequest) (*ResubmitJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitJobs not implemented")
+}
+
+func RegisterLookoutServer(s *grpc.Server, srv LookoutServer) {
+	s.RegisterService(&_Lookout_serviceDesc, srv)
+}
+
+func _Lookout_Overview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x42
+	if interceptor == nil {
+		return srv.(LookoutServer).Overview(ctx, in)
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0x3a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/Overview",
 	}
-	if m.Skip != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.Skip))
-		i--
-		dAtA[i] = 0x30
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).Overview(ctx, req.(*types.Empty))
 	}
-	if m.Take != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.Take))
-		i--
-		dAtA[i] = 0x28
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lookout_GetJobSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobSetIds) > 0 {
-		for iNdEx := len(m.JobSetIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobSetIds[iNdEx])
-			copy(dAtA[i:], m.JobSetIds[iNdEx])
-			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSetIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if interceptor == nil {
+		return srv.(LookoutServer).GetJobSets(ctx, in)
 	}
-	if len(m.JobStates) > 0 {
-		for iNdEx := len(m.JobStates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobStates[iNdEx])
-			copy(dAtA[i:], m.JobStates[iNdEx])
-			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobStates[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetJobSets",
 	}
-	if m.NewestFirst {
-		i--
-		if m.NewestFirst {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetJobSets(ctx, req.(*GetJobSetsRequest))
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lookout_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(LookoutServer).GetJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetJobs(ctx, req.(*GetJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetJobsResponse) Marshal() (dAtA []byte, err error) {
+func _Lookout_GetResourceConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceConsumptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookoutServer).GetResourceConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetResourceConsumption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetResourceConsumption(ctx, req.(*GetResourceConsumptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lookout_CreateSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookoutServer).CreateSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/CreateSavedView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).CreateSavedView(ctx, req.(*CreateSavedViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lookout_GetSavedViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookoutServer).GetSavedViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetSavedViews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetSavedViews(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lookout_DeleteSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookoutServer).DeleteSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/DeleteSavedView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).DeleteSavedView(ctx, req.(*DeleteSavedViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lookout_ResubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookoutServer).ResubmitJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/ResubmitJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).ResubmitJobs(ctx, req.(*ResubmitJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Lookout_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lookout.Lookout",
+	HandlerType: (*LookoutServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Overview",
+			Handler:    _Lookout_Overview_Handler,
+		},
+		{
+			MethodName: "GetJobSets",
+			Handler:    _Lookout_GetJobSets_Handler,
+		},
+		{
+			MethodName: "GetJobs",
+			Handler:    _Lookout_GetJobs_Handler,
+		},
+		{
+			MethodName: "GetResourceConsumption",
+			Handler:    _Lookout_GetResourceConsumption_Handler,
+		},
+		{
+			MethodName: "CreateSavedView",
+			Handler:    _Lookout_CreateSavedView_Handler,
+		},
+		{
+			MethodName: "GetSavedViews",
+			Handler:    _Lookout_GetSavedViews_Handler,
+		},
+		{
+			MethodName: "DeleteSavedView",
+			Handler:    _Lookout_DeleteSavedView_Handler,
+		},
+		{
+			MethodName: "ResubmitJobs",
+			Handler:    _Lookout_ResubmitJobs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/lookout/lookout.proto",
+}
+
+func (m *SystemOverview) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1728,20 +1922,20 @@ func (m *GetJobsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SystemOverview) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SystemOverview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.JobInfos) > 0 {
-		for iNdEx := len(m.JobInfos) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.JobInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1755,504 +1949,3317 @@ func (m *GetJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLookout(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLookout(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SystemOverview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Queues) > 0 {
-		for _, e := range m.Queues {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
-		}
-	}
-	return n
+
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if len(m.Runs) > 0 {
-		for _, e := range m.Runs {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
-		}
+	if len(m.JobJson) > 0 {
+		i -= len(m.JobJson)
+		copy(dAtA[i:], m.JobJson)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobJson)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Cancelled != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled)
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.JobState) > 0 {
+		i -= len(m.JobState)
+		copy(dAtA[i:], m.JobState)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobState)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.JobState)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.Cancelled != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Cancelled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintLookout(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.JobJson)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.Runs) > 0 {
+		for iNdEx := len(m.Runs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RunInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RunInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RunInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.K8SId)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.UnableToSchedule {
+		i--
+		if m.UnableToSchedule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.RunState) > 0 {
+		i -= len(m.RunState)
+		copy(dAtA[i:], m.RunState)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.RunState)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Succeeded {
-		n += 2
+	if m.Finished != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Finished, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintLookout(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.Started != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Started, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintLookout(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if m.Created != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created)
-		n += 1 + l + sovLookout(uint64(l))
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintLookout(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Started != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started)
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Finished != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished)
-		n += 1 + l + sovLookout(uint64(l))
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovLookout(uint64(m.PodNumber))
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.RunState)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UnableToSchedule {
-		n += 2
+	if len(m.K8SId) > 0 {
+		i -= len(m.K8SId)
+		copy(dAtA[i:], m.K8SId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.K8SId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueueInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.JobsQueued != 0 {
-		n += 1 + sovLookout(uint64(m.JobsQueued))
+	if m.LongestRunningDuration != nil {
+		{
+			size, err := m.LongestRunningDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.JobsPending != 0 {
-		n += 1 + sovLookout(uint64(m.JobsPending))
+	if m.OldestQueuedDuration != nil {
+		{
+			size, err := m.OldestQueuedDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.JobsRunning != 0 {
-		n += 1 + sovLookout(uint64(m.JobsRunning))
+	if m.LongestRunningJob != nil {
+		{
+			size, err := m.LongestRunningJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.OldestQueuedJob != nil {
-		l = m.OldestQueuedJob.Size()
-		n += 1 + l + sovLookout(uint64(l))
+		{
+			size, err := m.OldestQueuedJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.LongestRunningJob != nil {
-		l = m.LongestRunningJob.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	if m.JobsRunning != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRunning))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.OldestQueuedDuration != nil {
-		l = m.OldestQueuedDuration.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	if m.JobsPending != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsPending))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.LongestRunningDuration != nil {
-		l = m.LongestRunningDuration.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	if m.JobsQueued != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsQueued))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.QueuedStats != nil {
+		{
+			size, err := m.QueuedStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.JobSet)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.RunningStats != nil {
+		{
+			size, err := m.RunningStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.JobsQueued != 0 {
-		n += 1 + sovLookout(uint64(m.JobsQueued))
+	if m.JobsFailed != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsFailed))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.JobsPending != 0 {
-		n += 1 + sovLookout(uint64(m.JobsPending))
+	if m.JobsSucceeded != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsSucceeded))
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.JobsRunning != 0 {
-		n += 1 + sovLookout(uint64(m.JobsRunning))
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRunning))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.JobsSucceeded != 0 {
-		n += 1 + sovLookout(uint64(m.JobsSucceeded))
+	if m.JobsPending != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsPending))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.JobsFailed != 0 {
-		n += 1 + sovLookout(uint64(m.JobsFailed))
+	if m.JobsQueued != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsQueued))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.RunningStats != nil {
-		l = m.RunningStats.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.JobSet) > 0 {
+		i -= len(m.JobSet)
+		copy(dAtA[i:], m.JobSet)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSet)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.QueuedStats != nil {
-		l = m.QueuedStats.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DurationStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Shortest != nil {
-		l = m.Shortest.Size()
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Longest != nil {
-		l = m.Longest.Size()
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Average != nil {
-		l = m.Average.Size()
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Median != nil {
-		l = m.Median.Size()
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Q1 != nil {
-		l = m.Q1.Size()
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Q3 != nil {
-		l = m.Q3.Size()
-		n += 1 + l + sovLookout(uint64(l))
+func (m *DurationStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetJobSetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	return n
+func (m *DurationStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetJobSetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DurationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.JobSetInfos) > 0 {
-		for _, e := range m.JobSetInfos {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
+	if m.Q3 != nil {
+		{
+			size, err := m.Q3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *GetJobsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.NewestFirst {
-		n += 2
-	}
-	if len(m.JobStates) > 0 {
-		for _, s := range m.JobStates {
-			l = len(s)
-			n += 1 + l + sovLookout(uint64(l))
+	if m.Q1 != nil {
+		{
+			size, err := m.Q1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.JobSetIds) > 0 {
-		for _, s := range m.JobSetIds {
-			l = len(s)
-			n += 1 + l + sovLookout(uint64(l))
+	if m.Median != nil {
+		{
+			size, err := m.Median.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Take != 0 {
-		n += 1 + sovLookout(uint64(m.Take))
-	}
-	if m.Skip != 0 {
-		n += 1 + sovLookout(uint64(m.Skip))
+	if m.Average != nil {
+		{
+			size, err := m.Average.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.Longest != nil {
+		{
+			size, err := m.Longest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.UserAnnotations) > 0 {
-		for k, v := range m.UserAnnotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + len(v) + sovLookout(uint64(len(v)))
-			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
+	if m.Shortest != nil {
+		{
+			size, err := m.Shortest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetJobsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetJobSetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobSetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobSetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.JobInfos) > 0 {
-		for _, e := range m.JobInfos {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
-		}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovLookout(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *GetJobSetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozLookout(x uint64) (n int) {
-	return sovLookout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *GetJobSetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *SystemOverview) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForQueues := "[]*QueueInfo{"
-	for _, f := range this.Queues {
-		repeatedStringForQueues += strings.Replace(f.String(), "QueueInfo", "QueueInfo", 1) + ","
+
+func (m *GetJobSetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobSetInfos) > 0 {
+		for iNdEx := len(m.JobSetInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobSetInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	repeatedStringForQueues += "}"
-	s := strings.Join([]string{`&SystemOverview{`,
-		`Queues:` + repeatedStringForQueues + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobInfo) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForRuns := "[]*RunInfo{"
-	for _, f := range this.Runs {
-		repeatedStringForRuns += strings.Replace(f.String(), "RunInfo", "RunInfo", 1) + ","
+
+func (m *GetJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForRuns += "}"
-	s := strings.Join([]string{`&JobInfo{`,
-		`Job:` + strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "api.Job", 1) + `,`,
-		`Runs:` + repeatedStringForRuns + `,`,
-		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "Timestamp", "types.Timestamp", 1) + `,`,
-		`JobState:` + fmt.Sprintf("%v", this.JobState) + `,`,
-		`JobJson:` + fmt.Sprintf("%v", this.JobJson) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *RunInfo) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RunInfo{`,
-		`K8SId:` + fmt.Sprintf("%v", this.K8SId) + `,`,
-		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
-		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
-		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`Created:` + strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Started:` + strings.Replace(fmt.Sprintf("%v", this.Started), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Finished:` + strings.Replace(fmt.Sprintf("%v", this.Finished), "Timestamp", "types.Timestamp", 1) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`RunState:` + fmt.Sprintf("%v", this.RunState) + `,`,
-		`UnableToSchedule:` + fmt.Sprintf("%v", this.UnableToSchedule) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *GetJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *QueueInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserAnnotations) > 0 {
+		for k := range m.UserAnnotations {
+			v := m.UserAnnotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLookout(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	s := strings.Join([]string{`&QueueInfo{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobsQueued:` + fmt.Sprintf("%v", this.JobsQueued) + `,`,
-		`JobsPending:` + fmt.Sprintf("%v", this.JobsPending) + `,`,
-		`JobsRunning:` + fmt.Sprintf("%v", this.JobsRunning) + `,`,
-		`OldestQueuedJob:` + strings.Replace(this.OldestQueuedJob.String(), "JobInfo", "JobInfo", 1) + `,`,
-		`LongestRunningJob:` + strings.Replace(this.LongestRunningJob.String(), "JobInfo", "JobInfo", 1) + `,`,
-		`OldestQueuedDuration:` + strings.Replace(fmt.Sprintf("%v", this.OldestQueuedDuration), "Duration", "types.Duration", 1) + `,`,
-		`LongestRunningDuration:` + strings.Replace(fmt.Sprintf("%v", this.LongestRunningDuration), "Duration", "types.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobSetInfo) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x42
 	}
-	s := strings.Join([]string{`&JobSetInfo{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobSet:` + fmt.Sprintf("%v", this.JobSet) + `,`,
-		`JobsQueued:` + fmt.Sprintf("%v", this.JobsQueued) + `,`,
-		`JobsPending:` + fmt.Sprintf("%v", this.JobsPending) + `,`,
-		`JobsRunning:` + fmt.Sprintf("%v", this.JobsRunning) + `,`,
-		`JobsSucceeded:` + fmt.Sprintf("%v", this.JobsSucceeded) + `,`,
-		`JobsFailed:` + fmt.Sprintf("%v", this.JobsFailed) + `,`,
-		`RunningStats:` + strings.Replace(this.RunningStats.String(), "DurationStats", "DurationStats", 1) + `,`,
-		`QueuedStats:` + strings.Replace(this.QueuedStats.String(), "DurationStats", "DurationStats", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DurationStats) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	s := strings.Join([]string{`&DurationStats{`,
-		`Shortest:` + strings.Replace(fmt.Sprintf("%v", this.Shortest), "Duration", "types.Duration", 1) + `,`,
-		`Longest:` + strings.Replace(fmt.Sprintf("%v", this.Longest), "Duration", "types.Duration", 1) + `,`,
-		`Average:` + strings.Replace(fmt.Sprintf("%v", this.Average), "Duration", "types.Duration", 1) + `,`,
-		`Median:` + strings.Replace(fmt.Sprintf("%v", this.Median), "Duration", "types.Duration", 1) + `,`,
-		`Q1:` + strings.Replace(fmt.Sprintf("%v", this.Q1), "Duration", "types.Duration", 1) + `,`,
-		`Q3:` + strings.Replace(fmt.Sprintf("%v", this.Q3), "Duration", "types.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetJobSetsRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Skip != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Skip))
+		i--
+		dAtA[i] = 0x30
 	}
-	s := strings.Join([]string{`&GetJobSetsRequest{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Take != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Take))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.JobSetIds) > 0 {
+		for iNdEx := len(m.JobSetIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobSetIds[iNdEx])
+			copy(dAtA[i:], m.JobSetIds[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSetIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.JobStates) > 0 {
+		for iNdEx := len(m.JobStates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobStates[iNdEx])
+			copy(dAtA[i:], m.JobStates[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobStates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NewestFirst {
+		i--
+		if m.NewestFirst {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *GetJobSetsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForJobSetInfos := "[]*JobSetInfo{"
-	for _, f := range this.JobSetInfos {
-		repeatedStringForJobSetInfos += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *GetJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobInfos) > 0 {
+		for iNdEx := len(m.JobInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	repeatedStringForJobSetInfos += "}"
-	s := strings.Join([]string{`&GetJobSetsResponse{`,
-		`JobSetInfos:` + repeatedStringForJobSetInfos + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *GetJobsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetResourceConsumptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	keysForUserAnnotations := make([]string, 0, len(this.UserAnnotations))
-	for k, _ := range this.UserAnnotations {
-		keysForUserAnnotations = append(keysForUserAnnotations, k)
+	return dAtA[:n], nil
+}
+
+func (m *GetResourceConsumptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResourceConsumptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.To):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintLookout(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x2a
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForUserAnnotations)
-	mapStringForUserAnnotations := "map[string]string{"
-	for _, k := range keysForUserAnnotations {
-		mapStringForUserAnnotations += fmt.Sprintf("%v: %v,", k, this.UserAnnotations[k])
+	if m.From != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.From):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintLookout(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x22
 	}
-	mapStringForUserAnnotations += "}"
-	s := strings.Join([]string{`&GetJobsRequest{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`NewestFirst:` + fmt.Sprintf("%v", this.NewestFirst) + `,`,
-		`JobStates:` + fmt.Sprintf("%v", this.JobStates) + `,`,
-		`JobSetIds:` + fmt.Sprintf("%v", this.JobSetIds) + `,`,
-		`Take:` + fmt.Sprintf("%v", this.Take) + `,`,
-		`Skip:` + fmt.Sprintf("%v", this.Skip) + `,`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
-		`UserAnnotations:` + mapStringForUserAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetIds) > 0 {
+		for iNdEx := len(m.JobSetIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobSetIds[iNdEx])
+			copy(dAtA[i:], m.JobSetIds[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSetIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *GetJobsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceConsumption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForJobInfos := "[]*JobInfo{"
-	for _, f := range this.JobInfos {
-		repeatedStringForJobInfos += strings.Replace(f.String(), "JobInfo", "JobInfo", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *ResourceConsumption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GpuDutyCycleHoursUsed != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GpuDutyCycleHoursUsed))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.MemoryGibHoursUsed != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MemoryGibHoursUsed))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.CpuHoursUsed != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CpuHoursUsed))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.GpuHoursRequested != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GpuHoursRequested))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.MemoryGibHoursRequested != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MemoryGibHoursRequested))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.CpuHoursRequested != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CpuHoursRequested))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Runs != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSet) > 0 {
+		i -= len(m.JobSet)
+		copy(dAtA[i:], m.JobSet)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSet)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetResourceConsumptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResourceConsumptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResourceConsumptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceConsumption) > 0 {
+		for iNdEx := len(m.ResourceConsumption) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceConsumption[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SavedView) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SavedView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SavedView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Created != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintLookout(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateSavedViewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSavedViewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSavedViewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSavedViewsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSavedViewsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSavedViewsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SavedViews) > 0 {
+		for iNdEx := len(m.SavedViews) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SavedViews[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSavedViewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSavedViewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSavedViewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResubmitJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResubmitJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResubmitJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for k := range m.Resources {
+			v := m.Resources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLookout(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Priority != nil {
+		{
+			size, err := m.Priority.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResubmitJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResubmitJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResubmitJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResubmittedJobs) > 0 {
+		for iNdEx := len(m.ResubmittedJobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResubmittedJobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResubmittedJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResubmittedJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResubmittedJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalJobId) > 0 {
+		i -= len(m.OriginalJobId)
+		copy(dAtA[i:], m.OriginalJobId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.OriginalJobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLookout(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLookout(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SystemOverview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Runs) > 0 {
+		for _, e := range m.Runs {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if m.Cancelled != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobState)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobJson)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *RunInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.K8SId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Succeeded {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Started != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Finished != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovLookout(uint64(m.PodNumber))
+	}
+	l = len(m.RunState)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.UnableToSchedule {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueueInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.JobsQueued != 0 {
+		n += 1 + sovLookout(uint64(m.JobsQueued))
+	}
+	if m.JobsPending != 0 {
+		n += 1 + sovLookout(uint64(m.JobsPending))
+	}
+	if m.JobsRunning != 0 {
+		n += 1 + sovLookout(uint64(m.JobsRunning))
+	}
+	if m.OldestQueuedJob != nil {
+		l = m.OldestQueuedJob.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.LongestRunningJob != nil {
+		l = m.LongestRunningJob.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.OldestQueuedDuration != nil {
+		l = m.OldestQueuedDuration.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.LongestRunningDuration != nil {
+		l = m.LongestRunningDuration.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobSet)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.JobsQueued != 0 {
+		n += 1 + sovLookout(uint64(m.JobsQueued))
+	}
+	if m.JobsPending != 0 {
+		n += 1 + sovLookout(uint64(m.JobsPending))
+	}
+	if m.JobsRunning != 0 {
+		n += 1 + sovLookout(uint64(m.JobsRunning))
+	}
+	if m.JobsSucceeded != 0 {
+		n += 1 + sovLookout(uint64(m.JobsSucceeded))
+	}
+	if m.JobsFailed != 0 {
+		n += 1 + sovLookout(uint64(m.JobsFailed))
+	}
+	if m.RunningStats != nil {
+		l = m.RunningStats.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.QueuedStats != nil {
+		l = m.QueuedStats.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *DurationStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shortest != nil {
+		l = m.Shortest.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Longest != nil {
+		l = m.Longest.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Average != nil {
+		l = m.Average.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Median != nil {
+		l = m.Median.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Q1 != nil {
+		l = m.Q1.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Q3 != nil {
+		l = m.Q3.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetJobSetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetJobSetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobSetInfos) > 0 {
+		for _, e := range m.JobSetInfos {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.NewestFirst {
+		n += 2
+	}
+	if len(m.JobStates) > 0 {
+		for _, s := range m.JobStates {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if len(m.JobSetIds) > 0 {
+		for _, s := range m.JobSetIds {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if m.Take != 0 {
+		n += 1 + sovLookout(uint64(m.Take))
+	}
+	if m.Skip != 0 {
+		n += 1 + sovLookout(uint64(m.Skip))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.UserAnnotations) > 0 {
+		for k, v := range m.UserAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + len(v) + sovLookout(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobInfos) > 0 {
+		for _, e := range m.JobInfos {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetResourceConsumptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.JobSetIds) > 0 {
+		for _, s := range m.JobSetIds {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.From != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.From)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.To != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.To)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourceConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobSet)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovLookout(uint64(m.Runs))
+	}
+	if m.CpuHoursRequested != 0 {
+		n += 9
+	}
+	if m.MemoryGibHoursRequested != 0 {
+		n += 9
+	}
+	if m.GpuHoursRequested != 0 {
+		n += 9
+	}
+	if m.CpuHoursUsed != 0 {
+		n += 9
+	}
+	if m.MemoryGibHoursUsed != 0 {
+		n += 9
+	}
+	if m.GpuDutyCycleHoursUsed != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GetResourceConsumptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ResourceConsumption) > 0 {
+		for _, e := range m.ResourceConsumption {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SavedView) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateSavedViewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSavedViewsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SavedViews) > 0 {
+		for _, e := range m.SavedViews {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteSavedViewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *ResubmitJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Priority != nil {
+		l = m.Priority.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for k, v := range m.Resources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + len(v) + sovLookout(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ResubmitJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ResubmittedJobs) > 0 {
+		for _, e := range m.ResubmittedJobs {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResubmittedJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalJobId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func sovLookout(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLookout(x uint64) (n int) {
+	return sovLookout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SystemOverview) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*QueueInfo{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(f.String(), "QueueInfo", "QueueInfo", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&SystemOverview{`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRuns := "[]*RunInfo{"
+	for _, f := range this.Runs {
+		repeatedStringForRuns += strings.Replace(f.String(), "RunInfo", "RunInfo", 1) + ","
+	}
+	repeatedStringForRuns += "}"
+	s := strings.Join([]string{`&JobInfo{`,
+		`Job:` + strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "api.Job", 1) + `,`,
+		`Runs:` + repeatedStringForRuns + `,`,
+		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "Timestamp", "types.Timestamp", 1) + `,`,
+		`JobState:` + fmt.Sprintf("%v", this.JobState) + `,`,
+		`JobJson:` + fmt.Sprintf("%v", this.JobJson) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RunInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RunInfo{`,
+		`K8SId:` + fmt.Sprintf("%v", this.K8SId) + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Created:` + strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Started:` + strings.Replace(fmt.Sprintf("%v", this.Started), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Finished:` + strings.Replace(fmt.Sprintf("%v", this.Finished), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`RunState:` + fmt.Sprintf("%v", this.RunState) + `,`,
+		`UnableToSchedule:` + fmt.Sprintf("%v", this.UnableToSchedule) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueInfo{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobsQueued:` + fmt.Sprintf("%v", this.JobsQueued) + `,`,
+		`JobsPending:` + fmt.Sprintf("%v", this.JobsPending) + `,`,
+		`JobsRunning:` + fmt.Sprintf("%v", this.JobsRunning) + `,`,
+		`OldestQueuedJob:` + strings.Replace(this.OldestQueuedJob.String(), "JobInfo", "JobInfo", 1) + `,`,
+		`LongestRunningJob:` + strings.Replace(this.LongestRunningJob.String(), "JobInfo", "JobInfo", 1) + `,`,
+		`OldestQueuedDuration:` + strings.Replace(fmt.Sprintf("%v", this.OldestQueuedDuration), "Duration", "types.Duration", 1) + `,`,
+		`LongestRunningDuration:` + strings.Replace(fmt.Sprintf("%v", this.LongestRunningDuration), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetInfo{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSet:` + fmt.Sprintf("%v", this.JobSet) + `,`,
+		`JobsQueued:` + fmt.Sprintf("%v", this.JobsQueued) + `,`,
+		`JobsPending:` + fmt.Sprintf("%v", this.JobsPending) + `,`,
+		`JobsRunning:` + fmt.Sprintf("%v", this.JobsRunning) + `,`,
+		`JobsSucceeded:` + fmt.Sprintf("%v", this.JobsSucceeded) + `,`,
+		`JobsFailed:` + fmt.Sprintf("%v", this.JobsFailed) + `,`,
+		`RunningStats:` + strings.Replace(this.RunningStats.String(), "DurationStats", "DurationStats", 1) + `,`,
+		`QueuedStats:` + strings.Replace(this.QueuedStats.String(), "DurationStats", "DurationStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DurationStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DurationStats{`,
+		`Shortest:` + strings.Replace(fmt.Sprintf("%v", this.Shortest), "Duration", "types.Duration", 1) + `,`,
+		`Longest:` + strings.Replace(fmt.Sprintf("%v", this.Longest), "Duration", "types.Duration", 1) + `,`,
+		`Average:` + strings.Replace(fmt.Sprintf("%v", this.Average), "Duration", "types.Duration", 1) + `,`,
+		`Median:` + strings.Replace(fmt.Sprintf("%v", this.Median), "Duration", "types.Duration", 1) + `,`,
+		`Q1:` + strings.Replace(fmt.Sprintf("%v", this.Q1), "Duration", "types.Duration", 1) + `,`,
+		`Q3:` + strings.Replace(fmt.Sprintf("%v", this.Q3), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobSetsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetJobSetsRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobSetsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobSetInfos := "[]*JobSetInfo{"
+	for _, f := range this.JobSetInfos {
+		repeatedStringForJobSetInfos += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	}
+	repeatedStringForJobSetInfos += "}"
+	s := strings.Join([]string{`&GetJobSetsResponse{`,
+		`JobSetInfos:` + repeatedStringForJobSetInfos + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForUserAnnotations := make([]string, 0, len(this.UserAnnotations))
+	for k, _ := range this.UserAnnotations {
+		keysForUserAnnotations = append(keysForUserAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUserAnnotations)
+	mapStringForUserAnnotations := "map[string]string{"
+	for _, k := range keysForUserAnnotations {
+		mapStringForUserAnnotations += fmt.Sprintf("%v: %v,", k, this.UserAnnotations[k])
+	}
+	mapStringForUserAnnotations += "}"
+	s := strings.Join([]string{`&GetJobsRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`NewestFirst:` + fmt.Sprintf("%v", this.NewestFirst) + `,`,
+		`JobStates:` + fmt.Sprintf("%v", this.JobStates) + `,`,
+		`JobSetIds:` + fmt.Sprintf("%v", this.JobSetIds) + `,`,
+		`Take:` + fmt.Sprintf("%v", this.Take) + `,`,
+		`Skip:` + fmt.Sprintf("%v", this.Skip) + `,`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`UserAnnotations:` + mapStringForUserAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobInfos := "[]*JobInfo{"
+	for _, f := range this.JobInfos {
+		repeatedStringForJobInfos += strings.Replace(f.String(), "JobInfo", "JobInfo", 1) + ","
+	}
+	repeatedStringForJobInfos += "}"
+	s := strings.Join([]string{`&GetJobsResponse{`,
+		`JobInfos:` + repeatedStringForJobInfos + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResourceConsumptionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetResourceConsumptionRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetIds:` + fmt.Sprintf("%v", this.JobSetIds) + `,`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`From:` + strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1) + `,`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceConsumption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceConsumption{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSet:` + fmt.Sprintf("%v", this.JobSet) + `,`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`Runs:` + fmt.Sprintf("%v", this.Runs) + `,`,
+		`CpuHoursRequested:` + fmt.Sprintf("%v", this.CpuHoursRequested) + `,`,
+		`MemoryGibHoursRequested:` + fmt.Sprintf("%v", this.MemoryGibHoursRequested) + `,`,
+		`GpuHoursRequested:` + fmt.Sprintf("%v", this.GpuHoursRequested) + `,`,
+		`CpuHoursUsed:` + fmt.Sprintf("%v", this.CpuHoursUsed) + `,`,
+		`MemoryGibHoursUsed:` + fmt.Sprintf("%v", this.MemoryGibHoursUsed) + `,`,
+		`GpuDutyCycleHoursUsed:` + fmt.Sprintf("%v", this.GpuDutyCycleHoursUsed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResourceConsumptionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResourceConsumption := "[]*ResourceConsumption{"
+	for _, f := range this.ResourceConsumption {
+		repeatedStringForResourceConsumption += strings.Replace(f.String(), "ResourceConsumption", "ResourceConsumption", 1) + ","
+	}
+	repeatedStringForResourceConsumption += "}"
+	s := strings.Join([]string{`&GetResourceConsumptionResponse{`,
+		`ResourceConsumption:` + repeatedStringForResourceConsumption + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SavedView) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SavedView{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`Request:` + strings.Replace(this.Request.String(), "GetJobsRequest", "GetJobsRequest", 1) + `,`,
+		`Created:` + strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateSavedViewRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateSavedViewRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Request:` + strings.Replace(this.Request.String(), "GetJobsRequest", "GetJobsRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSavedViewsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSavedViews := "[]*SavedView{"
+	for _, f := range this.SavedViews {
+		repeatedStringForSavedViews += strings.Replace(f.String(), "SavedView", "SavedView", 1) + ","
+	}
+	repeatedStringForSavedViews += "}"
+	s := strings.Join([]string{`&GetSavedViewsResponse{`,
+		`SavedViews:` + repeatedStringForSavedViews + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteSavedViewRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteSavedViewRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResubmitJobsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForResources := make([]string, 0, len(this.Resources))
+	for k, _ := range this.Resources {
+		keysForResources = append(keysForResources, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResources)
+	mapStringForResources := "map[string]string{"
+	for _, k := range keysForResources {
+		mapStringForResources += fmt.Sprintf("%v: %v,", k, this.Resources[k])
+	}
+	mapStringForResources += "}"
+	s := strings.Join([]string{`&ResubmitJobsRequest{`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Priority:` + strings.Replace(fmt.Sprintf("%v", this.Priority), "DoubleValue", "types.DoubleValue", 1) + `,`,
+		`Resources:` + mapStringForResources + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResubmitJobsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResubmittedJobs := "[]*ResubmittedJob{"
+	for _, f := range this.ResubmittedJobs {
+		repeatedStringForResubmittedJobs += strings.Replace(f.String(), "ResubmittedJob", "ResubmittedJob", 1) + ","
+	}
+	repeatedStringForResubmittedJobs += "}"
+	s := strings.Join([]string{`&ResubmitJobsResponse{`,
+		`ResubmittedJobs:` + repeatedStringForResubmittedJobs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResubmittedJob) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResubmittedJob{`,
+		`OriginalJobId:` + fmt.Sprintf("%v", this.OriginalJobId) + `,`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLookout(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SystemOverview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemOverview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemOverview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &QueueInfo{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &api.Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runs = append(m.Runs, &RunInfo{})
+			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cancelled == nil {
+				m.Cancelled = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Cancelled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.K8SId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Started, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Finished, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnableToSchedule = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
+			}
+			m.JobsQueued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsQueued |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
+			}
+			m.JobsPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsPending |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
+			}
+			m.JobsRunning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsRunning |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestQueuedJob == nil {
+				m.OldestQueuedJob = &JobInfo{}
+			}
+			if err := m.OldestQueuedJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongestRunningJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LongestRunningJob == nil {
+				m.LongestRunningJob = &JobInfo{}
+			}
+			if err := m.LongestRunningJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestQueuedDuration == nil {
+				m.OldestQueuedDuration = &types.Duration{}
+			}
+			if err := m.OldestQueuedDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongestRunningDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LongestRunningDuration == nil {
+				m.LongestRunningDuration = &types.Duration{}
+			}
+			if err := m.LongestRunningDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
+			}
+			m.JobsQueued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsQueued |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
+			}
+			m.JobsPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsPending |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
+			}
+			m.JobsRunning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsRunning |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsSucceeded", wireType)
+			}
+			m.JobsSucceeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsSucceeded |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsFailed", wireType)
+			}
+			m.JobsFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsFailed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunningStats == nil {
+				m.RunningStats = &DurationStats{}
+			}
+			if err := m.RunningStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedStats == nil {
+				m.QueuedStats = &DurationStats{}
+			}
+			if err := m.QueuedStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForJobInfos += "}"
-	s := strings.Join([]string{`&GetJobsResponse{`,
-		`JobInfos:` + repeatedStringForJobInfos + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringLookout(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *SystemOverview) Unmarshal(dAtA []byte) error {
+func (m *DurationStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2275,15 +5282,15 @@ func (m *SystemOverview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SystemOverview: wiretype end group for non-group")
+			return fmt.Errorf("proto: DurationStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SystemOverview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DurationStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2310,64 +5317,88 @@ func (m *SystemOverview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queues = append(m.Queues, &QueueInfo{})
-			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Shortest == nil {
+				m.Shortest = &types.Duration{}
+			}
+			if err := m.Shortest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLookout(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longest", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLookout
+			if m.Longest == nil {
+				m.Longest = &types.Duration{}
+			}
+			if err := m.Longest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Average == nil {
+				m.Average = &types.Duration{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Average.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2394,16 +5425,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &api.Job{}
+			if m.Median == nil {
+				m.Median = &types.Duration{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Q1", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2430,14 +5461,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runs = append(m.Runs, &RunInfo{})
-			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Q1 == nil {
+				m.Q1 = &types.Duration{}
+			}
+			if err := m.Q1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Q3", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2464,16 +5497,66 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cancelled == nil {
-				m.Cancelled = new(time.Time)
+			if m.Q3 == nil {
+				m.Q3 = &types.Duration{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Cancelled, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Q3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobSetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobSetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2501,13 +5584,63 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobState = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobSetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobSetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2517,23 +5650,25 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobJson = string(dAtA[iNdEx:postIndex])
+			m.JobSetInfos = append(m.JobSetInfos, &JobSetInfo{})
+			if err := m.JobSetInfos[len(m.JobSetInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2556,7 +5691,7 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunInfo) Unmarshal(dAtA []byte) error {
+func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2579,15 +5714,67 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJobsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestFirst", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NewestFirst = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobStates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2615,11 +5802,11 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.K8SId = string(dAtA[iNdEx:postIndex])
+			m.JobStates = append(m.JobStates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2647,13 +5834,13 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cluster = string(dAtA[iNdEx:postIndex])
+			m.JobSetIds = append(m.JobSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Take", wireType)
 			}
-			var stringLen uint64
+			m.Take = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2663,29 +5850,16 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Take |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLookout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Node = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
 			}
-			var v int
+			m.Skip = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2695,15 +5869,14 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Skip |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Succeeded = bool(v != 0)
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2731,13 +5904,13 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2747,31 +5920,27 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAnnotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2798,73 +5967,159 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Started, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			if m.UserAnnotations == nil {
+				m.UserAnnotations = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLookout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLookout(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLookout
+			m.UserAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLookout
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Finished, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2874,44 +6129,26 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.JobInfos = append(m.JobInfos, &JobInfo{})
+			if err := m.JobInfos[len(m.JobInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UnableToSchedule = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLookout(dAtA[iNdEx:])
@@ -2933,7 +6170,7 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+func (m *GetResourceConsumptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2956,10 +6193,10 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetResourceConsumptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetResourceConsumptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2995,67 +6232,10 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
-			}
-			m.JobsQueued = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsQueued |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
-			}
-			m.JobsPending = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsPending |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
-			}
-			m.JobsRunning = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsRunning |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3065,33 +6245,29 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldestQueuedJob == nil {
-				m.OldestQueuedJob = &JobInfo{}
-			}
-			if err := m.OldestQueuedJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobSetIds = append(m.JobSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LongestRunningJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3101,31 +6277,27 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LongestRunningJob == nil {
-				m.LongestRunningJob = &JobInfo{}
-			}
-			if err := m.LongestRunningJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3152,16 +6324,16 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldestQueuedDuration == nil {
-				m.OldestQueuedDuration = &types.Duration{}
+			if m.From == nil {
+				m.From = new(time.Time)
 			}
-			if err := m.OldestQueuedDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.From, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LongestRunningDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3188,10 +6360,10 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LongestRunningDuration == nil {
-				m.LongestRunningDuration = &types.Duration{}
+			if m.To == nil {
+				m.To = new(time.Time)
 			}
-			if err := m.LongestRunningDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.To, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3216,7 +6388,7 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+func (m *ResourceConsumption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3239,10 +6411,10 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceConsumption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3310,105 +6482,10 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			m.JobSet = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
-			}
-			m.JobsQueued = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsQueued |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
-			}
-			m.JobsPending = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsPending |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
-			}
-			m.JobsRunning = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsRunning |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsSucceeded", wireType)
-			}
-			m.JobsSucceeded = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsSucceeded |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsFailed", wireType)
-			}
-			m.JobsFailed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsFailed |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunningStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3418,33 +6495,29 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunningStats == nil {
-				m.RunningStats = &DurationStats{}
-			}
-			if err := m.RunningStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStats", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
-			var msglen int
+			m.Runs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3454,28 +6527,77 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Runs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLookout
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuHoursRequested", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CpuHoursRequested = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryGibHoursRequested", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueuedStats == nil {
-				m.QueuedStats = &DurationStats{}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MemoryGibHoursRequested = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpuHoursRequested", wireType)
 			}
-			if err := m.QueuedStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GpuHoursRequested = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuHoursUsed", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CpuHoursUsed = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryGibHoursUsed", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MemoryGibHoursUsed = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpuDutyCycleHoursUsed", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GpuDutyCycleHoursUsed = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLookout(dAtA[iNdEx:])
@@ -3497,7 +6619,7 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DurationStats) Unmarshal(dAtA []byte) error {
+func (m *GetResourceConsumptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3520,15 +6642,15 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DurationStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetResourceConsumptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DurationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetResourceConsumptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shortest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceConsumption", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3555,18 +6677,66 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shortest == nil {
-				m.Shortest = &types.Duration{}
-			}
-			if err := m.Shortest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ResourceConsumption = append(m.ResourceConsumption, &ResourceConsumption{})
+			if err := m.ResourceConsumption[len(m.ResourceConsumption)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SavedView) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SavedView: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SavedView: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Longest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3576,33 +6746,29 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Longest == nil {
-				m.Longest = &types.Duration{}
-			}
-			if err := m.Longest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3612,33 +6778,29 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Average == nil {
-				m.Average = &types.Duration{}
-			}
-			if err := m.Average.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3648,31 +6810,27 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Median == nil {
-				m.Median = &types.Duration{}
-			}
-			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3699,16 +6857,16 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Q1 == nil {
-				m.Q1 = &types.Duration{}
+			if m.Request == nil {
+				m.Request = &GetJobsRequest{}
 			}
-			if err := m.Q1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3735,10 +6893,10 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Q3 == nil {
-				m.Q3 = &types.Duration{}
+			if m.Created == nil {
+				m.Created = new(time.Time)
 			}
-			if err := m.Q3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3763,7 +6921,7 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateSavedViewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3786,15 +6944,15 @@ func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobSetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSavedViewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobSetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSavedViewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3822,7 +6980,43 @@ func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &GetJobsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3845,7 +7039,7 @@ func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetSavedViewsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,15 +7062,15 @@ func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobSetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSavedViewsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobSetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSavedViewsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SavedViews", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3903,8 +7097,8 @@ func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetInfos = append(m.JobSetInfos, &JobSetInfo{})
-			if err := m.JobSetInfos[len(m.JobSetInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SavedViews = append(m.SavedViews, &SavedView{})
+			if err := m.SavedViews[len(m.SavedViews)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3929,7 +7123,7 @@ func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteSavedViewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3952,15 +7146,15 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteSavedViewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteSavedViewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3988,31 +7182,61 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewestFirst", wireType)
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResubmitJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.NewestFirst = bool(v != 0)
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResubmitJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResubmitJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4040,11 +7264,11 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobStates = append(m.JobStates, string(dAtA[iNdEx:postIndex]))
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4072,49 +7296,11 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetIds = append(m.JobSetIds, string(dAtA[iNdEx:postIndex]))
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Take", wireType)
-			}
-			m.Take = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Take |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
-			}
-			m.Skip = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Skip |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4142,13 +7328,13 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -4158,27 +7344,31 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.Priority == nil {
+				m.Priority = &types.DoubleValue{}
+			}
+			if err := m.Priority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4205,8 +7395,8 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserAnnotations == nil {
-				m.UserAnnotations = make(map[string]string)
+			if m.Resources == nil {
+				m.Resources = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -4301,7 +7491,7 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.UserAnnotations[mapkey] = mapvalue
+			m.Resources[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4324,7 +7514,7 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
+func (m *ResubmitJobsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4347,15 +7537,15 @@ func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResubmitJobsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResubmitJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResubmittedJobs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4382,10 +7572,156 @@ func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobInfos = append(m.JobInfos, &JobInfo{})
-			if err := m.JobInfos[len(m.JobInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ResubmittedJobs = append(m.ResubmittedJobs, &ResubmittedJob{})
+			if err := m.ResubmittedJobs[len(m.ResubmittedJobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResubmittedJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResubmittedJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResubmittedJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
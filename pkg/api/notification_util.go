@@ -0,0 +1,20 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// NotifyUrlAnnotation can be set on a job to receive a notification when the job succeeds, fails or is cancelled
+const NotifyUrlAnnotation = "armadaproject.io/notifyUrl"
+
+// Notifications of subscriptions with a secret are signed, the header contains HMAC-SHA256 of the request body
+const NotificationSignatureHeader = "X-Armada-Signature-256"
+
+// SignNotification returns value of the signature header for notification body
+func SignNotification(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -57,6 +57,90 @@ func (IngressType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{0}
 }
 
+type JobStatus int32
+
+const (
+	JobStatus_UnknownStatus JobStatus = 0
+	JobStatus_Submitted     JobStatus = 1
+	JobStatus_Duplicate     JobStatus = 2
+	JobStatus_Queued        JobStatus = 3
+	JobStatus_Leased        JobStatus = 4
+	JobStatus_Pending       JobStatus = 5
+	JobStatus_Running       JobStatus = 6
+	JobStatus_Succeeded     JobStatus = 7
+	JobStatus_Failed        JobStatus = 8
+	JobStatus_Cancelled     JobStatus = 9
+)
+
+var JobStatus_name = map[int32]string{
+	0: "UnknownStatus",
+	1: "Submitted",
+	2: "Duplicate",
+	3: "Queued",
+	4: "Leased",
+	5: "Pending",
+	6: "Running",
+	7: "Succeeded",
+	8: "Failed",
+	9: "Cancelled",
+}
+
+var JobStatus_value = map[string]int32{
+	"UnknownStatus": 0,
+	"Submitted":     1,
+	"Duplicate":     2,
+	"Queued":        3,
+	"Leased":        4,
+	"Pending":       5,
+	"Running":       6,
+	"Succeeded":     7,
+	"Failed":        8,
+	"Cancelled":     9,
+}
+
+func (x JobStatus) String() string {
+	return proto.EnumName(JobStatus_name, int32(x))
+}
+
+func (JobStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
+
+// Roles granted on a single queue, each role includes rights of the previous ones
+type QueueRole int32
+
+const (
+	QueueRole_NoRole    QueueRole = 0
+	QueueRole_Viewer    QueueRole = 1
+	QueueRole_Submitter QueueRole = 2
+	QueueRole_Operator  QueueRole = 3
+	QueueRole_Admin     QueueRole = 4
+)
+
+var QueueRole_name = map[int32]string{
+	0: "NoRole",
+	1: "Viewer",
+	2: "Submitter",
+	3: "Operator",
+	4: "Admin",
+}
+
+var QueueRole_value = map[string]int32{
+	"NoRole":    0,
+	"Viewer":    1,
+	"Submitter": 2,
+	"Operator":  3,
+	"Admin":     4,
+}
+
+func (x QueueRole) String() string {
+	return proto.EnumName(QueueRole_name, int32(x))
+}
+
+func (QueueRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{2}
+}
+
 type JobSubmitRequestItem struct {
 	Priority           float64           `protobuf:"fixed64,1,opt,name=priority,proto3" json:"priority,omitempty"`
 	Namespace          string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -222,6 +306,10 @@ type JobSubmitRequest struct {
 	Queue           string                  `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 	JobSetId        string                  `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	JobRequestItems []*JobSubmitRequestItem `protobuf:"bytes,3,rep,name=job_request_items,json=jobRequestItems,proto3" json:"jobRequestItems,omitempty"`
+	// Return current status of the original job for items detected as duplicates of earlier submissions with the same client id
+	ReturnDuplicateStatus bool `protobuf:"varint,4,opt,name=return_duplicate_status,json=returnDuplicateStatus,proto3" json:"returnDuplicateStatus,omitempty"`
+	// Validate the jobs and check each of them can be scheduled on some cluster without submitting them
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
 func (m *JobSubmitRequest) Reset()      { *m = JobSubmitRequest{} }
@@ -277,6 +365,20 @@ func (m *JobSubmitRequest) GetJobRequestItems() []*JobSubmitRequestItem {
 	return nil
 }
 
+func (m *JobSubmitRequest) GetReturnDuplicateStatus() bool {
+	if m != nil {
+		return m.ReturnDuplicateStatus
+	}
+	return false
+}
+
+func (m *JobSubmitRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 // swagger:model
 type JobCancelRequest struct {
 	JobId    string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
@@ -450,8 +552,13 @@ func (m *JobReprioritizeResponse) GetReprioritizationResults() map[string]string
 }
 
 type JobSubmitResponseItem struct {
+	// Empty for dry run, no job is created
 	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Job with the same client id was submitted earlier, job_id is id of the original job
+	Duplicate bool `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	// Current status of the original job, set only when requested by return_duplicate_status
+	OriginalJobStatus JobStatus `protobuf:"varint,4,opt,name=original_job_status,json=originalJobStatus,proto3,enum=api.JobStatus" json:"originalJobStatus,omitempty"`
 }
 
 func (m *JobSubmitResponseItem) Reset()      { *m = JobSubmitResponseItem{} }
@@ -500,6 +607,20 @@ func (m *JobSubmitResponseItem) GetError() string {
 	return ""
 }
 
+func (m *JobSubmitResponseItem) GetDuplicate() bool {
+	if m != nil {
+		return m.Duplicate
+	}
+	return false
+}
+
+func (m *JobSubmitResponseItem) GetOriginalJobStatus() JobStatus {
+	if m != nil {
+		return m.OriginalJobStatus
+	}
+	return JobStatus_UnknownStatus
+}
+
 // swagger:model
 type JobSubmitResponse struct {
 	JobResponseItems []*JobSubmitResponseItem `protobuf:"bytes,1,rep,name=job_response_items,json=jobResponseItems,proto3" json:"jobResponseItems,omitempty"`
@@ -546,11 +667,14 @@ func (m *JobSubmitResponse) GetJobResponseItems() []*JobSubmitResponseItem {
 
 // swagger:model
 type Queue struct {
-	Name           string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PriorityFactor float64            `protobuf:"fixed64,2,opt,name=priority_factor,json=priorityFactor,proto3" json:"priorityFactor,omitempty"`
-	UserOwners     []string           `protobuf:"bytes,3,rep,name=user_owners,json=userOwners,proto3" json:"userOwners,omitempty"`
-	GroupOwners    []string           `protobuf:"bytes,4,rep,name=group_owners,json=groupOwners,proto3" json:"groupOwners,omitempty"`
-	ResourceLimits map[string]float64 `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Name           string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PriorityFactor float64             `protobuf:"fixed64,2,opt,name=priority_factor,json=priorityFactor,proto3" json:"priorityFactor,omitempty"`
+	UserOwners     []string            `protobuf:"bytes,3,rep,name=user_owners,json=userOwners,proto3" json:"userOwners,omitempty"`
+	GroupOwners    []string            `protobuf:"bytes,4,rep,name=group_owners,json=groupOwners,proto3" json:"groupOwners,omitempty"`
+	ResourceLimits map[string]float64  `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	RoleBindings   []*QueueRoleBinding `protobuf:"bytes,6,rep,name=role_bindings,json=roleBindings,proto3" json:"roleBindings,omitempty"`
+	// Incremented on every change of the queue, updates are accepted only for the current version
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *Queue) Reset()      { *m = Queue{} }
@@ -620,6 +744,176 @@ func (m *Queue) GetResourceLimits() map[string]float64 {
 	return nil
 }
 
+func (m *Queue) GetRoleBindings() []*QueueRoleBinding {
+	if m != nil {
+		return m.RoleBindings
+	}
+	return nil
+}
+
+func (m *Queue) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// Grants the role on the queue to users and members of groups
+type QueueRoleBinding struct {
+	Role   QueueRole `protobuf:"varint,1,opt,name=role,proto3,enum=api.QueueRole" json:"role,omitempty"`
+	Users  []string  `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Groups []string  `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (m *QueueRoleBinding) Reset()      { *m = QueueRoleBinding{} }
+func (*QueueRoleBinding) ProtoMessage() {}
+func (*QueueRoleBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{9}
+}
+func (m *QueueRoleBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueRoleBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueRoleBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueRoleBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueRoleBinding.Merge(m, src)
+}
+func (m *QueueRoleBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueRoleBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueRoleBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueRoleBinding proto.InternalMessageInfo
+
+func (m *QueueRoleBinding) GetRole() QueueRole {
+	if m != nil {
+		return m.Role
+	}
+	return QueueRole_NoRole
+}
+
+func (m *QueueRoleBinding) GetUsers() []string {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *QueueRoleBinding) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type QueueList struct {
+	Queues []*Queue `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+}
+
+func (m *QueueList) Reset()      { *m = QueueList{} }
+func (*QueueList) ProtoMessage() {}
+func (*QueueList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{10}
+}
+func (m *QueueList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueList.Merge(m, src)
+}
+func (m *QueueList) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueList proto.InternalMessageInfo
+
+func (m *QueueList) GetQueues() []*Queue {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
+//swagger:model
+type QueueRoleBindingsRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Replaces all existing bindings of the queue
+	RoleBindings []*QueueRoleBinding `protobuf:"bytes,2,rep,name=role_bindings,json=roleBindings,proto3" json:"roleBindings,omitempty"`
+}
+
+func (m *QueueRoleBindingsRequest) Reset()      { *m = QueueRoleBindingsRequest{} }
+func (*QueueRoleBindingsRequest) ProtoMessage() {}
+func (*QueueRoleBindingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{11}
+}
+func (m *QueueRoleBindingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueRoleBindingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueRoleBindingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueRoleBindingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueRoleBindingsRequest.Merge(m, src)
+}
+func (m *QueueRoleBindingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueRoleBindingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueRoleBindingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueRoleBindingsRequest proto.InternalMessageInfo
+
+func (m *QueueRoleBindingsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueueRoleBindingsRequest) GetRoleBindings() []*QueueRoleBinding {
+	if m != nil {
+		return m.RoleBindings
+	}
+	return nil
+}
+
 // swagger:model
 type CancellationResult struct {
 	CancelledIds []string `protobuf:"bytes,1,rep,name=cancelled_ids,json=cancelledIds,proto3" json:"cancelledIds"`
@@ -628,7 +922,7 @@ type CancellationResult struct {
 func (m *CancellationResult) Reset()      { *m = CancellationResult{} }
 func (*CancellationResult) ProtoMessage() {}
 func (*CancellationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{9}
+	return fileDescriptor_e998bacb27df16c1, []int{12}
 }
 func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +966,7 @@ type QueueInfoRequest struct {
 func (m *QueueInfoRequest) Reset()      { *m = QueueInfoRequest{} }
 func (*QueueInfoRequest) ProtoMessage() {}
 func (*QueueInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{10}
+	return fileDescriptor_e998bacb27df16c1, []int{13}
 }
 func (m *QueueInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +1010,7 @@ type QueueDeleteRequest struct {
 func (m *QueueDeleteRequest) Reset()      { *m = QueueDeleteRequest{} }
 func (*QueueDeleteRequest) ProtoMessage() {}
 func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{11}
+	return fileDescriptor_e998bacb27df16c1, []int{14}
 }
 func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +1055,7 @@ type QueueInfo struct {
 func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
 func (*QueueInfo) ProtoMessage() {}
 func (*QueueInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{12}
+	return fileDescriptor_e998bacb27df16c1, []int{15}
 }
 func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,6 +1098,58 @@ func (m *QueueInfo) GetActiveJobSets() []*JobSetInfo {
 	return nil
 }
 
+// swagger:model
+type JobSetCloseRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+}
+
+func (m *JobSetCloseRequest) Reset()      { *m = JobSetCloseRequest{} }
+func (*JobSetCloseRequest) ProtoMessage() {}
+func (*JobSetCloseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{16}
+}
+func (m *JobSetCloseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetCloseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetCloseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSetCloseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetCloseRequest.Merge(m, src)
+}
+func (m *JobSetCloseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetCloseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetCloseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetCloseRequest proto.InternalMessageInfo
+
+func (m *JobSetCloseRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobSetCloseRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
 type JobSetInfo struct {
 	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
@@ -813,7 +1159,7 @@ type JobSetInfo struct {
 func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
 func (*JobSetInfo) ProtoMessage() {}
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13}
+	return fileDescriptor_e998bacb27df16c1, []int{17}
 }
 func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,6 +1211,8 @@ func (m *JobSetInfo) GetLeasedJobs() int32 {
 
 func init() {
 	proto.RegisterEnum("api.IngressType", IngressType_name, IngressType_value)
+	proto.RegisterEnum("api.JobStatus", JobStatus_name, JobStatus_value)
+	proto.RegisterEnum("api.QueueRole", QueueRole_name, QueueRole_value)
 	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
 	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
@@ -879,93 +1227,125 @@ func init() {
 	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
 	proto.RegisterType((*Queue)(nil), "api.Queue")
 	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
+	proto.RegisterType((*QueueRoleBinding)(nil), "api.QueueRoleBinding")
+	proto.RegisterType((*QueueList)(nil), "api.QueueList")
+	proto.RegisterType((*QueueRoleBindingsRequest)(nil), "api.QueueRoleBindingsRequest")
 	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
 	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
 	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
 	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
+	proto.RegisterType((*JobSetCloseRequest)(nil), "api.JobSetCloseRequest")
 	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
 }
 
 func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
 
 var fileDescriptor_e998bacb27df16c1 = []byte{
-	// 1215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x6f, 0x1b, 0x45,
-	0x10, 0xcf, 0xc5, 0xf9, 0xb0, 0xe7, 0xf2, 0xe1, 0x6e, 0x93, 0xe6, 0x7a, 0x89, 0x1c, 0x73, 0xe2,
-	0xc3, 0x8a, 0xe0, 0xac, 0x06, 0x21, 0xda, 0x4a, 0x20, 0xf5, 0x23, 0x94, 0x84, 0xaa, 0x2d, 0x57,
-	0x04, 0x7d, 0xa9, 0xac, 0x3b, 0xdf, 0xc4, 0x5c, 0x6a, 0xdf, 0x5e, 0x77, 0xd7, 0xa9, 0x02, 0x42,
-	0x42, 0x3c, 0xf1, 0x82, 0x84, 0x04, 0x7f, 0x02, 0x7f, 0x0c, 0xbc, 0x55, 0xe2, 0xa5, 0x12, 0x12,
-	0x82, 0x94, 0x27, 0xfe, 0x0a, 0x74, 0xb3, 0x77, 0xf1, 0x39, 0xb6, 0x53, 0x85, 0xb7, 0x9b, 0xd9,
-	0xdf, 0xfc, 0xe6, 0xb7, 0x3b, 0xb3, 0x73, 0x0b, 0x2b, 0xc9, 0x93, 0x4e, 0xd3, 0x4f, 0xa2, 0xa6,
-	0xec, 0x07, 0xbd, 0x48, 0xb9, 0x89, 0xe0, 0x8a, 0xb3, 0x92, 0x9f, 0x44, 0xf6, 0x7a, 0x87, 0xf3,
-	0x4e, 0x17, 0x9b, 0xe4, 0x0a, 0xfa, 0xfb, 0x4d, 0xec, 0x25, 0xea, 0x48, 0x23, 0x6c, 0xe7, 0xc9,
-	0x55, 0xe9, 0x46, 0x9c, 0x42, 0xdb, 0x5c, 0x60, 0xf3, 0xf0, 0x4a, 0xb3, 0x83, 0x31, 0x0a, 0x5f,
-	0x61, 0x98, 0x61, 0x36, 0x32, 0x82, 0x14, 0xe3, 0xc7, 0x31, 0x57, 0xbe, 0x8a, 0x78, 0x2c, 0xb3,
-	0xd5, 0x77, 0x3a, 0x91, 0xfa, 0xb2, 0x1f, 0xb8, 0x6d, 0xde, 0x6b, 0x76, 0x78, 0x87, 0x0f, 0xf2,
-	0xa4, 0x16, 0x19, 0xf4, 0xa5, 0xe1, 0xce, 0x6f, 0xb3, 0xb0, 0xb2, 0xc7, 0x83, 0x87, 0x24, 0xd3,
-	0xc3, 0xa7, 0x7d, 0x94, 0x6a, 0x57, 0x61, 0x8f, 0xd9, 0x50, 0x4e, 0x44, 0xc4, 0x45, 0xa4, 0x8e,
-	0x2c, 0xa3, 0x6e, 0x34, 0x0c, 0xef, 0xc4, 0x66, 0x1b, 0x50, 0x89, 0xfd, 0x1e, 0xca, 0xc4, 0x6f,
-	0xa3, 0x55, 0xaa, 0x1b, 0x8d, 0x8a, 0x37, 0x70, 0xb0, 0x75, 0xa8, 0xb4, 0xbb, 0x11, 0xc6, 0xaa,
-	0x15, 0x85, 0x56, 0x99, 0x56, 0xcb, 0xda, 0xb1, 0x1b, 0xb2, 0x0f, 0x60, 0xae, 0xeb, 0x07, 0xd8,
-	0x95, 0xd6, 0x4c, 0xbd, 0xd4, 0x30, 0xb7, 0xdf, 0x70, 0xfd, 0x24, 0x72, 0xc7, 0x29, 0x70, 0xef,
-	0x12, 0x6e, 0x27, 0x56, 0xe2, 0xc8, 0xcb, 0x82, 0xd8, 0x5d, 0x30, 0x0b, 0x5b, 0xb6, 0x66, 0x89,
-	0x63, 0x6b, 0x32, 0xc7, 0x8d, 0x01, 0x58, 0x13, 0x15, 0xc3, 0x59, 0x07, 0x56, 0x04, 0x3e, 0xed,
-	0x47, 0x02, 0xc3, 0x56, 0xcc, 0x43, 0x6c, 0x65, 0xd2, 0xe6, 0x88, 0xf6, 0xca, 0x64, 0x5a, 0x2f,
-	0x8b, 0xba, 0xc7, 0x43, 0x2c, 0xc8, 0xbc, 0x39, 0x6d, 0x19, 0x1e, 0x13, 0x23, 0x8b, 0xec, 0x3a,
-	0x94, 0x13, 0x1e, 0xb6, 0x64, 0x82, 0x6d, 0x6b, 0xba, 0x6e, 0x34, 0xcc, 0xed, 0x75, 0x57, 0x57,
-	0x9a, 0x72, 0xa4, 0x95, 0x76, 0x0f, 0xaf, 0xb8, 0x0f, 0x78, 0xf8, 0x30, 0xc1, 0x36, 0xd1, 0xcc,
-	0x27, 0xda, 0x60, 0x57, 0xa1, 0x92, 0xc7, 0x4a, 0x6b, 0x9e, 0x94, 0x9d, 0x15, 0xec, 0x95, 0xb3,
-	0x40, 0xc9, 0xde, 0x86, 0xf9, 0x28, 0xee, 0x08, 0x94, 0xd2, 0xaa, 0x50, 0x1c, 0xa3, 0x80, 0x5d,
-	0xed, 0xbb, 0xc5, 0xe3, 0xfd, 0xa8, 0xe3, 0xe5, 0x10, 0xfb, 0x1a, 0x98, 0x85, 0xad, 0xb0, 0x2a,
-	0x94, 0x9e, 0xa0, 0x2e, 0x7d, 0xc5, 0x4b, 0x3f, 0xd9, 0x0a, 0xcc, 0x1e, 0xfa, 0xdd, 0x3e, 0xd2,
-	0x0e, 0x2a, 0x9e, 0x36, 0xae, 0x4f, 0x5f, 0x35, 0xec, 0x0f, 0xa1, 0x7a, 0xfa, 0xa0, 0xcf, 0x15,
-	0xbf, 0x03, 0x6b, 0x13, 0x4e, 0xf4, 0x3c, 0x34, 0xce, 0x27, 0xb0, 0x38, 0xb4, 0x37, 0xf6, 0x3a,
-	0xcc, 0xa8, 0xa3, 0x04, 0x29, 0x7a, 0x69, 0xbb, 0x5a, 0xdc, 0xfd, 0x67, 0x47, 0x09, 0x7a, 0xb4,
-	0x9a, 0x12, 0x26, 0x5c, 0x28, 0x69, 0x4d, 0xd7, 0x4b, 0x8d, 0x45, 0x4f, 0x1b, 0xce, 0x0f, 0x06,
-	0x54, 0x4f, 0xd7, 0x3e, 0x85, 0x3e, 0xed, 0x63, 0x1f, 0x33, 0x3d, 0xda, 0x60, 0x1b, 0x00, 0x07,
-	0x3c, 0x68, 0x49, 0xa4, 0x8e, 0xd7, 0xb2, 0xca, 0x07, 0x3c, 0x78, 0x88, 0x69, 0xc7, 0xef, 0xc0,
-	0x85, 0x74, 0x55, 0x68, 0x8a, 0x56, 0xa4, 0xb0, 0x27, 0xad, 0x12, 0xd5, 0xe3, 0xf2, 0xc4, 0x0e,
-	0xf3, 0x96, 0x0f, 0x78, 0x50, 0xb0, 0xa5, 0xf3, 0x98, 0xe4, 0xdc, 0xf2, 0xe3, 0x36, 0x76, 0x73,
-	0x39, 0xab, 0x30, 0x97, 0x52, 0x47, 0x61, 0xae, 0xe7, 0x80, 0x07, 0xbb, 0xe1, 0x2b, 0xf4, 0x9c,
-	0xec, 0xa1, 0x54, 0xd8, 0x83, 0xf3, 0xbd, 0x01, 0x97, 0xf6, 0xd2, 0x94, 0xd9, 0x25, 0x8f, 0xbe,
-	0xc2, 0x3c, 0xcb, 0x1a, 0xcc, 0xeb, 0x2c, 0xd2, 0x32, 0xea, 0xa5, 0x46, 0xc5, 0x9b, 0xa3, 0x34,
-	0xf2, 0xff, 0xe4, 0x61, 0xaf, 0xc1, 0x42, 0x8c, 0xcf, 0x5a, 0x27, 0xa3, 0x65, 0x86, 0x46, 0x8b,
-	0x19, 0xe3, 0xb3, 0x07, 0x99, 0xcb, 0xf9, 0xc3, 0x80, 0xb5, 0x11, 0x29, 0x32, 0xe1, 0xb1, 0x44,
-	0xa6, 0xc0, 0x12, 0x03, 0x3f, 0xf5, 0x5b, 0x4b, 0xa0, 0xec, 0x77, 0x95, 0x16, 0x67, 0x6e, 0x5f,
-	0xcb, 0xcf, 0x74, 0x5c, 0xbc, 0xeb, 0x9d, 0x0a, 0xf6, 0x74, 0xac, 0x9e, 0x0d, 0x6b, 0x62, 0xfc,
-	0xaa, 0xbd, 0x07, 0x1b, 0x67, 0x05, 0x9e, 0xab, 0x49, 0x6f, 0xc3, 0x6a, 0xa1, 0xe0, 0x5a, 0x16,
-	0x0d, 0xdc, 0x09, 0xc5, 0x5c, 0x81, 0x59, 0x14, 0x82, 0x8b, 0x9c, 0x89, 0x0c, 0xe7, 0x31, 0x5c,
-	0x18, 0x61, 0x61, 0x1f, 0x03, 0xd3, 0x9d, 0xa6, 0xed, 0xac, 0xd5, 0xf4, 0xb1, 0xd8, 0xa7, 0x5b,
-	0x6d, 0x90, 0xd9, 0xab, 0x52, 0xaf, 0x0d, 0x1c, 0xd2, 0xf9, 0x79, 0x1a, 0x66, 0x3f, 0xa5, 0x7a,
-	0x31, 0x98, 0x49, 0x27, 0x7b, 0xa6, 0x89, 0xbe, 0xd9, 0x5b, 0xb0, 0x9c, 0xd7, 0xaf, 0xb5, 0xef,
-	0xb7, 0x55, 0x26, 0xce, 0xf0, 0x96, 0x72, 0xf7, 0x47, 0xe4, 0x65, 0x9b, 0x60, 0xf6, 0x25, 0x8a,
-	0x16, 0x7f, 0x16, 0xa3, 0xd0, 0x4d, 0x5f, 0xf1, 0x20, 0x75, 0xdd, 0x27, 0x4f, 0xda, 0x0d, 0x1d,
-	0xc1, 0xfb, 0x49, 0x8e, 0x98, 0x21, 0x84, 0x49, 0xbe, 0x0c, 0x72, 0x07, 0x96, 0x05, 0x4a, 0xde,
-	0x17, 0x6d, 0x6c, 0x75, 0xa3, 0x5e, 0xa4, 0xf2, 0xa9, 0x5f, 0xa3, 0x1d, 0x91, 0x4a, 0xd7, 0xcb,
-	0x10, 0x77, 0x09, 0xa0, 0xab, 0xb9, 0x24, 0x86, 0x9c, 0xf6, 0x0d, 0xb8, 0x38, 0x06, 0xf6, 0xaa,
-	0xda, 0x19, 0xc3, 0x03, 0x86, 0xe9, 0x0b, 0xd8, 0x2d, 0xf4, 0x00, 0x7b, 0x0f, 0x16, 0xdb, 0xda,
-	0x8b, 0xe1, 0xe0, 0x96, 0xdc, 0xac, 0xfe, 0xfb, 0xe7, 0xe6, 0xc2, 0xc9, 0xc2, 0x6e, 0x28, 0xbd,
-	0x21, 0xcb, 0x79, 0x13, 0xaa, 0x24, 0x7e, 0x37, 0xde, 0xe7, 0xf9, 0x55, 0x1b, 0x73, 0xda, 0x4e,
-	0x03, 0x18, 0xe1, 0x6e, 0x63, 0x17, 0x15, 0x9e, 0x85, 0x7c, 0x04, 0x95, 0x13, 0xc6, 0xb1, 0x85,
-	0x7b, 0x1f, 0x96, 0xfd, 0xb6, 0x8a, 0x0e, 0xb1, 0x95, 0xdd, 0x5b, 0x3d, 0xf3, 0xcc, 0xed, 0xe5,
-	0x93, 0xee, 0x40, 0x45, 0x7a, 0x16, 0x35, 0x4e, 0x7b, 0xa4, 0x13, 0x00, 0x0c, 0x16, 0xc7, 0x52,
-	0x6f, 0x82, 0x49, 0x17, 0x3c, 0x4c, 0xa9, 0x25, 0x1d, 0xdd, 0xac, 0x07, 0xda, 0xb5, 0xc7, 0x03,
-	0x99, 0x02, 0xba, 0xe8, 0xcb, 0x1c, 0x50, 0xd2, 0x00, 0xed, 0x4a, 0x01, 0x5b, 0xeb, 0x60, 0x16,
-	0x66, 0x33, 0x5b, 0x80, 0x72, 0xfa, 0x2f, 0x78, 0xc0, 0x85, 0xaa, 0x4e, 0x6d, 0xff, 0x32, 0x03,
-	0x73, 0xba, 0x73, 0xd9, 0xe7, 0x00, 0xfa, 0x8b, 0x68, 0x57, 0xc7, 0x8e, 0x50, 0xfb, 0xd2, 0xf8,
-	0x76, 0x77, 0x2e, 0x7f, 0xf7, 0xfb, 0x3f, 0x3f, 0x4d, 0x5f, 0x74, 0x96, 0xd2, 0x87, 0xd5, 0x01,
-	0x0f, 0xb2, 0xf7, 0xd9, 0x75, 0x63, 0x8b, 0x7d, 0x01, 0xa0, 0x8b, 0x3b, 0xcc, 0x3b, 0x34, 0x71,
-	0xed, 0x35, 0x72, 0x8f, 0x36, 0xc1, 0x28, 0xb1, 0xae, 0x75, 0x4a, 0x1c, 0x43, 0xb5, 0x38, 0x8b,
-	0x88, 0x7e, 0x7d, 0xfc, 0x94, 0xd2, 0x49, 0x36, 0xce, 0x1a, 0x61, 0xce, 0x26, 0x65, 0xba, 0xec,
-	0xac, 0xe4, 0x99, 0x0a, 0x53, 0x0b, 0xd3, 0x7c, 0xf7, 0xc0, 0xbc, 0x25, 0xd0, 0x57, 0xa8, 0x6f,
-	0x30, 0x0c, 0xee, 0x89, 0x7d, 0xc9, 0xd5, 0x6f, 0x47, 0x37, 0x7f, 0x14, 0xba, 0x3b, 0xe9, 0xe3,
-	0xd3, 0x59, 0x27, 0xce, 0x55, 0xbb, 0x9a, 0x72, 0x52, 0xd1, 0x9a, 0x5f, 0xa7, 0x65, 0xfd, 0x26,
-	0xe5, 0x7b, 0x04, 0xa6, 0xee, 0x3d, 0xcd, 0xb7, 0x36, 0xe0, 0x1b, 0x6a, 0xc9, 0x89, 0xe4, 0x16,
-	0x91, 0xb3, 0xad, 0x11, 0x72, 0x76, 0x1f, 0x16, 0xee, 0xa0, 0x1a, 0xf4, 0xec, 0xea, 0x80, 0xba,
-	0x70, 0x2b, 0xec, 0xa5, 0x61, 0x77, 0x4e, 0xc8, 0x46, 0x08, 0x6f, 0xd6, 0x5f, 0xfc, 0x5d, 0x9b,
-	0xfa, 0xf6, 0xb8, 0x66, 0xfc, 0x7a, 0x5c, 0x33, 0x9e, 0x1f, 0xd7, 0x8c, 0xbf, 0x8e, 0x6b, 0xc6,
-	0x8f, 0x2f, 0x6b, 0x53, 0xcf, 0x5f, 0xd6, 0xa6, 0x5e, 0xbc, 0xac, 0x4d, 0x05, 0x73, 0x24, 0xee,
-	0xdd, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x92, 0x6a, 0x6f, 0xa1, 0x0b, 0x00, 0x00,
+	// 1663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xcf, 0x77, 0xbf, 0xf1, 0x47, 0xbb, 0x62, 0x7b, 0x3a, 0x63, 0x33, 0x19, 0x9a, 0xaf,
+	0xc1, 0x22, 0x33, 0x8a, 0x11, 0x90, 0xb5, 0xc4, 0x4a, 0xb1, 0x37, 0x9b, 0x75, 0x62, 0x25, 0xa1,
+	0xcd, 0x2e, 0x7b, 0x60, 0xd5, 0xea, 0x99, 0x7e, 0x19, 0xda, 0xe9, 0xe9, 0xea, 0x54, 0x55, 0xdb,
+	0x18, 0x84, 0x84, 0x38, 0xc1, 0x0d, 0x89, 0x0b, 0x67, 0xfe, 0x1a, 0xb8, 0xad, 0xc4, 0x65, 0x25,
+	0xa4, 0x15, 0x24, 0x9c, 0xf6, 0xaf, 0x40, 0x55, 0xd5, 0x3d, 0xd3, 0xb6, 0x67, 0x62, 0x02, 0xb7,
+	0x7a, 0x5f, 0xbf, 0xf7, 0x51, 0xef, 0xbd, 0xea, 0x86, 0x8d, 0xe4, 0xe5, 0x78, 0xe0, 0x27, 0xe1,
+	0x80, 0xa7, 0xc3, 0x49, 0x28, 0xfa, 0x09, 0xa3, 0x82, 0x92, 0xb2, 0x9f, 0x84, 0xed, 0xed, 0x31,
+	0xa5, 0xe3, 0x08, 0x07, 0x8a, 0x35, 0x4c, 0x5f, 0x0c, 0x70, 0x92, 0x88, 0x0b, 0xad, 0xd1, 0x76,
+	0x5e, 0xde, 0xe7, 0xfd, 0x90, 0x2a, 0xd3, 0x11, 0x65, 0x38, 0x38, 0xbb, 0x37, 0x18, 0x63, 0x8c,
+	0xcc, 0x17, 0x18, 0x64, 0x3a, 0x3b, 0x19, 0x80, 0xd4, 0xf1, 0xe3, 0x98, 0x0a, 0x5f, 0x84, 0x34,
+	0xe6, 0x99, 0xf4, 0xee, 0x38, 0x14, 0xbf, 0x48, 0x87, 0xfd, 0x11, 0x9d, 0x0c, 0xc6, 0x74, 0x4c,
+	0x67, 0x7e, 0x24, 0xa5, 0x08, 0x75, 0xd2, 0xea, 0xce, 0xdf, 0xaa, 0xb0, 0xf1, 0x98, 0x0e, 0x4f,
+	0x54, 0x98, 0x2e, 0xbe, 0x4a, 0x91, 0x8b, 0x23, 0x81, 0x13, 0xd2, 0x86, 0x46, 0xc2, 0x42, 0xca,
+	0x42, 0x71, 0x61, 0x1b, 0x5d, 0xa3, 0x67, 0xb8, 0x53, 0x9a, 0xec, 0x80, 0x19, 0xfb, 0x13, 0xe4,
+	0x89, 0x3f, 0x42, 0xbb, 0xdc, 0x35, 0x7a, 0xa6, 0x3b, 0x63, 0x90, 0x6d, 0x30, 0x47, 0x51, 0x88,
+	0xb1, 0xf0, 0xc2, 0xc0, 0x6e, 0x28, 0x69, 0x43, 0x33, 0x8e, 0x02, 0xf2, 0x63, 0xa8, 0x45, 0xfe,
+	0x10, 0x23, 0x6e, 0x57, 0xba, 0xe5, 0x5e, 0x73, 0xef, 0x5b, 0x7d, 0x3f, 0x09, 0xfb, 0xf3, 0x22,
+	0xe8, 0x1f, 0x2b, 0xbd, 0x87, 0xb1, 0x60, 0x17, 0x6e, 0x66, 0x44, 0x8e, 0xa1, 0x59, 0x48, 0xd9,
+	0xae, 0x2a, 0x8c, 0xdd, 0xc5, 0x18, 0x0f, 0x66, 0xca, 0x1a, 0xa8, 0x68, 0x4e, 0xc6, 0xb0, 0xc1,
+	0xf0, 0x55, 0x1a, 0x32, 0x0c, 0xbc, 0x98, 0x06, 0xe8, 0x65, 0xa1, 0xd5, 0x14, 0xec, 0xbd, 0xc5,
+	0xb0, 0x6e, 0x66, 0xf5, 0x94, 0x06, 0x58, 0x08, 0xf3, 0xa0, 0x64, 0x1b, 0x2e, 0x61, 0xd7, 0x84,
+	0x64, 0x1f, 0x1a, 0x09, 0x0d, 0x3c, 0x9e, 0xe0, 0xc8, 0x2e, 0x75, 0x8d, 0x5e, 0x73, 0x6f, 0xbb,
+	0xaf, 0x6f, 0x5a, 0xf9, 0x90, 0x37, 0xdd, 0x3f, 0xbb, 0xd7, 0x7f, 0x4e, 0x83, 0x93, 0x04, 0x47,
+	0x0a, 0xa6, 0x9e, 0x68, 0x82, 0xdc, 0x07, 0x33, 0xb7, 0xe5, 0x76, 0xbd, 0x5b, 0xbe, 0xc1, 0xd8,
+	0x6d, 0x64, 0x86, 0x9c, 0x7c, 0x0f, 0xea, 0x61, 0x3c, 0x66, 0xc8, 0xb9, 0x6d, 0x2a, 0x3b, 0xa2,
+	0x0c, 0x8e, 0x34, 0xef, 0x90, 0xc6, 0x2f, 0xc2, 0xb1, 0x9b, 0xab, 0xb4, 0xdf, 0x83, 0x66, 0x21,
+	0x15, 0x62, 0x41, 0xf9, 0x25, 0xea, 0xab, 0x37, 0x5d, 0x79, 0x24, 0x1b, 0x50, 0x3d, 0xf3, 0xa3,
+	0x14, 0x55, 0x06, 0xa6, 0xab, 0x89, 0xfd, 0xd2, 0x7d, 0xa3, 0xfd, 0x3e, 0x58, 0x57, 0x0b, 0xfd,
+	0x4e, 0xf6, 0x0f, 0xa1, 0xb5, 0xa0, 0xa2, 0xef, 0x02, 0xe3, 0x3c, 0x81, 0x95, 0x4b, 0xb9, 0x91,
+	0x6f, 0x42, 0x45, 0x5c, 0x24, 0xa8, 0xac, 0x57, 0xf7, 0xac, 0x62, 0xf6, 0x3f, 0xbd, 0x48, 0xd0,
+	0x55, 0x52, 0x09, 0x98, 0x50, 0x26, 0xb8, 0x5d, 0xea, 0x96, 0x7b, 0x2b, 0xae, 0x26, 0x9c, 0x2f,
+	0x0d, 0xb0, 0xae, 0xde, 0xbd, 0x54, 0x7d, 0x95, 0x62, 0x8a, 0x59, 0x3c, 0x9a, 0x20, 0x3b, 0x00,
+	0xa7, 0x74, 0xe8, 0x71, 0x54, 0x1d, 0xaf, 0xc3, 0x6a, 0x9c, 0xd2, 0xe1, 0x09, 0xca, 0x8e, 0x7f,
+	0x08, 0xeb, 0x52, 0xca, 0x34, 0x84, 0x17, 0x0a, 0x9c, 0x70, 0xbb, 0xac, 0xee, 0xe3, 0xf6, 0xc2,
+	0x0e, 0x73, 0xd7, 0x4e, 0xe9, 0xb0, 0x40, 0x73, 0xf2, 0x43, 0x68, 0x31, 0x14, 0x29, 0x8b, 0xbd,
+	0x20, 0x4d, 0xa2, 0x70, 0xe4, 0x0b, 0xf4, 0xb8, 0xf0, 0x45, 0x2a, 0x27, 0xc9, 0xe8, 0x35, 0xdc,
+	0x4d, 0x2d, 0xfe, 0x20, 0x97, 0x9e, 0x28, 0x21, 0x69, 0x41, 0x3d, 0x60, 0x17, 0x1e, 0x4b, 0x63,
+	0xbb, 0xaa, 0xf4, 0x6a, 0x01, 0xbb, 0x70, 0xd3, 0xd8, 0xf9, 0x4c, 0xe5, 0x77, 0xe8, 0xc7, 0x23,
+	0x8c, 0xf2, 0xfc, 0x36, 0xa1, 0x26, 0x63, 0x0d, 0x83, 0x3c, 0xc1, 0x53, 0x3a, 0x3c, 0x0a, 0x6e,
+	0x48, 0x70, 0x5a, 0x94, 0x72, 0xa1, 0x28, 0xce, 0xef, 0x0d, 0xd8, 0x7a, 0x2c, 0x73, 0xc8, 0xb6,
+	0x46, 0xf8, 0x2b, 0xcc, 0xbd, 0xb4, 0xa0, 0xae, 0xbd, 0x70, 0xdb, 0xe8, 0x96, 0x7b, 0xa6, 0x5b,
+	0x53, 0x6e, 0xf8, 0xff, 0xe2, 0x87, 0x7c, 0x1d, 0x96, 0x63, 0x3c, 0xf7, 0xa6, 0xbb, 0xaa, 0xa2,
+	0x76, 0x55, 0x33, 0xc6, 0xf3, 0xe7, 0x19, 0xcb, 0xf9, 0x87, 0x01, 0xad, 0x6b, 0xa1, 0xf0, 0x84,
+	0xc6, 0x1c, 0x89, 0x00, 0x9b, 0xcd, 0xf8, 0xaa, 0x81, 0x3d, 0x86, 0x3c, 0x8d, 0x84, 0x0e, 0xae,
+	0xb9, 0xf7, 0x5e, 0x7e, 0x49, 0xf3, 0xec, 0xfb, 0xee, 0x15, 0x63, 0x57, 0xdb, 0xea, 0x65, 0xd3,
+	0x62, 0xf3, 0xa5, 0xed, 0xc7, 0xb0, 0xf3, 0x36, 0xc3, 0x77, 0xea, 0xfa, 0xbf, 0x18, 0xb0, 0x59,
+	0x68, 0x21, 0x1d, 0x97, 0x5a, 0xe1, 0x0b, 0x6e, 0x73, 0x03, 0xaa, 0xc8, 0x18, 0x65, 0x39, 0x94,
+	0x22, 0xe4, 0x4e, 0x9f, 0x36, 0x96, 0xaa, 0x70, 0xc3, 0x9d, 0x31, 0xc8, 0xfb, 0x70, 0x8b, 0xb2,
+	0x70, 0x1c, 0xc6, 0x7e, 0xe4, 0xa9, 0x2b, 0x9a, 0x75, 0xde, 0xea, 0xde, 0xea, 0xb4, 0x8d, 0x15,
+	0xd7, 0x5d, 0xcf, 0x55, 0xa7, 0x2c, 0xe7, 0x33, 0x58, 0xbf, 0x16, 0x23, 0xf9, 0x08, 0x88, 0x9e,
+	0x0c, 0x4d, 0x67, 0xa3, 0xa1, 0xab, 0xde, 0xbe, 0x3a, 0x1a, 0xb3, 0xbc, 0x5c, 0x4b, 0xcd, 0xc6,
+	0x8c, 0xc1, 0x9d, 0xaf, 0x4a, 0x50, 0xfd, 0x89, 0x6a, 0x07, 0x02, 0x15, 0xf9, 0x12, 0x65, 0x19,
+	0xab, 0x33, 0xf9, 0x0e, 0xac, 0xe5, 0xed, 0xe1, 0xbd, 0xf0, 0x47, 0x22, 0x4b, 0xdd, 0x70, 0x57,
+	0x73, 0xf6, 0x87, 0x8a, 0x4b, 0xee, 0x40, 0x33, 0xe5, 0xc8, 0x3c, 0x7a, 0x1e, 0x23, 0xd3, 0x43,
+	0x6a, 0xba, 0x20, 0x59, 0xcf, 0x14, 0x47, 0x36, 0xdb, 0x98, 0xd1, 0x34, 0xc9, 0x35, 0x2a, 0x4a,
+	0xa3, 0xa9, 0x78, 0x99, 0xca, 0x23, 0x58, 0x63, 0xc8, 0x69, 0xca, 0x46, 0xe8, 0x45, 0xe1, 0x24,
+	0x14, 0xf9, 0x2b, 0xd5, 0x51, 0x19, 0xa9, 0x28, 0xfb, 0x6e, 0xa6, 0x71, 0xac, 0x14, 0x74, 0xb3,
+	0xac, 0xb2, 0x4b, 0x4c, 0xb2, 0x0f, 0x2b, 0x8c, 0x46, 0xe8, 0x0d, 0xc3, 0x38, 0x08, 0xe3, 0x71,
+	0xfe, 0x2a, 0x6d, 0xce, 0x60, 0x5c, 0x1a, 0xe1, 0x81, 0x96, 0xba, 0xcb, 0x6c, 0x46, 0x70, 0x62,
+	0x43, 0xfd, 0x0c, 0x19, 0x0f, 0x69, 0x6c, 0xd7, 0xbb, 0x46, 0xaf, 0xe2, 0xe6, 0x64, 0xfb, 0x01,
+	0xdc, 0x9a, 0xe3, 0xfc, 0xa6, 0x86, 0x33, 0x8a, 0x0d, 0x17, 0x80, 0x75, 0xd5, 0x3d, 0x71, 0xa0,
+	0x22, 0x03, 0xb0, 0x8d, 0x42, 0x43, 0x4c, 0x95, 0x5c, 0x25, 0x93, 0x88, 0xb2, 0x94, 0x7a, 0xcf,
+	0x9a, 0xae, 0x26, 0xc8, 0x16, 0xd4, 0x54, 0xf9, 0xf2, 0x72, 0x67, 0x94, 0x33, 0x00, 0x53, 0x01,
+	0x1c, 0x87, 0x5c, 0x10, 0x07, 0x6a, 0x6a, 0xda, 0xf3, 0xee, 0x80, 0x82, 0x83, 0x4c, 0xe2, 0x9c,
+	0x82, 0x7d, 0x35, 0x2c, 0x9e, 0x6f, 0x9c, 0x79, 0x5d, 0x71, 0xad, 0xbe, 0xa5, 0xff, 0xba, 0xbe,
+	0xce, 0x13, 0x20, 0x7a, 0x71, 0x46, 0x85, 0xd9, 0x25, 0x3f, 0x80, 0x95, 0x91, 0xe6, 0x62, 0x30,
+	0xdb, 0x6e, 0x07, 0xd6, 0x57, 0x5f, 0xde, 0x59, 0x9e, 0x0a, 0x8e, 0x02, 0xee, 0x5e, 0xa2, 0x9c,
+	0x6f, 0x67, 0xf5, 0x3c, 0x8a, 0x5f, 0xd0, 0xb7, 0x04, 0xec, 0xf4, 0x80, 0x28, 0xbd, 0x0f, 0x30,
+	0x42, 0x81, 0x6f, 0xd3, 0xfc, 0x14, 0xcc, 0x29, 0xe2, 0xdc, 0xdc, 0x7f, 0x04, 0x6b, 0xfe, 0x48,
+	0x84, 0x67, 0xe8, 0x65, 0xfb, 0x36, 0xcf, 0x7e, 0x6d, 0x3a, 0x76, 0x28, 0x54, 0x3c, 0x2b, 0x5a,
+	0x4f, 0x73, 0xb8, 0xf3, 0x11, 0x10, 0x7d, 0x3c, 0x8c, 0x28, 0xc7, 0xff, 0xe3, 0x59, 0x74, 0x86,
+	0x00, 0x33, 0x37, 0x73, 0x83, 0xbc, 0x03, 0x4d, 0x05, 0x14, 0xc8, 0x20, 0xb9, 0x02, 0xa8, 0xba,
+	0xa0, 0x59, 0x8f, 0xe9, 0x90, 0x4b, 0x85, 0x08, 0x7d, 0x9e, 0x2b, 0x94, 0xb5, 0x82, 0x66, 0x49,
+	0x85, 0xdd, 0x6d, 0x68, 0x16, 0x9e, 0x7b, 0xb2, 0x0c, 0x0d, 0xf9, 0x79, 0xf1, 0x9c, 0x32, 0x61,
+	0x2d, 0xed, 0xfe, 0xd9, 0x00, 0x73, 0xba, 0xa0, 0xc8, 0x3a, 0xac, 0x7c, 0x1c, 0xbf, 0x8c, 0xe9,
+	0x79, 0xac, 0x19, 0xd6, 0x12, 0x59, 0x01, 0x53, 0x2f, 0x1f, 0x81, 0x81, 0x65, 0x48, 0x72, 0xfa,
+	0xb6, 0x5a, 0x25, 0x02, 0x50, 0x53, 0x35, 0x0e, 0xac, 0xb2, 0x3c, 0x1f, 0x2b, 0xaf, 0x56, 0x85,
+	0x34, 0xa1, 0xfe, 0x1c, 0x55, 0x9b, 0x58, 0x55, 0x49, 0xb8, 0x69, 0x1c, 0x4b, 0xa2, 0xa6, 0xf1,
+	0x46, 0x23, 0xc4, 0x00, 0x03, 0xab, 0x2e, 0x8d, 0x3e, 0xf4, 0xc3, 0x08, 0x03, 0xab, 0x21, 0x45,
+	0x87, 0x79, 0x4b, 0x58, 0xe6, 0xee, 0x13, 0x30, 0xa7, 0x0d, 0x28, 0xf5, 0x9e, 0x52, 0x79, 0xb2,
+	0x96, 0xe4, 0xf9, 0x93, 0x10, 0xcf, 0x91, 0x59, 0x46, 0x31, 0x3c, 0x66, 0x95, 0x64, 0x72, 0xcf,
+	0x12, 0xf9, 0x9f, 0x40, 0x99, 0x55, 0x26, 0x26, 0x54, 0x1f, 0x04, 0x93, 0x30, 0xb6, 0x2a, 0x7b,
+	0x7f, 0xa8, 0x43, 0x4d, 0x2b, 0x92, 0x4f, 0x00, 0xf4, 0x49, 0x95, 0x6f, 0x73, 0xee, 0xd7, 0x47,
+	0x7b, 0x6b, 0xfe, 0xe6, 0x75, 0x6e, 0xff, 0xee, 0xef, 0xff, 0xfe, 0x53, 0xe9, 0x96, 0xb3, 0x2a,
+	0xff, 0x49, 0x4e, 0xe9, 0x30, 0xfb, 0xb5, 0xd9, 0x37, 0x76, 0xc9, 0xcf, 0x00, 0x74, 0xf8, 0x97,
+	0x71, 0x2f, 0x7d, 0x5b, 0xb4, 0x5b, 0x8a, 0x7d, 0x7d, 0x6c, 0x72, 0xe0, 0x7d, 0x63, 0x77, 0x86,
+	0xad, 0x07, 0x84, 0xc4, 0x60, 0x15, 0x5f, 0x5d, 0x05, 0xbf, 0x3d, 0xff, 0x3d, 0xd6, 0x4e, 0x76,
+	0xde, 0xf6, 0x58, 0x3b, 0x77, 0x94, 0xa7, 0xdb, 0xce, 0x46, 0xee, 0xa6, 0xf0, 0x3e, 0xa3, 0x4c,
+	0xe4, 0xe7, 0xd0, 0x54, 0x8d, 0xad, 0x3b, 0x93, 0xb4, 0x0a, 0xd3, 0x50, 0x6c, 0xf8, 0xf6, 0x56,
+	0x5f, 0xff, 0x83, 0xf5, 0xf3, 0x9f, 0xab, 0xfe, 0x43, 0xf9, 0x13, 0xe7, 0xec, 0x28, 0x07, 0x5b,
+	0x32, 0x95, 0xf5, 0xcc, 0xc7, 0x5d, 0x8e, 0x62, 0x30, 0x92, 0xc6, 0xe4, 0x29, 0x34, 0x0f, 0x19,
+	0xfa, 0x02, 0xf5, 0x53, 0x55, 0x58, 0x62, 0x0b, 0x01, 0xb7, 0x15, 0xe0, 0x66, 0xdb, 0x92, 0x68,
+	0xaa, 0xf5, 0x07, 0xbf, 0x96, 0xc3, 0xf1, 0x1b, 0x19, 0xed, 0x01, 0x34, 0x3f, 0x4e, 0x82, 0xb9,
+	0x78, 0x85, 0x73, 0x8e, 0xb1, 0x37, 0x17, 0xe3, 0x11, 0x98, 0x8f, 0x50, 0x28, 0x45, 0x4e, 0x16,
+	0x44, 0xd1, 0x2e, 0xec, 0x73, 0xb9, 0x8e, 0x1d, 0xa2, 0x10, 0x97, 0x09, 0x4c, 0x11, 0x39, 0xf9,
+	0x25, 0x6c, 0x9c, 0x64, 0x40, 0xc5, 0x0d, 0x4c, 0xbe, 0x36, 0x77, 0x9f, 0xf2, 0x9b, 0x2a, 0xf9,
+	0x5d, 0xe5, 0xe2, 0x1b, 0xed, 0xce, 0xd5, 0xa0, 0x07, 0x72, 0x11, 0xdf, 0xcd, 0x97, 0xb6, 0x4c,
+	0xe1, 0x53, 0x68, 0xea, 0x95, 0xa8, 0xcb, 0xd0, 0x9a, 0x39, 0xbc, 0xb4, 0x29, 0x17, 0xba, 0xb2,
+	0x95, 0x2b, 0xb2, 0x7b, 0xad, 0x3e, 0xe4, 0x19, 0x2c, 0xe7, 0xc5, 0x51, 0x5b, 0xaa, 0xf0, 0x36,
+	0x14, 0x96, 0x75, 0x7b, 0xf5, 0x32, 0x3b, 0x07, 0x24, 0xd7, 0x00, 0x0f, 0xba, 0x5f, 0xfc, 0xab,
+	0xb3, 0xf4, 0xdb, 0xd7, 0x1d, 0xe3, 0xaf, 0xaf, 0x3b, 0xc6, 0xe7, 0xaf, 0x3b, 0xc6, 0x3f, 0x5f,
+	0x77, 0x8c, 0x3f, 0xbe, 0xe9, 0x2c, 0x7d, 0xfe, 0xa6, 0xb3, 0xf4, 0xc5, 0x9b, 0xce, 0xd2, 0xb0,
+	0xa6, 0x82, 0xfb, 0xfe, 0x7f, 0x06, 0x00, 0x55, 0xd3, 0x3f, 0x1d, 0x41, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -983,7 +1363,12 @@ type SubmitClient interface {
 	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
 	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
 	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
+	// Closed job sets do not accept new jobs, they are finalized once all their jobs finish
+	CloseJobSet(ctx context.Context, in *JobSetCloseRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*Queue, error)
+	GetQueues(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*QueueList, error)
+	SetQueueRoleBindings(ctx context.Context, in *QueueRoleBindingsRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
 }
@@ -1023,6 +1408,15 @@ func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritize
 	return out, nil
 }
 
+func (c *submitClient) CloseJobSet(ctx context.Context, in *JobSetCloseRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CloseJobSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
@@ -1032,6 +1426,33 @@ func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.
 	return out, nil
 }
 
+func (c *submitClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*Queue, error) {
+	out := new(Queue)
+	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetQueues(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*QueueList, error) {
+	out := new(QueueList)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) SetQueueRoleBindings(ctx context.Context, in *QueueRoleBindingsRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/SetQueueRoleBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
@@ -1055,7 +1476,12 @@ type SubmitServer interface {
 	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
 	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
 	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
+	// Closed job sets do not accept new jobs, they are finalized once all their jobs finish
+	CloseJobSet(context.Context, *JobSetCloseRequest) (*types.Empty, error)
 	CreateQueue(context.Context, *Queue) (*types.Empty, error)
+	UpdateQueue(context.Context, *Queue) (*Queue, error)
+	GetQueues(context.Context, *types.Empty) (*QueueList, error)
+	SetQueueRoleBindings(context.Context, *QueueRoleBindingsRequest) (*types.Empty, error)
 	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
 	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
 }
@@ -1073,9 +1499,21 @@ func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancel
 func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
 }
+func (*UnimplementedSubmitServer) CloseJobSet(ctx context.Context, req *JobSetCloseRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseJobSet not implemented")
+}
 func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
 }
+func (*UnimplementedSubmitServer) UpdateQueue(ctx context.Context, req *Queue) (*Queue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueues(ctx context.Context, req *types.Empty) (*QueueList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueues not implemented")
+}
+func (*UnimplementedSubmitServer) SetQueueRoleBindings(ctx context.Context, req *QueueRoleBindingsRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueueRoleBindings not implemented")
+}
 func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
 }
@@ -1141,6 +1579,24 @@ func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Submit_CloseJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CloseJobSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CloseJobSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CloseJobSet(ctx, req.(*JobSetCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Queue)
 	if err := dec(in); err != nil {
@@ -1159,62 +1615,132 @@ func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueDeleteRequest)
+func _Submit_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).DeleteQueue(ctx, in)
+		return srv.(SubmitServer).UpdateQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/DeleteQueue",
+		FullMethod: "/api.Submit/UpdateQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+		return srv.(SubmitServer).UpdateQueue(ctx, req.(*Queue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueInfoRequest)
+func _Submit_GetQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).GetQueueInfo(ctx, in)
+		return srv.(SubmitServer).GetQueues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/GetQueueInfo",
+		FullMethod: "/api.Submit/GetQueues",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+		return srv.(SubmitServer).GetQueues(ctx, req.(*types.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Submit_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Submit",
-	HandlerType: (*SubmitServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitJobs",
-			Handler:    _Submit_SubmitJobs_Handler,
-		},
-		{
-			MethodName: "CancelJobs",
-			Handler:    _Submit_CancelJobs_Handler,
-		},
-		{
-			MethodName: "ReprioritizeJobs",
-			Handler:    _Submit_ReprioritizeJobs_Handler,
-		},
+func _Submit_SetQueueRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).SetQueueRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SetQueueRoleBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SetQueueRoleBindings(ctx, req.(*QueueRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).DeleteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/DeleteQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueueInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueueInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Submit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Submit",
+	HandlerType: (*SubmitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitJobs",
+			Handler:    _Submit_SubmitJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobs",
+			Handler:    _Submit_CancelJobs_Handler,
+		},
+		{
+			MethodName: "ReprioritizeJobs",
+			Handler:    _Submit_ReprioritizeJobs_Handler,
+		},
+		{
+			MethodName: "CloseJobSet",
+			Handler:    _Submit_CloseJobSet_Handler,
+		},
 		{
 			MethodName: "CreateQueue",
 			Handler:    _Submit_CreateQueue_Handler,
 		},
+		{
+			MethodName: "UpdateQueue",
+			Handler:    _Submit_UpdateQueue_Handler,
+		},
+		{
+			MethodName: "GetQueues",
+			Handler:    _Submit_GetQueues_Handler,
+		},
+		{
+			MethodName: "SetQueueRoleBindings",
+			Handler:    _Submit_SetQueueRoleBindings_Handler,
+		},
 		{
 			MethodName: "DeleteQueue",
 			Handler:    _Submit_DeleteQueue_Handler,
@@ -1434,6 +1960,26 @@ func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReturnDuplicateStatus {
+		i--
+		if m.ReturnDuplicateStatus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.JobRequestItems) > 0 {
 		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1623,6 +2169,21 @@ func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OriginalJobStatus != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.OriginalJobStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Duplicate {
+		i--
+		if m.Duplicate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -1697,6 +2258,25 @@ func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RoleBindings) > 0 {
+		for iNdEx := len(m.RoleBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ResourceLimits) > 0 {
 		for k := range m.ResourceLimits {
 			v := m.ResourceLimits[k]
@@ -1749,6 +2329,133 @@ func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueueRoleBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueRoleBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueRoleBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Users[iNdEx])
+			copy(dAtA[i:], m.Users[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Users[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Role != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueRoleBindingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueRoleBindingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueRoleBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoleBindings) > 0 {
+		for iNdEx := len(m.RoleBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1885,6 +2592,43 @@ func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobSetCloseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetCloseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetCloseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2035,6 +2779,12 @@ func (m *JobSubmitRequest) Size() (n int) {
 			n += 1 + l + sovSubmit(uint64(l))
 		}
 	}
+	if m.ReturnDuplicateStatus {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
 	return n
 }
 
@@ -2116,6 +2866,12 @@ func (m *JobSubmitResponseItem) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSubmit(uint64(l))
 	}
+	if m.Duplicate {
+		n += 2
+	}
+	if m.OriginalJobStatus != 0 {
+		n += 1 + sovSubmit(uint64(m.OriginalJobStatus))
+	}
 	return n
 }
 
@@ -2167,17 +2923,35 @@ func (m *Queue) Size() (n int) {
 			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
 		}
 	}
+	if len(m.RoleBindings) > 0 {
+		for _, e := range m.RoleBindings {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovSubmit(uint64(m.Version))
+	}
 	return n
 }
 
-func (m *CancellationResult) Size() (n int) {
+func (m *QueueRoleBinding) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.CancelledIds) > 0 {
-		for _, s := range m.CancelledIds {
+	if m.Role != 0 {
+		n += 1 + sovSubmit(uint64(m.Role))
+	}
+	if len(m.Users) > 0 {
+		for _, s := range m.Users {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
 			l = len(s)
 			n += 1 + l + sovSubmit(uint64(l))
 		}
@@ -2185,15 +2959,64 @@ func (m *CancellationResult) Size() (n int) {
 	return n
 }
 
-func (m *QueueInfoRequest) Size() (n int) {
+func (m *QueueList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueRoleBindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.RoleBindings) > 0 {
+		for _, e := range m.RoleBindings {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancellationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for _, s := range m.CancelledIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
 	return n
 }
@@ -2230,6 +3053,23 @@ func (m *QueueInfo) Size() (n int) {
 	return n
 }
 
+func (m *JobSetCloseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
 func (m *JobSetInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2337,6 +3177,8 @@ func (this *JobSubmitRequest) String() string {
 		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
 		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
 		`JobRequestItems:` + repeatedStringForJobRequestItems + `,`,
+		`ReturnDuplicateStatus:` + fmt.Sprintf("%v", this.ReturnDuplicateStatus) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2393,6 +3235,8 @@ func (this *JobSubmitResponseItem) String() string {
 	s := strings.Join([]string{`&JobSubmitResponseItem{`,
 		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
 		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Duplicate:` + fmt.Sprintf("%v", this.Duplicate) + `,`,
+		`OriginalJobStatus:` + fmt.Sprintf("%v", this.OriginalJobStatus) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2416,6 +3260,11 @@ func (this *Queue) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRoleBindings := "[]*QueueRoleBinding{"
+	for _, f := range this.RoleBindings {
+		repeatedStringForRoleBindings += strings.Replace(f.String(), "QueueRoleBinding", "QueueRoleBinding", 1) + ","
+	}
+	repeatedStringForRoleBindings += "}"
 	keysForResourceLimits := make([]string, 0, len(this.ResourceLimits))
 	for k, _ := range this.ResourceLimits {
 		keysForResourceLimits = append(keysForResourceLimits, k)
@@ -2432,6 +3281,51 @@ func (this *Queue) String() string {
 		`UserOwners:` + fmt.Sprintf("%v", this.UserOwners) + `,`,
 		`GroupOwners:` + fmt.Sprintf("%v", this.GroupOwners) + `,`,
 		`ResourceLimits:` + mapStringForResourceLimits + `,`,
+		`RoleBindings:` + repeatedStringForRoleBindings + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueRoleBinding) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueRoleBinding{`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Users:` + fmt.Sprintf("%v", this.Users) + `,`,
+		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*Queue{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(f.String(), "Queue", "Queue", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&QueueList{`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueRoleBindingsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRoleBindings := "[]*QueueRoleBinding{"
+	for _, f := range this.RoleBindings {
+		repeatedStringForRoleBindings += strings.Replace(f.String(), "QueueRoleBinding", "QueueRoleBinding", 1) + ","
+	}
+	repeatedStringForRoleBindings += "}"
+	s := strings.Join([]string{`&QueueRoleBindingsRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`RoleBindings:` + repeatedStringForRoleBindings + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2482,6 +3376,17 @@ func (this *QueueInfo) String() string {
 	}, "")
 	return s
 }
+func (this *JobSetCloseRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetCloseRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *JobSetInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -3384,6 +4289,46 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnDuplicateStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReturnDuplicateStatus = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -3978,6 +4923,45 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duplicate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Duplicate = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalJobStatus", wireType)
+			}
+			m.OriginalJobStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginalJobStatus |= JobStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -4325,61 +5309,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			}
 			m.ResourceLimits[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CancellationResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleBindings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -4389,24 +5323,45 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			m.RoleBindings = append(m.RoleBindings, &QueueRoleBinding{})
+			if err := m.RoleBindings[len(m.RoleBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -4428,7 +5383,7 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueueRoleBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4451,17 +5406,17 @@ func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueRoleBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueRoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -4471,16 +5426,431 @@ func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Role |= QueueRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
 			}
-			postIndex := iNdEx + intStringLen
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &Queue{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueRoleBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueRoleBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueRoleBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleBindings = append(m.RoleBindings, &QueueRoleBinding{})
+			if err := m.RoleBindings[len(m.RoleBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
@@ -4708,6 +6078,120 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *JobSetCloseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetCloseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetCloseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,124 @@
+// Package armada is a client of Armada api for Go services.
+// It submits jobs in chunks, returns handles to wait for, cancel and inspect submitted jobs,
+// resumes broken event streams and reports failures as typed errors instead of logging them.
+package armada
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/binoculars"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+const (
+	defaultReconnectDelay = 5 * time.Second
+	clusterIdPlaceholder  = "{CLUSTER_ID}"
+)
+
+type Options struct {
+	// Url and authentication of Armada server, unary calls are retried by the connection
+	ApiConnection client.ApiConnectionDetails
+	// Url of binoculars used to read logs of jobs, {CLUSTER_ID} gets replaced by id of the cluster running the job.
+	// Binoculars use the authentication of Armada server, logs are not available when the pattern is empty.
+	BinocularsUrlPattern string
+	// Maximum number of jobs in single submit request, client.MaxJobsPerRequest is used when not set
+	MaxJobsPerRequest int
+	// Delay before broken event stream is reopened, 5 seconds when not set
+	ReconnectDelay time.Duration
+	DialOptions    []grpc.DialOption
+}
+
+type Client struct {
+	options          Options
+	connection       *grpc.ClientConn
+	submitClient     api.SubmitClient
+	eventClient      api.EventClient
+	schedulingClient api.SchedulingClient
+
+	binocularsMutex       sync.Mutex
+	binocularsConnections map[string]*grpc.ClientConn
+}
+
+// NewClient connects to Armada server, the client has to be closed once it is not needed
+func NewClient(options Options) (*Client, error) {
+	connection, e := client.CreateApiConnection(&options.ApiConnection, options.DialOptions...)
+	if e != nil {
+		return nil, e
+	}
+	c := newClient(options, api.NewSubmitClient(connection), api.NewEventClient(connection), api.NewSchedulingClient(connection))
+	c.connection = connection
+	return c, nil
+}
+
+func newClient(options Options, submitClient api.SubmitClient, eventClient api.EventClient, schedulingClient api.SchedulingClient) *Client {
+	if options.MaxJobsPerRequest <= 0 {
+		options.MaxJobsPerRequest = client.MaxJobsPerRequest
+	}
+	if options.ReconnectDelay <= 0 {
+		options.ReconnectDelay = defaultReconnectDelay
+	}
+	return &Client{
+		options:               options,
+		submitClient:          submitClient,
+		eventClient:           eventClient,
+		schedulingClient:      schedulingClient,
+		binocularsConnections: map[string]*grpc.ClientConn{},
+	}
+}
+
+// Close closes connections to Armada server and binoculars
+func (c *Client) Close() error {
+	c.binocularsMutex.Lock()
+	defer c.binocularsMutex.Unlock()
+
+	var result error
+	for clusterId, connection := range c.binocularsConnections {
+		if e := connection.Close(); e != nil && result == nil {
+			result = e
+		}
+		delete(c.binocularsConnections, clusterId)
+	}
+	if c.connection != nil {
+		if e := c.connection.Close(); e != nil && result == nil {
+			result = e
+		}
+	}
+	return result
+}
+
+// JobSet returns handle of the job set, the job set is created by the first submission
+func (c *Client) JobSet(queue string, jobSetId string) *JobSet {
+	return &JobSet{client: c, Queue: queue, JobSetId: jobSetId}
+}
+
+// Job returns handle of a job submitted earlier, e.g. by another process
+func (c *Client) Job(queue string, jobSetId string, jobId string) *JobHandle {
+	return &JobHandle{client: c, Queue: queue, JobSetId: jobSetId, JobId: jobId}
+}
+
+func (c *Client) binocularsClient(clusterId string) (binoculars.BinocularsClient, error) {
+	if c.options.BinocularsUrlPattern == "" {
+		return nil, ErrLogsNotConfigured
+	}
+
+	c.binocularsMutex.Lock()
+	defer c.binocularsMutex.Unlock()
+
+	connection, exists := c.binocularsConnections[clusterId]
+	if !exists {
+		connectionDetails := c.options.ApiConnection
+		connectionDetails.ArmadaUrl = strings.Replace(c.options.BinocularsUrlPattern, clusterIdPlaceholder, clusterId, -1)
+		var e error
+		connection, e = client.CreateApiConnection(&connectionDetails, c.options.DialOptions...)
+		if e != nil {
+			return nil, e
+		}
+		c.binocularsConnections[clusterId] = connection
+	}
+	return binoculars.NewBinocularsClient(connection), nil
+}
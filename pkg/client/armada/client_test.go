@@ -0,0 +1,218 @@
+package armada
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client/domain"
+)
+
+type fakeSubmitClient struct {
+	api.SubmitClient
+	requests []*api.JobSubmitRequest
+	rejected map[string]string
+	// errors returned by requests by their index
+	requestErrors map[int]error
+}
+
+func (c *fakeSubmitClient) SubmitJobs(ctx context.Context, in *api.JobSubmitRequest, opts ...grpc.CallOption) (*api.JobSubmitResponse, error) {
+	c.requests = append(c.requests, in)
+	if e := c.requestErrors[len(c.requests)-1]; e != nil {
+		return nil, e
+	}
+	response := &api.JobSubmitResponse{}
+	for _, item := range in.JobRequestItems {
+		response.JobResponseItems = append(response.JobResponseItems, &api.JobSubmitResponseItem{
+			JobId: "job-" + item.ClientId,
+			Error: c.rejected[item.ClientId],
+		})
+	}
+	return response, nil
+}
+
+type fakeEventClient struct {
+	api.EventClient
+	snapshot *api.JobSetState
+	// each call of GetJobSetEvents streams next list of messages followed by the error, nil error ends the stream
+	streams       [][]*api.EventStreamMessage
+	streamErrors  []error
+	fromMessageId []string
+}
+
+func (c *fakeEventClient) GetJobSetState(ctx context.Context, in *api.JobSetStateRequest, opts ...grpc.CallOption) (*api.JobSetState, error) {
+	if c.snapshot == nil {
+		return nil, status.Error(codes.Unimplemented, "snapshots are disabled")
+	}
+	return c.snapshot, nil
+}
+
+func (c *fakeEventClient) GetJobSetEvents(ctx context.Context, in *api.JobSetRequest, opts ...grpc.CallOption) (api.Event_GetJobSetEventsClient, error) {
+	call := len(c.fromMessageId)
+	c.fromMessageId = append(c.fromMessageId, in.FromMessageId)
+	if call >= len(c.streams) {
+		return nil, status.Error(codes.Internal, "unexpected call")
+	}
+	return &fakeEventStream{messages: c.streams[call], err: c.streamErrors[call]}, nil
+}
+
+type fakeEventStream struct {
+	grpc.ClientStream
+	messages []*api.EventStreamMessage
+	err      error
+}
+
+func (s *fakeEventStream) Recv() (*api.EventStreamMessage, error) {
+	if len(s.messages) == 0 {
+		if s.err == nil {
+			return nil, io.EOF
+		}
+		return nil, s.err
+	}
+	message := s.messages[0]
+	s.messages = s.messages[1:]
+	return message, nil
+}
+
+func TestJobSet_Submit_ChunksJobsAndReportsRejectedJobs(t *testing.T) {
+	submitClient := &fakeSubmitClient{rejected: map[string]string{"c3": "invalid pod spec"}}
+	client := newClient(Options{MaxJobsPerRequest: 2}, submitClient, &fakeEventClient{}, nil)
+
+	jobs := []*api.JobSubmitRequestItem{}
+	for i := 0; i < 5; i++ {
+		jobs = append(jobs, &api.JobSubmitRequestItem{ClientId: fmt.Sprintf("c%d", i)})
+	}
+	jobs[4].ClientId = ""
+
+	handles, e := client.JobSet("queue", "set").Submit(context.Background(), jobs)
+
+	assert.Len(t, submitClient.requests, 3)
+	assert.Len(t, submitClient.requests[2].JobRequestItems, 1)
+	assert.NotEmpty(t, jobs[4].ClientId)
+	require.Len(t, handles, 4)
+	assert.Equal(t, "job-c0", handles[0].JobId)
+	assert.Equal(t, "job-"+jobs[4].ClientId, handles[3].JobId)
+	assert.Equal(t, "set", handles[3].JobSetId)
+
+	submitError, ok := e.(*SubmitError)
+	require.True(t, ok)
+	assert.Equal(t, []*JobSubmitFailure{{Index: 3, ClientId: "c3", Reason: "invalid pod spec"}}, submitError.Failures)
+	assert.Nil(t, submitError.Err)
+}
+
+func TestJobSet_Submit_ReportsRejectedJobsOfEarlierRequestsWhenRequestFails(t *testing.T) {
+	submitClient := &fakeSubmitClient{
+		rejected:      map[string]string{"c1": "invalid pod spec"},
+		requestErrors: map[int]error{1: status.Error(codes.PermissionDenied, "queue is not yours")},
+	}
+	client := newClient(Options{MaxJobsPerRequest: 2}, submitClient, &fakeEventClient{}, nil)
+
+	jobs := []*api.JobSubmitRequestItem{}
+	for i := 0; i < 4; i++ {
+		jobs = append(jobs, &api.JobSubmitRequestItem{ClientId: fmt.Sprintf("c%d", i)})
+	}
+
+	handles, e := client.JobSet("queue", "set").Submit(context.Background(), jobs)
+
+	require.Len(t, handles, 1)
+	assert.Equal(t, "job-c0", handles[0].JobId)
+	submitError, ok := e.(*SubmitError)
+	require.True(t, ok)
+	assert.Equal(t, []*JobSubmitFailure{{Index: 1, ClientId: "c1", Reason: "invalid pod spec"}}, submitError.Failures)
+	assert.Equal(t, &ApiError{Method: "SubmitJobs", Code: codes.PermissionDenied, Message: "queue is not yours"}, submitError.Err)
+	assert.True(t, IsPermissionDenied(e))
+	assert.False(t, IsNotFound(e))
+}
+
+func TestJobHandle_Wait_ResumesBrokenStream(t *testing.T) {
+	eventClient := &fakeEventClient{
+		streams: [][]*api.EventStreamMessage{
+			{
+				message("1", &api.JobQueuedEvent{JobId: "job-1"}),
+				message("2", &api.JobLeasedEvent{JobId: "job-1", ClusterId: "cluster-1"}),
+			},
+			{
+				message("3", &api.JobFailedEvent{JobId: "job-2", Reason: "other job"}),
+				message("4", &api.JobFailedEvent{JobId: "job-1", ClusterId: "cluster-1", Reason: "OOMKilled"}),
+			},
+		},
+		streamErrors: []error{status.Error(codes.Unavailable, "transport is closing"), nil},
+	}
+	client := newClient(Options{ReconnectDelay: time.Millisecond}, &fakeSubmitClient{}, eventClient, nil)
+
+	e := client.Job("queue", "set", "job-1").Wait(context.Background())
+
+	assert.Equal(t, []string{"", "2"}, eventClient.fromMessageId)
+	assert.Equal(t, &JobFailedError{JobId: "job-1", ClusterId: "cluster-1", Reason: "OOMKilled"}, e)
+}
+
+func TestJobHandle_Wait_ReturnsApiError(t *testing.T) {
+	eventClient := &fakeEventClient{
+		streams:      [][]*api.EventStreamMessage{{}},
+		streamErrors: []error{status.Error(codes.PermissionDenied, "no permission")},
+	}
+	client := newClient(Options{}, &fakeSubmitClient{}, eventClient, nil)
+
+	e := client.Job("queue", "set", "job-1").Wait(context.Background())
+
+	assert.True(t, IsPermissionDenied(e))
+	assert.Equal(t, &ApiError{Method: "GetJobSetEvents", Code: codes.PermissionDenied, Message: "no permission"}, e)
+}
+
+func TestJobHandle_Wait_FinishedJobFromSnapshot(t *testing.T) {
+	eventClient := &fakeEventClient{snapshot: &api.JobSetState{
+		Jobs: []*api.JobState{{JobId: "job-1", Status: api.JobStatus_Cancelled}},
+	}}
+	client := newClient(Options{}, &fakeSubmitClient{}, eventClient, nil)
+
+	e := client.Job("queue", "set", "job-1").Wait(context.Background())
+
+	assert.Equal(t, &JobCancelledError{JobId: "job-1"}, e)
+	assert.Empty(t, eventClient.fromMessageId)
+}
+
+func TestJobHandle_Status(t *testing.T) {
+	eventClient := &fakeEventClient{snapshot: &api.JobSetState{
+		Jobs: []*api.JobState{{JobId: "job-1", Status: api.JobStatus_Running, ClusterId: "cluster-1"}},
+	}}
+	client := newClient(Options{}, &fakeSubmitClient{}, eventClient, nil)
+
+	info, e := client.Job("queue", "set", "job-1").Status(context.Background())
+	assert.Nil(t, e)
+	assert.Equal(t, domain.JobStatus(domain.Running), info.Status)
+	assert.Equal(t, "cluster-1", info.ClusterId)
+
+	eventClient.streams = [][]*api.EventStreamMessage{{}}
+	eventClient.streamErrors = []error{nil}
+	_, e = client.Job("queue", "set", "job-2").Status(context.Background())
+	assert.True(t, IsNotFound(e))
+}
+
+func TestJobHandle_Logs(t *testing.T) {
+	eventClient := &fakeEventClient{
+		streams:      [][]*api.EventStreamMessage{{message("1", &api.JobQueuedEvent{JobId: "job-1"})}, {}},
+		streamErrors: []error{nil, nil},
+	}
+	client := newClient(Options{}, &fakeSubmitClient{}, eventClient, nil)
+
+	_, e := client.Job("queue", "set", "job-1").Logs(context.Background(), 0, nil)
+	assert.Equal(t, &JobNotStartedError{JobId: "job-1", Status: domain.Queued}, e)
+
+	eventClient.streams[1] = []*api.EventStreamMessage{message("1", &api.JobLeasedEvent{JobId: "job-1", ClusterId: "cluster-1"})}
+	_, e = client.Job("queue", "set", "job-1").Logs(context.Background(), 0, nil)
+	assert.Equal(t, ErrLogsNotConfigured, e)
+}
+
+func message(id string, event api.Event) *api.EventStreamMessage {
+	wrapped, _ := api.Wrap(event)
+	return &api.EventStreamMessage{Id: id, Message: wrapped}
+}
@@ -0,0 +1,111 @@
+package armada
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrLogsNotConfigured is returned by JobHandle.Logs when the client has no BinocularsUrlPattern
+var ErrLogsNotConfigured = errors.New("binoculars url pattern is not configured, logs are not available")
+
+// ApiError is an error returned by Armada server
+type ApiError struct {
+	// Grpc method which failed, e.g. "SubmitJobs"
+	Method  string
+	Code    codes.Code
+	Message string
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("%s failed: %s: %s", e.Method, e.Code, e.Message)
+}
+
+// IsNotFound returns true for api errors caused by missing queue, job set or job
+func IsNotFound(err error) bool {
+	var apiError *ApiError
+	return errors.As(err, &apiError) && apiError.Code == codes.NotFound
+}
+
+// IsPermissionDenied returns true for api errors caused by missing permission or failed authentication
+func IsPermissionDenied(err error) bool {
+	var apiError *ApiError
+	return errors.As(err, &apiError) && (apiError.Code == codes.PermissionDenied || apiError.Code == codes.Unauthenticated)
+}
+
+func newApiError(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return &ApiError{Method: method, Code: s.Code(), Message: s.Message()}
+}
+
+// JobSubmitFailure describes a job rejected by the server
+type JobSubmitFailure struct {
+	// Index of the job in the submitted list
+	Index    int
+	ClientId string
+	Reason   string
+}
+
+// SubmitError is returned by Submit when some of the jobs were rejected, handles of accepted jobs are returned with it
+type SubmitError struct {
+	Failures []*JobSubmitFailure
+	// Error of the request which stopped the submission of remaining jobs, nil when all requests succeeded
+	Err error
+}
+
+func (e *SubmitError) Error() string {
+	reasons := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		reasons = append(reasons, fmt.Sprintf("job %d: %s", failure.Index, failure.Reason))
+	}
+	message := fmt.Sprintf("%d jobs rejected: %s", len(e.Failures), strings.Join(reasons, "; "))
+	if e.Err != nil {
+		message = fmt.Sprintf("%s; %v", message, e.Err)
+	}
+	return message
+}
+
+func (e *SubmitError) Unwrap() error {
+	return e.Err
+}
+
+// JobFailedError is returned by JobHandle.Wait when the job failed
+type JobFailedError struct {
+	JobId     string
+	ClusterId string
+	// Index of the failed pod of multi node job
+	PodNumber int32
+	Reason    string
+}
+
+func (e *JobFailedError) Error() string {
+	return fmt.Sprintf("job %s failed: %s", e.JobId, e.Reason)
+}
+
+// JobCancelledError is returned by JobHandle.Wait when the job was cancelled
+type JobCancelledError struct {
+	JobId string
+}
+
+func (e *JobCancelledError) Error() string {
+	return fmt.Sprintf("job %s was cancelled", e.JobId)
+}
+
+// JobNotStartedError is returned by JobHandle.Logs when the job was not leased to any cluster yet
+type JobNotStartedError struct {
+	JobId  string
+	Status string
+}
+
+func (e *JobNotStartedError) Error() string {
+	return fmt.Sprintf("job %s has not started, its status is %s", e.JobId, e.Status)
+}
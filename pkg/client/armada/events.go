@@ -0,0 +1,86 @@
+package armada
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// watchJobSet streams events of the job set reported after fromMessageId, all events are streamed when it is empty.
+// Broken streams are reopened from the last received message, so no event is lost or repeated.
+// Without watch it returns once all stored events were streamed, otherwise when onEvent returns true.
+// Returns id of the last received message, which can be used to resume watching later.
+func (c *Client) watchJobSet(
+	ctx context.Context,
+	queue string,
+	jobSetId string,
+	fromMessageId string,
+	watch bool,
+	onEvent func(api.Event) bool) (string, error) {
+
+	lastMessageId := fromMessageId
+	for {
+		stream, e := c.eventClient.GetJobSetEvents(ctx, &api.JobSetRequest{
+			Queue:         queue,
+			Id:            jobSetId,
+			FromMessageId: lastMessageId,
+			Watch:         watch,
+		})
+		if e != nil {
+			if e := c.waitBeforeReconnect(ctx, e); e != nil {
+				return lastMessageId, e
+			}
+			continue
+		}
+
+		for {
+			message, e := stream.Recv()
+			if e == io.EOF {
+				return lastMessageId, nil
+			}
+			if e != nil {
+				if e := c.waitBeforeReconnect(ctx, e); e != nil {
+					return lastMessageId, e
+				}
+				break
+			}
+			lastMessageId = message.Id
+
+			event, e := api.UnwrapEvent(message.Message)
+			if e != nil {
+				// event types added to the server after this client was built are skipped
+				continue
+			}
+			if onEvent(event) {
+				return lastMessageId, nil
+			}
+		}
+	}
+}
+
+// waitBeforeReconnect returns nil after the reconnect delay when the stream broke because of transient failure,
+// otherwise it returns the error which ended the stream
+func (c *Client) waitBeforeReconnect(ctx context.Context, streamError error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	switch status.Code(streamError) {
+	case codes.Unavailable, codes.Aborted:
+	default:
+		return newApiError("GetJobSetEvents", streamError)
+	}
+
+	timer := time.NewTimer(c.options.ReconnectDelay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
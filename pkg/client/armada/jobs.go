@@ -0,0 +1,275 @@
+package armada
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/binoculars"
+	"github.com/G-Research/armada/pkg/client"
+	"github.com/G-Research/armada/pkg/client/domain"
+)
+
+// JobSet is a handle of a job set, it is used to submit jobs into the job set
+type JobSet struct {
+	client   *Client
+	Queue    string
+	JobSetId string
+}
+
+// Submit submits jobs in requests of at most MaxJobsPerRequest jobs. Jobs without client id get a generated one,
+// so requests retried by the connection don't create duplicate jobs.
+// Handles of accepted jobs are returned in the order of submission. When some jobs are rejected, SubmitError is
+// returned with the handles, when a request fails, handles of jobs submitted by earlier requests are returned with ApiError,
+// wrapped in SubmitError when earlier requests had rejected jobs.
+func (s *JobSet) Submit(ctx context.Context, jobs []*api.JobSubmitRequestItem) ([]*JobHandle, error) {
+	client.AddClientIds(jobs)
+
+	handles := make([]*JobHandle, 0, len(jobs))
+	failures := []*JobSubmitFailure{}
+	for start := 0; start < len(jobs); start += s.client.options.MaxJobsPerRequest {
+		end := start + s.client.options.MaxJobsPerRequest
+		if end > len(jobs) {
+			end = len(jobs)
+		}
+		response, e := s.client.submitClient.SubmitJobs(ctx, &api.JobSubmitRequest{
+			Queue:           s.Queue,
+			JobSetId:        s.JobSetId,
+			JobRequestItems: jobs[start:end],
+		})
+		if e != nil {
+			e = newApiError("SubmitJobs", e)
+			if len(failures) > 0 {
+				return handles, &SubmitError{Failures: failures, Err: e}
+			}
+			return handles, e
+		}
+
+		for i, item := range response.JobResponseItems {
+			job := jobs[start+i]
+			if item.Error != "" {
+				failures = append(failures, &JobSubmitFailure{Index: start + i, ClientId: job.ClientId, Reason: item.Error})
+				continue
+			}
+			handles = append(handles, &JobHandle{
+				client:    s.client,
+				Queue:     s.Queue,
+				JobSetId:  s.JobSetId,
+				JobId:     item.JobId,
+				ClientId:  job.ClientId,
+				Duplicate: item.Duplicate,
+			})
+		}
+	}
+
+	if len(failures) > 0 {
+		return handles, &SubmitError{Failures: failures}
+	}
+	return handles, nil
+}
+
+// Cancel cancels all jobs of the job set
+func (s *JobSet) Cancel(ctx context.Context) ([]string, error) {
+	result, e := s.client.submitClient.CancelJobs(ctx, &api.JobCancelRequest{Queue: s.Queue, JobSetId: s.JobSetId})
+	if e != nil {
+		return nil, newApiError("CancelJobs", e)
+	}
+	return result.CancelledIds, nil
+}
+
+// Close rejects further submissions into the job set, the job set completes once all its jobs finish
+func (s *JobSet) Close(ctx context.Context) error {
+	_, e := s.client.submitClient.CloseJobSet(ctx, &api.JobSetCloseRequest{Queue: s.Queue, JobSetId: s.JobSetId})
+	return newApiError("CloseJobSet", e)
+}
+
+// JobHandle is a handle of a submitted job
+type JobHandle struct {
+	client   *Client
+	Queue    string
+	JobSetId string
+	JobId    string
+	// Client id of the submitted job, it is empty for handles of jobs submitted by others
+	ClientId string
+	// The job was submitted earlier with the same client id, JobId is id of the original job
+	Duplicate bool
+}
+
+// Status returns current status of the job. It is read from the job set snapshot when the server maintains
+// snapshots, otherwise all events of the job set are replayed.
+func (h *JobHandle) Status(ctx context.Context) (*domain.JobInfo, error) {
+	state, _, e := h.snapshot(ctx)
+	if e != nil {
+		return nil, e
+	}
+	if state != nil {
+		if info := state.GetJobInfo(h.JobId); info != nil {
+			return info, nil
+		}
+	}
+	return h.replay(ctx)
+}
+
+// Wait blocks until the job finishes. It returns nil when the job succeeded, JobFailedError or JobCancelledError
+// when it failed or was cancelled and the context error when the context is done first.
+func (h *JobHandle) Wait(ctx context.Context) error {
+	state, lastMessageId, e := h.snapshot(ctx)
+	if e != nil {
+		return e
+	}
+	if state == nil {
+		state = domain.NewWatchContext()
+	}
+
+	var failure *api.JobFailedEvent
+	if info := state.GetJobInfo(h.JobId); info != nil && info.Status == domain.Failed {
+		// snapshot does not contain failure reason, it is taken from the events
+		failure, e = h.findFailure(ctx)
+		if e != nil {
+			return e
+		}
+	}
+	finished, result := h.result(state.GetJobInfo(h.JobId), failure)
+
+	for !finished {
+		lastMessageId, e = h.client.watchJobSet(ctx, h.Queue, h.JobSetId, lastMessageId, true, func(event api.Event) bool {
+			if event.GetJobId() != h.JobId {
+				return false
+			}
+			state.ProcessEvent(event)
+			if failedEvent, ok := event.(*api.JobFailedEvent); ok {
+				failure = failedEvent
+			}
+			finished, result = h.result(state.GetJobInfo(h.JobId), failure)
+			return finished
+		})
+		if e != nil {
+			return e
+		}
+	}
+	return result
+}
+
+// Cancel cancels the job
+func (h *JobHandle) Cancel(ctx context.Context) error {
+	_, e := h.client.submitClient.CancelJobs(ctx, &api.JobCancelRequest{Queue: h.Queue, JobSetId: h.JobSetId, JobId: h.JobId})
+	return newApiError("CancelJobs", e)
+}
+
+// Logs returns logs of the pod of the job read through binoculars of the cluster running the job,
+// podNumber is index of the pod of multi node job. Options can be nil.
+func (h *JobHandle) Logs(ctx context.Context, podNumber int32, options *v1.PodLogOptions) (string, error) {
+	info, e := h.replay(ctx)
+	if e != nil {
+		return "", e
+	}
+	if info.ClusterId == "" {
+		return "", &JobNotStartedError{JobId: h.JobId, Status: string(info.Status)}
+	}
+	namespace := ""
+	if info.Job != nil {
+		namespace = info.Job.Namespace
+	}
+	if options == nil {
+		options = &v1.PodLogOptions{}
+	}
+
+	binocularsClient, e := h.client.binocularsClient(info.ClusterId)
+	if e != nil {
+		return "", e
+	}
+	response, e := binocularsClient.Logs(ctx, &binoculars.LogRequest{
+		JobId:        h.JobId,
+		PodNumber:    podNumber,
+		PodNamespace: namespace,
+		LogOptions:   options,
+	})
+	if e != nil {
+		return "", newApiError("Logs", e)
+	}
+	return response.Log, nil
+}
+
+// Explain returns reasons why the queued job is not running
+func (h *JobHandle) Explain(ctx context.Context) (*api.JobExplanation, error) {
+	explanation, e := h.client.schedulingClient.ExplainJob(ctx, &api.JobExplainRequest{JobId: h.JobId})
+	if e != nil {
+		return nil, newApiError("ExplainJob", e)
+	}
+	return explanation, nil
+}
+
+// snapshot returns state of the job set and id of the last event included in it,
+// the state is nil when the server does not maintain snapshots
+func (h *JobHandle) snapshot(ctx context.Context) (*domain.WatchContext, string, error) {
+	snapshot, e := h.client.eventClient.GetJobSetState(ctx, &api.JobSetStateRequest{Queue: h.Queue, JobSetId: h.JobSetId})
+	if e != nil {
+		code := status.Code(e)
+		if code == codes.Unimplemented || code == codes.FailedPrecondition {
+			return nil, "", nil
+		}
+		return nil, "", newApiError("GetJobSetState", e)
+	}
+	return domain.NewWatchContextFromSnapshot(snapshot), snapshot.LastMessageId, nil
+}
+
+// replay processes all stored events of the job, the result contains the job spec and the cluster running it
+func (h *JobHandle) replay(ctx context.Context) (*domain.JobInfo, error) {
+	state := domain.NewWatchContext()
+	_, e := h.client.watchJobSet(ctx, h.Queue, h.JobSetId, "", false, func(event api.Event) bool {
+		if event.GetJobId() == h.JobId {
+			state.ProcessEvent(event)
+		}
+		return false
+	})
+	if e != nil {
+		return nil, e
+	}
+	info := state.GetJobInfo(h.JobId)
+	if info == nil {
+		return nil, &ApiError{
+			Method:  "GetJobSetEvents",
+			Code:    codes.NotFound,
+			Message: fmt.Sprintf("job %s not found in job set %s of queue %s", h.JobId, h.JobSetId, h.Queue),
+		}
+	}
+	return info, nil
+}
+
+func (h *JobHandle) findFailure(ctx context.Context) (*api.JobFailedEvent, error) {
+	var failure *api.JobFailedEvent
+	_, e := h.client.watchJobSet(ctx, h.Queue, h.JobSetId, "", false, func(event api.Event) bool {
+		if failedEvent, ok := event.(*api.JobFailedEvent); ok && failedEvent.JobId == h.JobId {
+			failure = failedEvent
+		}
+		return false
+	})
+	return failure, e
+}
+
+// result returns true when the job finished and error describing its failure
+func (h *JobHandle) result(info *domain.JobInfo, failure *api.JobFailedEvent) (bool, error) {
+	if info == nil {
+		return false, nil
+	}
+	switch info.Status {
+	case domain.Succeeded:
+		return true, nil
+	case domain.Cancelled:
+		return true, &JobCancelledError{JobId: h.JobId}
+	case domain.Failed:
+		err := &JobFailedError{JobId: h.JobId, ClusterId: info.ClusterId}
+		if failure != nil {
+			err.ClusterId = failure.ClusterId
+			err.PodNumber = failure.PodNumber
+			err.Reason = failure.Reason
+		}
+		return true, err
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,135 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// TokenCache keeps tokens between runs of command line tools, so users don't have to log in for every command
+type TokenCache interface {
+	// Load returns nil when there is no token cached for the provider and client
+	Load(providerUrl string, clientId string) (*oauth2.Token, error)
+	Store(providerUrl string, clientId string, token *oauth2.Token) error
+}
+
+// FileTokenCache stores single token in a file readable only by the user
+type FileTokenCache struct {
+	path string
+}
+
+type cachedToken struct {
+	ProviderUrl string        `json:"providerUrl"`
+	ClientId    string        `json:"clientId"`
+	Token       *oauth2.Token `json:"token"`
+}
+
+func NewFileTokenCache(path string) *FileTokenCache {
+	return &FileTokenCache{path: path}
+}
+
+func (c *FileTokenCache) Load(providerUrl string, clientId string) (*oauth2.Token, error) {
+	data, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cached := &cachedToken{}
+	err = json.Unmarshal(data, cached)
+	if err != nil {
+		return nil, err
+	}
+	// Token of other provider or client would be rejected, it was cached before the configuration changed
+	if cached.ProviderUrl != providerUrl || cached.ClientId != clientId {
+		return nil, nil
+	}
+	return cached.Token, nil
+}
+
+func (c *FileTokenCache) Store(providerUrl string, clientId string, token *oauth2.Token) error {
+	data, err := json.Marshal(&cachedToken{ProviderUrl: providerUrl, ClientId: clientId, Token: token})
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(c.path), 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, data, 0600)
+}
+
+// authenticateWithCache reuses cached token while it is valid or can be refreshed, otherwise it calls login.
+// Refreshed tokens are written back to the cache, cache can be nil.
+func authenticateWithCache(
+	ctx context.Context,
+	oauth *oauth2.Config,
+	providerUrl string,
+	cache TokenCache,
+	login func() (*oauth2.Token, error)) (*TokenCredentials, error) {
+
+	if cache != nil {
+		token, err := cache.Load(providerUrl, oauth.ClientID)
+		if err != nil {
+			log.Warnf("Failed to load cached token: %v", err)
+		}
+		if token != nil {
+			source := &cachingTokenSource{source: oauth.TokenSource(ctx, token), cache: cache, providerUrl: providerUrl, clientId: oauth.ClientID}
+			if _, err := source.Token(); err == nil {
+				return &TokenCredentials{source}, nil
+			}
+		}
+	}
+
+	token, err := login()
+	if err != nil {
+		return nil, err
+	}
+	source := &cachingTokenSource{source: oauth.TokenSource(ctx, token), cache: cache, providerUrl: providerUrl, clientId: oauth.ClientID}
+	if cache != nil {
+		source.store(token)
+	}
+	return &TokenCredentials{source}, nil
+}
+
+// cachingTokenSource is used by concurrent calls, lock guards the last cached access token and writes to the cache
+type cachingTokenSource struct {
+	source      oauth2.TokenSource
+	cache       TokenCache
+	providerUrl string
+	clientId    string
+
+	lock        sync.Mutex
+	accessToken string
+}
+
+func (s *cachingTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		return nil, err
+	}
+	if s.cache != nil {
+		s.store(token)
+	}
+	return token, nil
+}
+
+// store writes the token to the cache unless it was already written
+func (s *cachingTokenSource) store(token *oauth2.Token) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if token.AccessToken == s.accessToken {
+		return
+	}
+	s.accessToken = token.AccessToken
+	if err := s.cache.Store(s.providerUrl, s.clientId, token); err != nil {
+		log.Warnf("Failed to cache token: %v", err)
+	}
+}
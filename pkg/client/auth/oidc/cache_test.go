@@ -0,0 +1,80 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestFileTokenCache_StoresTokenOfProviderAndClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tokens")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cache := NewFileTokenCache(filepath.Join(dir, "contexts", "dev.json"))
+
+	token, err := cache.Load("https://provider", "client")
+	assert.Nil(t, err)
+	assert.Nil(t, token)
+
+	assert.Nil(t, cache.Store("https://provider", "client", &oauth2.Token{AccessToken: "access", RefreshToken: "refresh"}))
+
+	token, err = cache.Load("https://provider", "client")
+	assert.Nil(t, err)
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+
+	token, err = cache.Load("https://provider", "other-client")
+	assert.Nil(t, err)
+	assert.Nil(t, token)
+
+	info, err := os.Stat(filepath.Join(dir, "contexts", "dev.json"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestAuthenticateWithCache_UsesValidCachedToken(t *testing.T) {
+	cache := &memoryTokenCache{token: &oauth2.Token{AccessToken: "cached", Expiry: time.Now().Add(time.Hour)}}
+	oauth := &oauth2.Config{ClientID: "client"}
+
+	credentials, err := authenticateWithCache(context.Background(), oauth, "https://provider", cache, func() (*oauth2.Token, error) {
+		return nil, errors.New("login should not be called")
+	})
+	assert.Nil(t, err)
+	token, err := credentials.tokenSource.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "cached", token.AccessToken)
+}
+
+func TestAuthenticateWithCache_LogsInWhenCachedTokenExpired(t *testing.T) {
+	cache := &memoryTokenCache{token: &oauth2.Token{AccessToken: "expired", Expiry: time.Now().Add(-time.Hour)}}
+	oauth := &oauth2.Config{ClientID: "client"}
+
+	credentials, err := authenticateWithCache(context.Background(), oauth, "https://provider", cache, func() (*oauth2.Token, error) {
+		return &oauth2.Token{AccessToken: "new", Expiry: time.Now().Add(time.Hour)}, nil
+	})
+	assert.Nil(t, err)
+	token, err := credentials.tokenSource.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "new", token.AccessToken)
+	assert.Equal(t, "new", cache.token.AccessToken)
+}
+
+type memoryTokenCache struct {
+	token *oauth2.Token
+}
+
+func (c *memoryTokenCache) Load(providerUrl string, clientId string) (*oauth2.Token, error) {
+	return c.token, nil
+}
+
+func (c *memoryTokenCache) Store(providerUrl string, clientId string, token *oauth2.Token) error {
+	c.token = token
+	return nil
+}
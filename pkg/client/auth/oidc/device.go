@@ -21,7 +21,8 @@ type DeviceDetails struct {
 	Scopes      []string
 }
 
-func AuthenticateDevice(config DeviceDetails) (*TokenCredentials, error) {
+// AuthenticateDevice logs in with device flow, cache is optional and allows reusing the token by later runs
+func AuthenticateDevice(config DeviceDetails, cache TokenCache) (*TokenCredentials, error) {
 	ctx := context.Background()
 	provider, err := openId.NewProvider(ctx, config.ProviderUrl)
 	if err != nil {
@@ -34,6 +35,12 @@ func AuthenticateDevice(config DeviceDetails) (*TokenCredentials, error) {
 		Scopes:   append(config.Scopes, openId.ScopeOpenID),
 	}
 
+	return authenticateWithCache(ctx, &oauth, config.ProviderUrl, cache, func() (*oauth2.Token, error) {
+		return loginDevice(config)
+	})
+}
+
+func loginDevice(config DeviceDetails) (*oauth2.Token, error) {
 	c := &http.Client{}
 	deviceFlowResponse, err := requestDeviceAuthorization(c, config)
 	if err != nil {
@@ -54,7 +61,7 @@ func AuthenticateDevice(config DeviceDetails) (*TokenCredentials, error) {
 
 		token, err := requestToken(c, config, deviceFlowResponse.DeviceCode)
 		if err == nil {
-			return &TokenCredentials{oauth.TokenSource(ctx, token)}, nil
+			return token, nil
 		} else if err.Error() == authorizationPending {
 			continue
 		} else if err.Error() == slowDown {
@@ -77,6 +84,11 @@ type deviceFlowResponse struct {
 	VerificationUriComplete string `json:"verification_uri_complete"`
 }
 
+type deviceTokenResponse struct {
+	oauth2.Token
+	ExpiresIn int64 `json:"expires_in"`
+}
+
 type oauthErrorResponse struct {
 	Error string `json:"error"`
 }
@@ -123,12 +135,16 @@ func requestToken(c *http.Client, config DeviceDetails, deviceCode string) (*oau
 	defer resp.Body.Close()
 
 	if resp.StatusCode == 200 {
-		var token oauth2.Token
+		var token deviceTokenResponse
 		err = json.NewDecoder(resp.Body).Decode(&token)
 		if err != nil {
 			return nil, err
 		}
-		return &token, nil
+		// Expiry has to be known, so cached tokens are not used after they expire
+		if token.ExpiresIn > 0 {
+			token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+		}
+		return &token.Token, nil
 	} else if resp.StatusCode == 400 {
 		var errResp oauthErrorResponse
 		err = json.NewDecoder(resp.Body).Decode(&errResp)
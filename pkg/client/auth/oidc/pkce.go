@@ -23,11 +23,10 @@ type PKCEDetails struct {
 	Scopes      []string
 }
 
-func AuthenticatePkce(config PKCEDetails) (*TokenCredentials, error) {
+// AuthenticatePkce logs in with browser, cache is optional and allows reusing the token by later runs
+func AuthenticatePkce(config PKCEDetails, cache TokenCache) (*TokenCredentials, error) {
 
 	ctx := context.Background()
-	result := make(chan *oauth2.Token)
-	errorResult := make(chan error)
 
 	provider, err := openId.NewProvider(ctx, config.ProviderUrl)
 	if err != nil {
@@ -43,6 +42,15 @@ func AuthenticatePkce(config PKCEDetails) (*TokenCredentials, error) {
 		Scopes:      append(config.Scopes, openId.ScopeOpenID),
 	}
 
+	return authenticateWithCache(ctx, &oauth, config.ProviderUrl, cache, func() (*oauth2.Token, error) {
+		return loginPkce(ctx, &oauth, localUrl)
+	})
+}
+
+func loginPkce(ctx context.Context, oauth *oauth2.Config, localUrl string) (*oauth2.Token, error) {
+	result := make(chan *oauth2.Token)
+	errorResult := make(chan error)
+
 	state := randomStringBase64() // xss protection
 	challenge := randomStringBase64()
 	challengeSum := sha256.Sum256([]byte(challenge))
@@ -102,7 +110,7 @@ func AuthenticatePkce(config PKCEDetails) (*TokenCredentials, error) {
 
 	select {
 	case t := <-result:
-		return &TokenCredentials{oauth.TokenSource(ctx, t)}, nil
+		return t, nil
 	case e := <-errorResult:
 		return nil, e
 	}
@@ -8,11 +8,15 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/G-Research/armada/pkg/client/auth/oidc"
 )
 
 func AddArmadaApiConnectionCommandlineArgs(rootCmd *cobra.Command) {
 	rootCmd.PersistentFlags().String("armadaUrl", "localhost:50051", "specify armada server url")
 	viper.BindPFlag("armadaUrl", rootCmd.PersistentFlags().Lookup("armadaUrl"))
+	rootCmd.PersistentFlags().String(contextKey, "", "context from the config file to use (default is currentContext of the config file)")
+	viper.BindPFlag(contextKey, rootCmd.PersistentFlags().Lookup(contextKey))
 }
 
 func LoadCommandlineArgsFromConfigFile(cfgFile string) {
@@ -67,10 +71,27 @@ func LoadCommandlineArgsFromConfigFile(cfgFile string) {
 			os.Exit(1)
 		}
 	}
+
+	err = applyContext()
+	if err != nil {
+		log.Error(err)
+		os.Exit(1)
+	}
 }
 
 func ExtractCommandlineArmadaApiConnectionDetails() *ApiConnectionDetails {
 	apiConnectionDetails := &ApiConnectionDetails{}
 	viper.Unmarshal(apiConnectionDetails)
+	if err := applyContextAuth(apiConnectionDetails); err != nil {
+		log.Errorf("Can't read authentication settings of context %s: %v", activeContext, err)
+		os.Exit(1)
+	}
+
+	tokenCachePath, err := TokenCachePath(activeContext)
+	if err != nil {
+		log.Warnf("Open Id tokens won't be cached: %v", err)
+	} else {
+		apiConnectionDetails.TokenCache = oidc.NewFileTokenCache(tokenCachePath)
+	}
 	return apiConnectionDetails
 }
@@ -0,0 +1,302 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	contextKey         = "context"
+	currentContextKey  = "currentContext"
+	contextsKey        = "contexts"
+	defaultContextName = "default"
+
+	armadaUrlKey = "armadaUrl"
+	queueKey     = "queue"
+	jobSetIdKey  = "jobSetId"
+)
+
+// Context selected when the config was loaded, commands without any contexts configured use the default one
+var activeContext = defaultContextName
+
+// Settings authenticating the client, a context configuring any of them replaces all top level ones,
+// so credentials of different schemes are not mixed
+var authKeys = []string{
+	"basicAuth", "apiToken", "openIdAuth", "openIdDeviceAuth", "openIdPasswordAuth", "openIdClientCredentialsAuth",
+	"kerberosAuth", "clientCert",
+}
+
+// Authentication settings of the selected context, nil when the context uses top level ones
+var activeContextAuth map[string]interface{}
+
+// ContextDetails describes named context of the armadactl config file
+type ContextDetails struct {
+	Name      string `json:"name"`
+	ArmadaUrl string `json:"armadaUrl"`
+	Queue     string `json:"queue"`
+	JobSetId  string `json:"jobSetId"`
+	Current   bool   `json:"current"`
+}
+
+// applyContext merges settings of the context selected by --context flag or by currentContext over the loaded config,
+// so the context overrides top level settings while explicitly passed flags still take precedence.
+// Authentication settings are not merged, the context either replaces them all or uses the top level ones.
+func applyContext() error {
+	activeContextAuth = nil
+	name := viper.GetString(contextKey)
+	if name == "" {
+		name = viper.GetString(currentContextKey)
+	}
+	if name == "" {
+		activeContext = defaultContextName
+		return nil
+	}
+
+	// viper keys are case insensitive, so are the context names
+	settings, exists := viper.GetStringMap(contextsKey)[strings.ToLower(name)]
+	if !exists {
+		return fmt.Errorf("context %s is not defined in the config file", name)
+	}
+	if settings != nil {
+		contextSettings, ok := settings.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("context %s is not a map of settings", name)
+		}
+		otherSettings := map[string]interface{}{}
+		authSettings := map[string]interface{}{}
+		for key, value := range contextSettings {
+			if isAuthKey(key) {
+				authSettings[key] = value
+			} else {
+				otherSettings[key] = value
+			}
+		}
+		err := viper.MergeConfigMap(otherSettings)
+		if err != nil {
+			return err
+		}
+		if len(authSettings) > 0 {
+			activeContextAuth = authSettings
+		}
+	}
+	activeContext = name
+	return nil
+}
+
+func isAuthKey(key string) bool {
+	for _, authKey := range authKeys {
+		if strings.EqualFold(key, authKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyContextAuth replaces authentication settings of the connection with the ones of the selected context
+func applyContextAuth(details *ApiConnectionDetails) error {
+	if activeContextAuth == nil {
+		return nil
+	}
+	settings := viper.New()
+	err := settings.MergeConfigMap(activeContextAuth)
+	if err != nil {
+		return err
+	}
+	auth := &ApiConnectionDetails{}
+	err = settings.Unmarshal(auth)
+	if err != nil {
+		return err
+	}
+	details.BasicAuth = auth.BasicAuth
+	details.ApiToken = auth.ApiToken
+	details.OpenIdAuth = auth.OpenIdAuth
+	details.OpenIdDeviceAuth = auth.OpenIdDeviceAuth
+	details.OpenIdPasswordAuth = auth.OpenIdPasswordAuth
+	details.OpenIdClientCredentialsAuth = auth.OpenIdClientCredentialsAuth
+	details.KerberosAuth = auth.KerberosAuth
+	details.ClientCert = auth.ClientCert
+	return nil
+}
+
+// ExtractCommandlineDefaultQueue returns queue configured for the current context, commands use it when queue is not specified
+func ExtractCommandlineDefaultQueue() string {
+	return viper.GetString(queueKey)
+}
+
+// ExtractCommandlineDefaultJobSetId returns job set configured for the current context, commands use it when job set is not specified
+func ExtractCommandlineDefaultJobSetId() string {
+	return viper.GetString(jobSetIdKey)
+}
+
+// TokenCachePath returns path of the file caching Open Id tokens of the context
+func TokenCachePath(context string) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "armadactl", "tokens", url.PathEscape(strings.ToLower(context))+".json"), nil
+}
+
+// CommandlineConfigPath returns the config file passed by --config argument or the default $HOME/.armadactl.yaml
+func CommandlineConfigPath(cfgFile string) (string, error) {
+	if cfgFile != "" {
+		return cfgFile, nil
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".armadactl.yaml"), nil
+}
+
+// CommandlineConfig is armadactl config file edited by config commands, settings it doesn't manage are preserved
+type CommandlineConfig struct {
+	path     string
+	settings yaml.MapSlice
+}
+
+// ReadCommandlineConfig reads config file, missing file is treated as empty config
+func ReadCommandlineConfig(path string) (*CommandlineConfig, error) {
+	config := &CommandlineConfig{path: path}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = yaml.Unmarshal(data, &config.settings)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse config file %s: %v", path, err)
+	}
+	return config, nil
+}
+
+func (c *CommandlineConfig) Write() error {
+	data, err := yaml.Marshal(c.settings)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, data, 0600)
+}
+
+func (c *CommandlineConfig) CurrentContext() string {
+	value, _ := getSetting(c.settings, currentContextKey)
+	return toString(value)
+}
+
+// Contexts returns all contexts sorted by name
+func (c *CommandlineConfig) Contexts() []*ContextDetails {
+	currentContext := c.CurrentContext()
+	contexts := []*ContextDetails{}
+	for _, item := range c.contexts() {
+		name := toString(item.Key)
+		settings, _ := item.Value.(yaml.MapSlice)
+		armadaUrl, _ := getSetting(settings, armadaUrlKey)
+		queue, _ := getSetting(settings, queueKey)
+		jobSetId, _ := getSetting(settings, jobSetIdKey)
+		contexts = append(contexts, &ContextDetails{
+			Name:      name,
+			ArmadaUrl: toString(armadaUrl),
+			Queue:     toString(queue),
+			JobSetId:  toString(jobSetId),
+			Current:   strings.EqualFold(name, currentContext),
+		})
+	}
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].Name < contexts[j].Name
+	})
+	return contexts
+}
+
+// Context returns details of the context, nil when it is not defined
+func (c *CommandlineConfig) Context(name string) *ContextDetails {
+	for _, context := range c.Contexts() {
+		if strings.EqualFold(context.Name, name) {
+			return context
+		}
+	}
+	return nil
+}
+
+// UseContext makes existing context the current one
+func (c *CommandlineConfig) UseContext(name string) error {
+	context := c.findContext(name)
+	if context == nil {
+		return fmt.Errorf("context %s is not defined in the config file %s", name, c.path)
+	}
+	c.settings = setSetting(c.settings, currentContextKey, context.Key)
+	return nil
+}
+
+// SetContext creates or updates context, only the settings passed are changed
+func (c *CommandlineConfig) SetContext(name string, values map[string]string) {
+	contexts := c.contexts()
+	context := c.findContext(name)
+	if context == nil {
+		contexts = append(contexts, yaml.MapItem{Key: name})
+		context = &contexts[len(contexts)-1]
+	}
+	settings, _ := context.Value.(yaml.MapSlice)
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		settings = setSetting(settings, key, values[key])
+	}
+	context.Value = settings
+	c.settings = setSetting(c.settings, contextsKey, contexts)
+}
+
+func (c *CommandlineConfig) contexts() yaml.MapSlice {
+	contexts, _ := getSetting(c.settings, contextsKey)
+	slice, _ := contexts.(yaml.MapSlice)
+	return slice
+}
+
+func (c *CommandlineConfig) findContext(name string) *yaml.MapItem {
+	contexts := c.contexts()
+	for i := range contexts {
+		if strings.EqualFold(toString(contexts[i].Key), name) {
+			return &contexts[i]
+		}
+	}
+	return nil
+}
+
+func getSetting(settings yaml.MapSlice, key string) (interface{}, bool) {
+	for _, item := range settings {
+		if strings.EqualFold(toString(item.Key), key) {
+			return item.Value, true
+		}
+	}
+	return nil, false
+}
+
+func setSetting(settings yaml.MapSlice, key string, value interface{}) yaml.MapSlice {
+	for i := range settings {
+		if strings.EqualFold(toString(settings[i].Key), key) {
+			settings[i].Value = value
+			return settings
+		}
+	}
+	return append(settings, yaml.MapItem{Key: key, Value: value})
+}
+
+func toString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
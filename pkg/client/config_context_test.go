@@ -0,0 +1,126 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCommandlineConfig = `armadaUrl: localhost:50051
+basicAuth:
+  username: user1
+currentContext: dev
+contexts:
+  dev:
+    armadaUrl: armada.dev.example.com:443
+    queue: test
+    jobSetId: set1
+  Prod:
+    armadaUrl: armada.example.com:443
+    openIdAuth:
+      providerUrl: https://auth.example.com
+      clientId: armadactl
+`
+
+func TestApplyContext_UsesCurrentContext(t *testing.T) {
+	loadTestConfig(t, testCommandlineConfig)
+
+	assert.Nil(t, applyContext())
+	assert.Equal(t, "dev", activeContext)
+
+	details := ExtractCommandlineArmadaApiConnectionDetails()
+	assert.Equal(t, "armada.dev.example.com:443", details.ArmadaUrl)
+	assert.Equal(t, "user1", details.BasicAuth.Username)
+	assert.NotNil(t, details.TokenCache)
+	assert.Equal(t, "test", ExtractCommandlineDefaultQueue())
+	assert.Equal(t, "set1", ExtractCommandlineDefaultJobSetId())
+}
+
+func TestApplyContext_ContextFlagOverridesCurrentContext(t *testing.T) {
+	loadTestConfig(t, testCommandlineConfig)
+	viper.Set(contextKey, "prod")
+
+	assert.Nil(t, applyContext())
+	assert.Equal(t, "prod", activeContext)
+	assert.Equal(t, "armada.example.com:443", viper.GetString(armadaUrlKey))
+	assert.Equal(t, "", ExtractCommandlineDefaultQueue())
+}
+
+func TestApplyContext_ContextReplacesAuthentication(t *testing.T) {
+	loadTestConfig(t, testCommandlineConfig)
+	viper.Set(contextKey, "prod")
+
+	assert.Nil(t, applyContext())
+	details := ExtractCommandlineArmadaApiConnectionDetails()
+	assert.Equal(t, "", details.BasicAuth.Username)
+	assert.Equal(t, "https://auth.example.com", details.OpenIdAuth.ProviderUrl)
+	assert.Equal(t, "armadactl", details.OpenIdAuth.ClientId)
+}
+
+func TestApplyContext_UnknownContext(t *testing.T) {
+	loadTestConfig(t, testCommandlineConfig)
+	viper.Set(contextKey, "staging")
+
+	assert.NotNil(t, applyContext())
+}
+
+func TestApplyContext_NoContexts(t *testing.T) {
+	loadTestConfig(t, "armadaUrl: localhost:50051\n")
+
+	assert.Nil(t, applyContext())
+	assert.Equal(t, defaultContextName, activeContext)
+	assert.Equal(t, "localhost:50051", viper.GetString(armadaUrlKey))
+}
+
+func TestCommandlineConfig_EditsContexts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "armadactl")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(testCommandlineConfig), 0600))
+
+	config, err := ReadCommandlineConfig(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []*ContextDetails{
+		{Name: "Prod", ArmadaUrl: "armada.example.com:443"},
+		{Name: "dev", ArmadaUrl: "armada.dev.example.com:443", Queue: "test", JobSetId: "set1", Current: true},
+	}, config.Contexts())
+
+	assert.Nil(t, config.UseContext("prod"))
+	assert.NotNil(t, config.UseContext("staging"))
+	config.SetContext("dev", map[string]string{queueKey: "other"})
+	config.SetContext("staging", map[string]string{armadaUrlKey: "armada.staging.example.com:443"})
+	assert.Nil(t, config.Write())
+
+	config, err = ReadCommandlineConfig(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "Prod", config.CurrentContext())
+	assert.Equal(t, []*ContextDetails{
+		{Name: "Prod", ArmadaUrl: "armada.example.com:443", Current: true},
+		{Name: "dev", ArmadaUrl: "armada.dev.example.com:443", Queue: "other", JobSetId: "set1"},
+		{Name: "staging", ArmadaUrl: "armada.staging.example.com:443"},
+	}, config.Contexts())
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "basicAuth:\n  username: user1")
+}
+
+func TestReadCommandlineConfig_MissingFile(t *testing.T) {
+	config, err := ReadCommandlineConfig(filepath.Join(os.TempDir(), "missing-armadactl-config.yaml"))
+	assert.Nil(t, err)
+	assert.Empty(t, config.Contexts())
+	assert.Equal(t, "", config.CurrentContext())
+}
+
+func loadTestConfig(t *testing.T, config string) {
+	viper.Reset()
+	activeContext = defaultContextName
+	viper.SetConfigType("yaml")
+	assert.Nil(t, viper.ReadConfig(bytes.NewBufferString(config)))
+}
@@ -1,6 +1,10 @@
 package client
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
 	"strings"
 	"time"
 
@@ -16,12 +20,26 @@ import (
 type ApiConnectionDetails struct {
 	ArmadaUrl                   string
 	BasicAuth                   common.LoginCredentials
+	ApiToken                    string
 	OpenIdAuth                  oidc.PKCEDetails
 	OpenIdDeviceAuth            oidc.DeviceDetails
 	OpenIdPasswordAuth          oidc.ClientPasswordDetails
 	OpenIdClientCredentialsAuth oidc.ClientCredentialsDetails
 	KerberosAuth                kerberos.ClientConfig
+	ClientCert                  ClientCertDetails
 	ForceNoTls                  bool
+	// Use TLS even for localhost urls, where TLS is not used by default
+	ForceTls bool
+	// Cache of Open Id tokens obtained interactively, it is set by command line tools
+	TokenCache oidc.TokenCache `mapstructure:"-"`
+}
+
+// ClientCertDetails configure certificate presented to the server for mutual TLS authentication
+type ClientCertDetails struct {
+	CertFile string
+	KeyFile  string
+	// CA bundle used to verify server certificate, system roots are used when empty
+	CAFile string
 }
 
 func CreateApiConnection(config *ApiConnectionDetails, additionalDialOptions ...grpc.DialOption) (*grpc.ClientConn, error) {
@@ -35,11 +53,16 @@ func CreateApiConnection(config *ApiConnectionDetails, additionalDialOptions ...
 	unuaryInterceptors := grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...))
 	streamInterceptors := grpc.WithChainStreamInterceptor(grpc_retry.StreamClientInterceptor(retryOpts...))
 
+	transportCreds, err := transportCredentials(config)
+	if err != nil {
+		return nil, err
+	}
+
 	dialOpts := append(additionalDialOptions,
 		defaultCallOptions,
 		unuaryInterceptors,
 		streamInterceptors,
-		transportCredentials(config))
+		transportCreds)
 
 	creds, err := perRpcCredentials(config)
 	if err != nil {
@@ -56,11 +79,14 @@ func perRpcCredentials(config *ApiConnectionDetails) (credentials.PerRPCCredenti
 	if config.BasicAuth.Username != "" {
 		return &config.BasicAuth, nil
 
+	} else if config.ApiToken != "" {
+		return &common.TokenCredentials{Token: config.ApiToken}, nil
+
 	} else if config.OpenIdAuth.ProviderUrl != "" {
-		return oidc.AuthenticatePkce(config.OpenIdAuth)
+		return oidc.AuthenticatePkce(config.OpenIdAuth, config.TokenCache)
 
 	} else if config.OpenIdDeviceAuth.ProviderUrl != "" {
-		return oidc.AuthenticateDevice(config.OpenIdDeviceAuth)
+		return oidc.AuthenticateDevice(config.OpenIdDeviceAuth, config.TokenCache)
 
 	} else if config.OpenIdPasswordAuth.ProviderUrl != "" {
 		return oidc.AuthenticateWithPassword(config.OpenIdPasswordAuth)
@@ -74,9 +100,32 @@ func perRpcCredentials(config *ApiConnectionDetails) (credentials.PerRPCCredenti
 	return nil, nil
 }
 
-func transportCredentials(config *ApiConnectionDetails) grpc.DialOption {
-	if !config.ForceNoTls && !strings.Contains(config.ArmadaUrl, "localhost") {
-		return grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))
+func transportCredentials(config *ApiConnectionDetails) (grpc.DialOption, error) {
+	if config.ClientCert.CertFile != "" {
+		return clientCertTransportCredentials(config.ClientCert)
+	}
+	if config.ForceTls || (!config.ForceNoTls && !strings.Contains(config.ArmadaUrl, "localhost")) {
+		return grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")), nil
+	}
+	return grpc.WithInsecure(), nil
+}
+
+// Client certificate requires TLS, so it is used even for localhost
+func clientCertTransportCredentials(config ClientCertDetails) (grpc.DialOption, error) {
+	certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{certificate}}
+	if config.CAFile != "" {
+		data, err := ioutil.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in %s", config.CAFile)
+		}
 	}
-	return grpc.WithInsecure()
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
 }
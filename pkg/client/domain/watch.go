@@ -59,8 +59,9 @@ func init() {
 //WatchContext keeps track of the current state when processing a stream of events
 //It is not threadsafe and is expected to only ever be used in a single thread
 type WatchContext struct {
-	state        map[string]*JobInfo
-	stateSummary map[JobStatus]int
+	state           map[string]*JobInfo
+	stateSummary    map[JobStatus]int
+	jobSetCompleted bool
 }
 
 func NewWatchContext() *WatchContext {
@@ -70,7 +71,35 @@ func NewWatchContext() *WatchContext {
 	}
 }
 
+// NewWatchContextFromSnapshot creates WatchContext with job statuses from the job set snapshot,
+// pod statuses, job specs and resource usage are not part of the snapshot
+func NewWatchContextFromSnapshot(snapshot *api.JobSetState) *WatchContext {
+	context := NewWatchContext()
+	for _, job := range snapshot.Jobs {
+		status := snapshotJobStatus(job.Status)
+		context.state[job.JobId] = &JobInfo{
+			Status:           status,
+			ClusterId:        job.ClusterId,
+			MaxUsedResources: common.ComputeResources{},
+		}
+		context.updateStateSummary("", status)
+	}
+	return context
+}
+
+func snapshotJobStatus(status api.JobStatus) JobStatus {
+	if status == api.JobStatus_UnknownStatus {
+		return ""
+	}
+	return JobStatus(status.String())
+}
+
 func (context *WatchContext) ProcessEvent(event api.Event) {
+	if _, ok := event.(*api.JobSetCompletedEvent); ok {
+		context.jobSetCompleted = true
+		return
+	}
+
 	info, exists := context.state[event.GetJobId()]
 	if !exists {
 		info = &JobInfo{
@@ -150,6 +179,11 @@ func (context *WatchContext) GetNumberOfJobs() int {
 	return numberOfJobs
 }
 
+// Job set is completed once it was closed and all its jobs finished, no more events are expected after that
+func (context *WatchContext) IsJobSetCompleted() bool {
+	return context.jobSetCompleted
+}
+
 func (context *WatchContext) AreJobsFinished(ids []string) bool {
 	for _, id := range ids {
 		state, ok := context.state[id]
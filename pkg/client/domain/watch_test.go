@@ -214,3 +214,31 @@ func TestWatchContext_UtilisationEvent(t *testing.T) {
 	})
 	assert.Equal(t, resource.MustParse("1"), watchContext.GetJobInfo("job1").MaxUsedResources["cpu"])
 }
+
+func TestWatchContext_ProcessEvent_JobSetCompleted(t *testing.T) {
+	watchContext := NewWatchContext()
+
+	watchContext.ProcessEvent(&api.JobSucceededEvent{JobId: "1"})
+	assert.False(t, watchContext.IsJobSetCompleted())
+
+	watchContext.ProcessEvent(&api.JobSetCompletedEvent{JobSetId: "set"})
+	assert.True(t, watchContext.IsJobSetCompleted())
+	assert.Equal(t, 1, len(watchContext.GetCurrentState()))
+	assert.Equal(t, 1, watchContext.GetNumberOfJobs())
+}
+
+func TestNewWatchContextFromSnapshot(t *testing.T) {
+	watchContext := NewWatchContextFromSnapshot(&api.JobSetState{
+		Jobs: []*api.JobState{
+			{JobId: "1", Status: api.JobStatus_Running, ClusterId: "cluster"},
+			{JobId: "2", Status: api.JobStatus_Queued},
+		},
+	})
+
+	assert.Equal(t, JobStatus(Running), watchContext.GetJobInfo("1").Status)
+	assert.Equal(t, "cluster", watchContext.GetJobInfo("1").ClusterId)
+	assert.Equal(t, map[JobStatus]int{Running: 1, Queued: 1}, watchContext.stateSummary)
+
+	watchContext.ProcessEvent(&api.JobSucceededEvent{JobId: "1"})
+	assert.Equal(t, map[JobStatus]int{Running: 0, Succeeded: 1, Queued: 1}, watchContext.stateSummary)
+}
@@ -23,6 +23,20 @@ func DeleteQueue(submitClient api.SubmitClient, name string) error {
 	return e
 }
 
+func SetQueueRoleBindings(submitClient api.SubmitClient, name string, roleBindings []*api.QueueRoleBinding) error {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	_, e := submitClient.SetQueueRoleBindings(ctx, &api.QueueRoleBindingsRequest{Name: name, RoleBindings: roleBindings})
+	return e
+}
+
+func CloseJobSet(submitClient api.SubmitClient, queue string, jobSetId string) error {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	_, e := submitClient.CloseJobSet(ctx, &api.JobSetCloseRequest{Queue: queue, JobSetId: jobSetId})
+	return e
+}
+
 func SubmitJobs(submitClient api.SubmitClient, request *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
 	AddClientIds(request.JobRequestItems)
 	ctx, cancel := common.ContextWithDefaultTimeout()
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/G-Research/armada/pkg/client/util"
+)
+
+// LoadTemplateValues merges values files in the given order and then values set on command line, later values override
+// earlier ones. Values set on command line are key=value pairs, nested keys are separated by dots, e.g. image.tag=1.0
+func LoadTemplateValues(valuesFiles []string, setValues []string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	for _, valuesFile := range valuesFiles {
+		fileValues := map[string]interface{}{}
+		if e := util.BindJsonOrYaml(valuesFile, &fileValues); e != nil {
+			return nil, e
+		}
+		mergeValues(values, fileValues)
+	}
+
+	for _, setValue := range setValues {
+		parts := strings.SplitN(setValue, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid value %q, expected key=value", setValue)
+		}
+		keys := strings.Split(parts[0], ".")
+		nested := map[string]interface{}{keys[len(keys)-1]: parts[1]}
+		for i := len(keys) - 2; i >= 0; i-- {
+			nested = map[string]interface{}{keys[i]: nested}
+		}
+		mergeValues(values, nested)
+	}
+	return values, nil
+}
+
+// Line opting a submit file into rendering as template when no values are given, e.g. to use only environment variables
+const submitTemplateMarker = "# armadactl: template"
+
+// RenderSubmitFile executes the submit file as Go template. Values are available as .Values and environment variables
+// through env function, e.g. {{ .Values.image.tag }} or {{ env "USER" }}. Missing values are reported as errors.
+// Files are rendered only when values are given or they contain the template marker line, other files are returned
+// unchanged, so static files can contain "{{".
+func RenderSubmitFile(filePath string, values map[string]interface{}) ([]byte, error) {
+	content, e := ioutil.ReadFile(filePath)
+	if e != nil {
+		return nil, fmt.Errorf("Failed opening file %s due to %s", filePath, e)
+	}
+	if len(values) == 0 && !isSubmitTemplate(content) {
+		return content, nil
+	}
+
+	submitTemplate, e := template.New(filepath.Base(filePath)).
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"env": os.Getenv}).
+		Parse(string(content))
+	if e != nil {
+		return nil, fmt.Errorf("Failed to parse template %s because: %v", filePath, e)
+	}
+
+	var rendered bytes.Buffer
+	e = submitTemplate.Execute(&rendered, map[string]interface{}{"Values": values})
+	if e != nil {
+		return nil, fmt.Errorf("Failed to render template %s because: %v", filePath, e)
+	}
+	return rendered.Bytes(), nil
+}
+
+func isSubmitTemplate(content []byte) bool {
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.TrimSpace(line) == submitTemplateMarker {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeValues(values map[string]interface{}, overrides map[string]interface{}) {
+	for key, override := range overrides {
+		existingMap, existingIsMap := values[key].(map[string]interface{})
+		overrideMap, overrideIsMap := override.(map[string]interface{})
+		if existingIsMap && overrideIsMap {
+			mergeValues(existingMap, overrideMap)
+		} else {
+			values[key] = override
+		}
+	}
+}
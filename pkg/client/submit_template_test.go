@@ -0,0 +1,63 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTemplateValues_CommandLineOverridesValuesFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "values")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	base := filepath.Join(dir, "base.yaml")
+	prod := filepath.Join(dir, "prod.yaml")
+	assert.Nil(t, ioutil.WriteFile(base, []byte("image:\n  name: alpine\n  tag: latest\nreplicas: 1\n"), 0600))
+	assert.Nil(t, ioutil.WriteFile(prod, []byte("image:\n  tag: \"3.13\"\n"), 0600))
+
+	values, err := LoadTemplateValues([]string{base, prod}, []string{"replicas=3", "image.pullPolicy=Always"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"image":    map[string]interface{}{"name": "alpine", "tag": "3.13", "pullPolicy": "Always"},
+		"replicas": "3",
+	}, values)
+
+	_, err = LoadTemplateValues(nil, []string{"replicas"})
+	assert.NotNil(t, err)
+}
+
+func TestRenderSubmitFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "jobs.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`# armadactl: template
+queue: {{ .Values.queue }}
+jobSetId: {{ env "ARMADA_TEST_JOB_SET" }}
+`), 0600))
+	os.Setenv("ARMADA_TEST_JOB_SET", "set-1")
+	defer os.Unsetenv("ARMADA_TEST_JOB_SET")
+
+	rendered, err := RenderSubmitFile(path, map[string]interface{}{"queue": "test"})
+	assert.Nil(t, err)
+	assert.Equal(t, "# armadactl: template\nqueue: test\njobSetId: set-1\n", string(rendered))
+
+	_, err = RenderSubmitFile(path, map[string]interface{}{})
+	assert.NotNil(t, err)
+}
+
+func TestRenderSubmitFile_StaticFileIsNotRendered(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "jobs.yaml")
+	content := "queue: test\njobSetId: set-1\nargs: [\"echo {{ not a template\"]\n"
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	rendered, err := RenderSubmitFile(path, map[string]interface{}{})
+	assert.Nil(t, err)
+	assert.Equal(t, content, string(rendered))
+}
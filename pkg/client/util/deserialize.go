@@ -1,7 +1,10 @@
 package util
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -18,3 +21,36 @@ func BindJsonOrYaml(filePath string, obj interface{}) error {
 	}
 	return nil
 }
+
+func BindJsonOrYamlData(data []byte, obj interface{}) error {
+	return yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 128).Decode(obj)
+}
+
+// SplitYamlDocuments returns documents of multi-document yaml separated by "---", empty documents are skipped.
+// Json is returned as a single document.
+func SplitYamlDocuments(data []byte) ([][]byte, error) {
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	documents := [][]byte{}
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !isEmptyYamlDocument(document) {
+			documents = append(documents, document)
+		}
+	}
+}
+
+func isEmptyYamlDocument(document []byte) bool {
+	for _, line := range bytes.Split(document, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 && line[0] != '#' && !bytes.Equal(line, []byte("---")) {
+			return false
+		}
+	}
+	return true
+}
@@ -69,3 +69,17 @@ func makeResourceList(t *testing.T, cpu string, memory string) v1.ResourceList {
 	assert.NoError(t, err)
 	return v1.ResourceList{v1.ResourceCPU: cpuResource, v1.ResourceMemory: memoryResource}
 }
+
+func TestSplitYamlDocuments(t *testing.T) {
+	documents, err := SplitYamlDocuments([]byte("---\nqueue: a\n---\n# comment only\n---\nqueue: b\n"))
+	assert.NoError(t, err)
+	assert.Len(t, documents, 2)
+
+	submitFile := &domain.JobSubmitFile{}
+	assert.NoError(t, BindJsonOrYamlData(documents[1], submitFile))
+	assert.Equal(t, "b", submitFile.Queue)
+
+	documents, err = SplitYamlDocuments([]byte(`{"queue": "a"}`))
+	assert.NoError(t, err)
+	assert.Len(t, documents, 1)
+}
@@ -9,6 +9,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	commonValidation "github.com/G-Research/armada/internal/common/validation"
+	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/client/util"
 )
 
@@ -42,6 +44,41 @@ func ValidateSubmitFile(filePath string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	return validateRawSubmitFile(submitFile)
+}
+
+// ValidateSubmitFileData validates single document of submit file
+func ValidateSubmitFileData(data []byte) (bool, error) {
+	submitFile := &rawJobSubmitFile{}
+	err := util.BindJsonOrYamlData(data, submitFile)
+
+	if err != nil {
+		return false, err
+	}
+	return validateRawSubmitFile(submitFile)
+}
+
+// ValidateSubmitRequestItem checks the job the same way armada server does on submission,
+// except default resource limits of the server are not applied to containers without resources.
+func ValidateSubmitRequestItem(item *api.JobSubmitRequestItem) error {
+	if item.PodSpec != nil && len(item.PodSpecs) > 0 {
+		return fmt.Errorf("job has both pod spec and pod spec list specified")
+	}
+	if len(item.GetAllPodSpecs()) == 0 {
+		return fmt.Errorf("job has no pod spec")
+	}
+	if e := commonValidation.ValidateJobSubmitRequestItem(item); e != nil {
+		return e
+	}
+	for i, podSpec := range item.GetAllPodSpecs() {
+		if e := commonValidation.ValidatePodSpec(podSpec); e != nil {
+			return fmt.Errorf("pod %d: %v", i, e)
+		}
+	}
+	return nil
+}
+
+func validateRawSubmitFile(submitFile *rawJobSubmitFile) (bool, error) {
 
 	if len(submitFile.Jobs) <= 0 {
 		return false, errors.New("Warning: You have provided no jobs to submit.")
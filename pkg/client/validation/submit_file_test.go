@@ -5,6 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/pkg/api"
 )
 
 func TestValidateSubmitFile_WhenValidFile_ReturnValid(t *testing.T) {
@@ -51,3 +55,19 @@ func TestValidateSubmitFile_WhenMultiJobUnknownField_ReturnInvalid(t *testing.T)
 	assert.NotNil(t, err)
 	assert.False(t, ok)
 }
+
+func TestValidateSubmitRequestItem(t *testing.T) {
+	resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
+	valid := &api.JobSubmitRequestItem{PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+		Name:      "main",
+		Resources: v1.ResourceRequirements{Limits: resources, Requests: resources},
+	}}}}
+	assert.Nil(t, ValidateSubmitRequestItem(valid))
+
+	assert.NotNil(t, ValidateSubmitRequestItem(&api.JobSubmitRequestItem{}))
+	assert.NotNil(t, ValidateSubmitRequestItem(&api.JobSubmitRequestItem{PodSpec: &v1.PodSpec{Containers: []v1.Container{{Name: "main"}}}}))
+	assert.NotNil(t, ValidateSubmitRequestItem(&api.JobSubmitRequestItem{
+		PodSpec:     valid.PodSpec,
+		Annotations: map[string]string{api.NotifyUrlAnnotation: "ftp://example.com"},
+	}))
+}
@@ -28,11 +28,26 @@ func WatchJobSet(client api.EventClient, queue, jobSetId string, waitForNew bool
 }
 
 func WatchJobSetWithJobIdsFilter(client api.EventClient, queue, jobSetId string, waitForNew bool, jobIds []string, context context.Context, onUpdate func(*domain.WatchContext, api.Event) bool) *domain.WatchContext {
-	state := domain.NewWatchContext()
+	return watchJobSet(client, queue, jobSetId, waitForNew, jobIds, domain.NewWatchContext(), "", context, onUpdate)
+}
+
+// WatchJobSetFromSnapshot initializes the state from the job set snapshot and only streams events reported after it.
+// The job set is replayed from the start if the server does not provide the snapshot.
+func WatchJobSetFromSnapshot(client api.EventClient, queue, jobSetId string, waitForNew bool, context context.Context, onUpdate func(*domain.WatchContext, api.Event) bool) *domain.WatchContext {
+	snapshot, e := client.GetJobSetState(context, &api.JobSetStateRequest{Queue: queue, JobSetId: jobSetId})
+	if e != nil {
+		code := status.Code(e)
+		if code != codes.Unimplemented && code != codes.FailedPrecondition {
+			log.Warnf("Failed to get job set snapshot, replaying all events: %v", e)
+		}
+		return WatchJobSet(client, queue, jobSetId, waitForNew, context, onUpdate)
+	}
+	return watchJobSet(client, queue, jobSetId, waitForNew, []string{}, domain.NewWatchContextFromSnapshot(snapshot), snapshot.LastMessageId, context, onUpdate)
+}
 
+func watchJobSet(client api.EventClient, queue, jobSetId string, waitForNew bool, jobIds []string, state *domain.WatchContext, lastMessageId string, context context.Context, onUpdate func(*domain.WatchContext, api.Event) bool) *domain.WatchContext {
 	jobIdsSet := util.StringListToSet(jobIds)
 	filterOnJobId := len(jobIdsSet) > 0
-	lastMessageId := ""
 
 	for {
 		select {
@@ -85,6 +100,60 @@ func WatchJobSetWithJobIdsFilter(client api.EventClient, queue, jobSetId string,
 	}
 }
 
+// WatchQueue streams events of all job sets in the queue which match filters of the request.
+// When the stream breaks it is reopened from the last received message.
+// Returns id of the last received message once onEvent returns true or context is done.
+func WatchQueue(client api.EventClient, request *api.WatchQueueFilteredRequest, context context.Context, onEvent func(api.Event) bool) string {
+	lastMessageId := request.FromMessageId
+
+	for {
+		select {
+		case <-context.Done():
+			return lastMessageId
+		default:
+		}
+
+		clientStream, e := client.WatchQueueFiltered(context, &api.WatchQueueFilteredRequest{
+			Queue:          request.Queue,
+			FromMessageId:  lastMessageId,
+			JobSetPatterns: request.JobSetPatterns,
+			EventTypes:     request.EventTypes,
+		})
+
+		if e != nil {
+			log.Error(e)
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		for {
+			msg, e := clientStream.Recv()
+			if e != nil {
+				if e == io.EOF {
+					return lastMessageId
+				}
+				if !isTransportClosingError(e) {
+					log.Error(e)
+				}
+				time.Sleep(5 * time.Second)
+				break
+			}
+			lastMessageId = msg.Id
+
+			event, e := api.UnwrapEvent(msg.Message)
+			if e != nil {
+				// This can mean that the event type reported from server is unknown to the client
+				log.Error(e)
+				continue
+			}
+
+			if onEvent(event) {
+				return lastMessageId
+			}
+		}
+	}
+}
+
 func isTransportClosingError(e error) bool {
 	if err, ok := status.FromError(e); ok {
 		switch err.Code() {
@@ -43,6 +43,9 @@ protoc \
 --proto_path=/proto \
 --grpc-gateway_out=logtostderr=true,$TYPES:. \
 --swagger_out=logtostderr=true,$TYPES,allow_merge=true,simple_operation_ids=true,json_names_for_fields=true,merge_file_name=./pkg/api/api:. \
+pkg/api/audit.proto \
+pkg/api/explain.proto \
+pkg/api/token.proto \
 pkg/api/event.proto \
 pkg/api/submit.proto
 